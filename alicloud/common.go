@@ -42,6 +42,7 @@ import (
 	"math"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/google/uuid"
 	"github.com/mitchellh/go-homedir"
@@ -677,6 +678,8 @@ func writeToFile(filePath string, data interface{}) error {
 
 type Invoker struct {
 	catchers []*Catcher
+	// policy is the retry policy of the client, which replaces the counts and waits of the catchers.
+	policy *connectivity.RetryPolicy
 }
 
 type Catcher struct {
@@ -689,8 +692,8 @@ var ClientErrorCatcher = Catcher{AliyunGoClientFailure, 10, 5}
 var ServiceBusyCatcher = Catcher{"ServiceUnavailable", 10, 5}
 var ThrottlingCatcher = Catcher{Throttling, 50, 2}
 
-func NewInvoker() Invoker {
-	i := Invoker{}
+func NewInvoker(client *connectivity.AliyunClient) Invoker {
+	i := Invoker{policy: client.RetryPolicy()}
 	i.AddCatcher(ClientErrorCatcher)
 	i.AddCatcher(ServiceBusyCatcher)
 	i.AddCatcher(ThrottlingCatcher)
@@ -702,6 +705,9 @@ func (a *Invoker) AddCatcher(catcher Catcher) {
 }
 
 func (a *Invoker) Run(f func() error) error {
+	if a.policy != nil {
+		return a.runWithPolicy(a.policy, f)
+	}
 	err := f()
 
 	if err == nil {
@@ -723,6 +729,30 @@ func (a *Invoker) Run(f func() error) error {
 	return err
 }
 
+// runWithPolicy retries the errors caught by the catchers with the provider retry policy instead of their counts and waits.
+func (a *Invoker) runWithPolicy(policy *connectivity.RetryPolicy, f func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		retryable := policy.IsExtraRetryable(err)
+		for _, catcher := range a.catchers {
+			if IsExpectedErrors(err, []string{catcher.Reason}) {
+				retryable = true
+				break
+			}
+		}
+		if !retryable {
+			return err
+		}
+		if attempt >= policy.MaxAttempts {
+			return fmt.Errorf("Retry timeout and got an error: %#v.", err)
+		}
+		time.Sleep(policy.Backoff(attempt))
+	}
+}
+
 func buildClientToken(action string) string {
 	token := strings.TrimSpace(fmt.Sprintf("TF-%s-%d-%s", action, time.Now().Unix(), strings.Trim(uuid.New().String(), "-")))
 	if len(token) > 64 {
@@ -0,0 +1,153 @@
+package connectivity
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	sts20150401 "github.com/alibabacloud-go/sts-20150401/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/credentials-go/credentials"
+	"github.com/aliyun/credentials-go/credentials/providers"
+)
+
+// AssumeRole is a hop of the provider assume_role blocks. The first hop is assumed by the provider credentials, and
+// each of the following hops is assumed by the credentials of the previous one.
+type AssumeRole struct {
+	RoleArn           string
+	SessionName       string
+	Policy            string
+	ExternalId        string
+	SessionExpiration int
+}
+
+// assumeRoleChainProvider walks the assume_role hops in order, like the CI identity -> the security hub account -> the
+// workload account. Only the credentials of the last hop are kept by the manager, and the whole chain is walked again
+// from the source credentials before they expire, so the intermediate sessions never need to outlive a hop.
+type assumeRoleChainProvider struct {
+	source providers.CredentialsProvider
+	hops   []*AssumeRole
+	// assumeRole assumes the hop by the previous credentials and returns the credentials with their expiration
+	assumeRole func(previous *providers.Credentials, hop *AssumeRole) (*providers.Credentials, time.Time, error)
+	manager    *CredentialManager
+}
+
+func (p *assumeRoleChainProvider) walk() (*providers.Credentials, time.Time, error) {
+	previous, err := p.source.GetCredentials()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var expiration time.Time
+	for i, hop := range p.hops {
+		previous, expiration, err = p.assumeRole(previous, hop)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("assuming the role %s of the assume_role %d got an error: %v", hop.RoleArn, i, err)
+		}
+		log.Printf("[DEBUG] assumed the role %s of the assume_role %d, expiration: %s", hop.RoleArn, i, expiration.Format(time.RFC3339))
+	}
+	return previous, expiration, nil
+}
+
+// stsAssumeRole calls the STS AssumeRole by the previous credentials.
+func (c *Config) stsAssumeRole(previous *providers.Credentials, hop *AssumeRole) (*providers.Credentials, time.Time, error) {
+	endpoint := c.StsEndpoint
+	if endpoint == "" {
+		endpoint = "sts.aliyuncs.com"
+	}
+	conf := &openapi.Config{
+		RegionId:        tea.String(c.RegionId),
+		Endpoint:        tea.String(endpoint),
+		UserAgent:       tea.String(c.getUserAgent()),
+		AccessKeyId:     tea.String(previous.AccessKeyId),
+		AccessKeySecret: tea.String(previous.AccessKeySecret),
+		// currently, sts endpoint only supports https
+		Protocol:       tea.String("HTTPS"),
+		ReadTimeout:    tea.Int(c.ClientReadTimeout),
+		ConnectTimeout: tea.Int(c.ClientConnectTimeout),
+	}
+	if previous.SecurityToken != "" {
+		conf.SecurityToken = tea.String(previous.SecurityToken)
+	}
+	stsClient, err := sts20150401.NewClient(conf)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("building sts client got an error: %v", err)
+	}
+	request := &sts20150401.AssumeRoleRequest{
+		RoleArn:         tea.String(hop.RoleArn),
+		RoleSessionName: tea.String(hop.SessionName),
+	}
+	if hop.Policy != "" {
+		request.Policy = tea.String(hop.Policy)
+	}
+	if hop.ExternalId != "" {
+		request.ExternalId = tea.String(hop.ExternalId)
+	}
+	if hop.SessionExpiration != 0 {
+		request.DurationSeconds = tea.Int64(int64(hop.SessionExpiration))
+	}
+	var response *sts20150401.AssumeRoleResponse
+	maxRetries := 5
+	for i := 0; i <= maxRetries; i++ {
+		response, err = stsClient.AssumeRoleWithOptions(request, &util.RuntimeOptions{})
+		if err != nil {
+			if needRetry(err) && i < maxRetries {
+				time.Sleep(time.Duration(i) * time.Second)
+				continue
+			}
+			return nil, time.Time{}, err
+		}
+		break
+	}
+	if response.Body == nil || response.Body.Credentials == nil {
+		return nil, time.Time{}, fmt.Errorf("the AssumeRole response misses the credentials")
+	}
+	credential := response.Body.Credentials
+	expiration, err := time.Parse(time.RFC3339, tea.StringValue(credential.Expiration))
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("parsing the expiration %q got an error: %v", tea.StringValue(credential.Expiration), err)
+	}
+	return &providers.Credentials{
+		AccessKeyId:     tea.StringValue(credential.AccessKeyId),
+		AccessKeySecret: tea.StringValue(credential.AccessKeySecret),
+		SecurityToken:   tea.StringValue(credential.SecurityToken),
+	}, expiration, nil
+}
+
+// credentialSource adapts the Credential to the source of the assume_role chain, so that the chain is walked from the
+// refreshed credentials, like the ones of the ECS RAM role.
+type credentialSource struct {
+	credential credentials.Credential
+}
+
+func (s *credentialSource) GetCredentials() (*providers.Credentials, error) {
+	credential, err := s.credential.GetCredential()
+	if err != nil {
+		return nil, err
+	}
+	return &providers.Credentials{
+		AccessKeyId:     tea.StringValue(credential.AccessKeyId),
+		AccessKeySecret: tea.StringValue(credential.AccessKeySecret),
+		SecurityToken:   tea.StringValue(credential.SecurityToken),
+		ProviderName:    tea.StringValue(credential.ProviderName),
+	}, nil
+}
+
+func (s *credentialSource) GetProviderName() string {
+	return "source"
+}
+
+// staticCredentialsProvider is the source of the assume_role chain when there is no Credential.
+type staticCredentialsProvider struct {
+	credentials providers.Credentials
+}
+
+func (p *staticCredentialsProvider) GetCredentials() (*providers.Credentials, error) {
+	credentials := p.credentials
+	return &credentials, nil
+}
+
+func (p *staticCredentialsProvider) GetProviderName() string {
+	return "static"
+}
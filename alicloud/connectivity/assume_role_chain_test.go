@@ -0,0 +1,77 @@
+package connectivity
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aliyun/credentials-go/credentials/providers"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAssumeRole returns the credentials named by the role, and records the credentials assuming each role.
+type fakeAssumeRole struct {
+	clock    *fakeClock
+	lifetime time.Duration
+	assumed  []string
+}
+
+func (f *fakeAssumeRole) assumeRole(previous *providers.Credentials, hop *AssumeRole) (*providers.Credentials, time.Time, error) {
+	if hop.RoleArn == "acs:ram::0:role/denied" {
+		return nil, time.Time{}, fmt.Errorf("NoPermission")
+	}
+	f.assumed = append(f.assumed, fmt.Sprintf("%s by %s", hop.RoleArn, previous.AccessKeyId))
+	return &providers.Credentials{
+		AccessKeyId:     fmt.Sprintf("STS.%s.%d", hop.RoleArn, len(f.assumed)),
+		AccessKeySecret: "secret",
+		SecurityToken:   "token",
+	}, f.clock.Now().Add(f.lifetime), nil
+}
+
+func newAssumeRoleChainConfig(fake *fakeAssumeRole, roles ...string) *Config {
+	config := &Config{AccessKey: "CIAccessKeyId", SecretKey: "CIAccessKeySecret", RamRoleArn: roles[0], clock: fake.clock}
+	for _, role := range roles {
+		config.AssumeRoleChain = append(config.AssumeRoleChain, &AssumeRole{RoleArn: role, SessionName: "terraform"})
+	}
+	config.assumeRoleChain = &assumeRoleChainProvider{
+		source:     &staticCredentialsProvider{credentials: providers.Credentials{AccessKeyId: config.AccessKey, AccessKeySecret: config.SecretKey}},
+		hops:       config.AssumeRoleChain,
+		assumeRole: fake.assumeRole,
+	}
+	return config
+}
+
+func TestConfigAssumeRoleChain(t *testing.T) {
+	fake := &fakeAssumeRole{clock: newFakeClock(), lifetime: time.Hour}
+	config := newAssumeRoleChainConfig(fake, "hub", "workload")
+	assert.Nil(t, config.RefreshAuthCredential())
+	assert.Equal(t, []string{"hub by CIAccessKeyId", "workload by STS.hub.1"}, fake.assumed)
+	assert.Equal(t, "STS.workload.2", config.AccessKey)
+	assert.Equal(t, "token", config.SecurityToken)
+
+	// The credentials of the last hop are cached until they are about to expire
+	assert.Nil(t, config.RefreshAuthCredential())
+	credential, err := config.Credential.GetCredential()
+	assert.Nil(t, err)
+	assert.Equal(t, "STS.workload.2", *credential.AccessKeyId)
+	assert.Len(t, fake.assumed, 2)
+}
+
+func TestConfigAssumeRoleChainExpiring(t *testing.T) {
+	fake := &fakeAssumeRole{clock: newFakeClock(), lifetime: time.Hour}
+	config := newAssumeRoleChainConfig(fake, "hub", "workload")
+	assert.Nil(t, config.RefreshAuthCredential())
+	fake.clock.Advance(58 * time.Minute)
+	assert.Nil(t, config.RefreshAuthCredential())
+	// The chain is walked again from the source credentials
+	assert.Equal(t, []string{"hub by CIAccessKeyId", "workload by STS.hub.1", "hub by CIAccessKeyId", "workload by STS.hub.3"}, fake.assumed)
+	assert.Equal(t, "STS.workload.4", config.AccessKey)
+}
+
+func TestConfigAssumeRoleChainError(t *testing.T) {
+	fake := &fakeAssumeRole{clock: newFakeClock(), lifetime: time.Hour}
+	config := newAssumeRoleChainConfig(fake, "hub", "acs:ram::0:role/denied")
+	err := config.RefreshAuthCredential()
+	assert.Contains(t, err.Error(), "assuming the role acs:ram::0:role/denied of the assume_role 1 got an error: NoPermission")
+	assert.Equal(t, "CIAccessKeyId", config.AccessKey)
+}
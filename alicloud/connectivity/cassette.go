@@ -0,0 +1,361 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const (
+	// CassetteModeRecord records all of the API requests and responses into the cassette file.
+	CassetteModeRecord = "record"
+	// CassetteModeReplay serves the API responses from the cassette file without sending any request.
+	CassetteModeReplay = "replay"
+)
+
+const (
+	interactionKindRpc     = "rpc"
+	interactionKindRoa     = "roa"
+	interactionKindOpenapi = "openapi"
+	interactionKindHttp    = "http"
+)
+
+const scrubbedValue = "******"
+
+// sensitiveKeyPattern matches the parameter and header names whose values should not be written into the cassette.
+// The pagination and idempotence tokens, like NextToken and ClientToken, are kept.
+var sensitiveKeyPattern = regexp.MustCompile(`(?i)(accesskey|secret|securitytoken|security-token|sessiontoken|accesstoken|^token$|signature|password|authorization|kubeconfig|privatekey|private_key)`)
+
+// Interaction is a pair of API request and response recorded in the cassette.
+// The requests are matched by Kind, ApiProductCode, ApiName, Method and Path in the recorded order when replaying.
+type Interaction struct {
+	Kind           string                 `json:"kind"`
+	ApiProductCode string                 `json:"api_product_code"`
+	ApiVersion     string                 `json:"api_version,omitempty"`
+	ApiName        string                 `json:"api_name,omitempty"`
+	Method         string                 `json:"method"`
+	Path           string                 `json:"path,omitempty"`
+	Request        map[string]interface{} `json:"request,omitempty"`
+	Response       map[string]interface{} `json:"response,omitempty"`
+	Error          *InteractionError      `json:"error,omitempty"`
+	StatusCode     int                    `json:"status_code,omitempty"`
+	ContentType    string                 `json:"content_type,omitempty"`
+	Body           string                 `json:"body,omitempty"`
+}
+
+// InteractionError is the error returned by a recorded API request.
+type InteractionError struct {
+	SDKError   bool        `json:"sdk_error"`
+	Code       string      `json:"code,omitempty"`
+	Message    string      `json:"message"`
+	StatusCode int         `json:"status_code,omitempty"`
+	Data       interface{} `json:"data,omitempty"`
+}
+
+// Cassette records the API interactions into a file and replays them.
+type Cassette struct {
+	mode         string
+	path         string
+	mutex        sync.Mutex
+	Interactions []*Interaction `json:"interactions"`
+	played       []bool
+}
+
+var cassettes = struct {
+	sync.Mutex
+	byPath map[string]*Cassette
+}{byPath: make(map[string]*Cassette)}
+
+// loadCassette returns the cassette of the path. The clients using the same path share one cassette,
+// so all of the provider configurations in one test process record into and replay from the same file.
+func loadCassette(mode, path string) (*Cassette, error) {
+	if mode != CassetteModeRecord && mode != CassetteModeReplay {
+		return nil, fmt.Errorf("invalid cassette mode %q, valid values: %s, %s", mode, CassetteModeRecord, CassetteModeReplay)
+	}
+	if path == "" {
+		return nil, fmt.Errorf("the cassette path is required in the cassette mode %s", mode)
+	}
+	cassettes.Lock()
+	defer cassettes.Unlock()
+	if c, ok := cassettes.byPath[path]; ok {
+		if c.mode != mode {
+			return nil, fmt.Errorf("the cassette %s has been loaded in the mode %s", path, c.mode)
+		}
+		return c, nil
+	}
+	c := &Cassette{mode: mode, path: path}
+	if mode == CassetteModeReplay {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading the cassette %s got an error: %#v", path, err)
+		}
+		// The Tea SDK decodes the response numbers as json.Number, so do the replayed responses.
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(c); err != nil {
+			return nil, fmt.Errorf("parsing the cassette %s got an error: %#v", path, err)
+		}
+		c.played = make([]bool, len(c.Interactions))
+	}
+	cassettes.byPath[path] = c
+	log.Printf("[INFO] Using the cassette %s in the %s mode.", path, mode)
+	return c, nil
+}
+
+// do replays the interaction from the cassette, or invokes the request and records it.
+func (c *Cassette) do(interaction *Interaction, invoke func() (map[string]interface{}, error)) (map[string]interface{}, error) {
+	if c.mode == CassetteModeReplay {
+		recorded, err := c.replay(interaction)
+		if err != nil {
+			return nil, err
+		}
+		return recorded.Response, recorded.Error.toError()
+	}
+	response, err := invoke()
+	interaction.Response = scrubMap(response)
+	interaction.Error = newInteractionError(err)
+	if e := c.record(interaction); e != nil {
+		log.Printf("[WARN] recording the %s %s interaction into the cassette %s failed. Error: %v", interaction.ApiProductCode, interaction.ApiName, c.path, e)
+	}
+	return response, err
+}
+
+// replay returns the first interaction not played yet which matches the request.
+func (c *Cassette) replay(interaction *Interaction) (*Interaction, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for i, recorded := range c.Interactions {
+		if c.played[i] || recorded.Kind != interaction.Kind || recorded.ApiProductCode != interaction.ApiProductCode ||
+			recorded.ApiName != interaction.ApiName || recorded.Method != interaction.Method || recorded.Path != interaction.Path {
+			continue
+		}
+		c.played[i] = true
+		return recorded, nil
+	}
+	return nil, fmt.Errorf("the cassette %s has no more %s interaction for %s %s %s %s", c.path, interaction.Kind, interaction.ApiProductCode, interaction.Method, interaction.ApiName, interaction.Path)
+}
+
+// record appends the interaction and writes all of the interactions into the cassette file.
+func (c *Cassette) record(interaction *Interaction) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.Interactions = append(c.Interactions, interaction)
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(c.path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(c.path, data, 0644)
+}
+
+// cassetteTransport records and replays the HTTP requests sent by the SDK clients built with getSdkConfig.
+type cassetteTransport struct {
+	cassette *Cassette
+	base     http.RoundTripper
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	interaction := &Interaction{
+		Kind:           interactionKindHttp,
+		ApiProductCode: req.URL.Host,
+		ApiVersion:     req.URL.Query().Get("Version"),
+		ApiName:        req.URL.Query().Get("Action"),
+		Method:         req.Method,
+		Path:           req.URL.Path,
+		Request:        scrubValues(req.URL.Query()),
+	}
+	if interaction.ApiName == "" {
+		interaction.ApiName = req.Header.Get("x-acs-action")
+	}
+	if interaction.ApiVersion == "" {
+		interaction.ApiVersion = req.Header.Get("x-acs-version")
+	}
+	if t.cassette.mode == CassetteModeReplay {
+		recorded, err := t.cassette.replay(interaction)
+		if err != nil {
+			return nil, err
+		}
+		if recorded.Error != nil {
+			return nil, recorded.Error.toError()
+		}
+		header := make(http.Header)
+		header.Set("Content-Type", recorded.ContentType)
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if values, err := url.ParseQuery(string(body)); err == nil && strings.Contains(req.Header.Get("Content-Type"), "form") {
+			for key, value := range scrubValues(values) {
+				interaction.Request[key] = value
+			}
+		} else if len(body) > 0 {
+			interaction.Request["body"] = scrubBody(string(body))
+		}
+	}
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		interaction.Error = newInteractionError(err)
+		if e := t.cassette.record(interaction); e != nil {
+			log.Printf("[WARN] recording the %s %s interaction into the cassette %s failed. Error: %v", interaction.ApiProductCode, interaction.ApiName, t.cassette.path, e)
+		}
+		return resp, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	interaction.StatusCode = resp.StatusCode
+	interaction.ContentType = resp.Header.Get("Content-Type")
+	interaction.Body = scrubBody(string(body))
+	if e := t.cassette.record(interaction); e != nil {
+		log.Printf("[WARN] recording the %s %s interaction into the cassette %s failed. Error: %v", interaction.ApiProductCode, interaction.ApiName, t.cassette.path, e)
+	}
+	return resp, nil
+}
+
+func newInteractionError(err error) *InteractionError {
+	if err == nil {
+		return nil
+	}
+	if e, ok := err.(*tea.SDKError); ok {
+		result := &InteractionError{
+			SDKError:   true,
+			Code:       tea.StringValue(e.Code),
+			Message:    tea.StringValue(e.Message),
+			StatusCode: tea.IntValue(e.StatusCode),
+		}
+		var data interface{}
+		if json.Unmarshal([]byte(tea.StringValue(e.Data)), &data) == nil {
+			result.Data = scrubValue("", data)
+		}
+		return result
+	}
+	return &InteractionError{Message: err.Error()}
+}
+
+func (e *InteractionError) toError() error {
+	if e == nil {
+		return nil
+	}
+	if !e.SDKError {
+		return fmt.Errorf("%s", e.Message)
+	}
+	result := &tea.SDKError{
+		Code:       tea.String(e.Code),
+		Message:    tea.String(e.Message),
+		StatusCode: tea.Int(e.StatusCode),
+	}
+	if e.Data != nil {
+		data, _ := json.Marshal(e.Data)
+		result.Data = tea.String(string(data))
+	}
+	return result
+}
+
+func scrubMap(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	return scrubValue("", m).(map[string]interface{})
+}
+
+// scrubValue returns a copy of the value whose sensitive fields are replaced.
+func scrubValue(key string, value interface{}) interface{} {
+	if key != "" && sensitiveKeyPattern.MatchString(key) {
+		if v, ok := value.(string); ok && v != "" {
+			return scrubbedValue
+		}
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			result[k] = scrubValue(k, item)
+		}
+		return result
+	case map[string]*string:
+		result := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			result[k] = scrubValue(k, tea.StringValue(item))
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = scrubValue(key, item)
+		}
+		return result
+	}
+	return value
+}
+
+func scrubValues(values url.Values) map[string]interface{} {
+	result := make(map[string]interface{}, len(values))
+	for key := range values {
+		result[key] = scrubValue(key, values.Get(key))
+	}
+	return result
+}
+
+// scrubBody scrubs the JSON body, and the other formats are kept as they are.
+func scrubBody(body string) string {
+	var data interface{}
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		return body
+	}
+	result, err := json.Marshal(scrubValue("", data))
+	if err != nil {
+		return body
+	}
+	return string(result)
+}
+
+// interactionRequest converts the API parameters to a map which can be recorded in the cassette.
+func interactionRequest(params map[string]interface{}) map[string]interface{} {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil
+	}
+	return scrubMap(result)
+}
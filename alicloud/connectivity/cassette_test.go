@@ -0,0 +1,125 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "vpc.json")
+	server := NewMockServer()
+	server.Register("Vpc", "CreateVpc", MockResponse{
+		Body: map[string]interface{}{"VpcId": "vpc-mock", "ResourceGroupId": "rg-mock"},
+	})
+	server.Register("Vpc", "DescribeVpcAttribute", MockResponse{
+		StatusCode: http.StatusNotFound,
+		Body:       map[string]interface{}{"Code": "InvalidVpcId.NotFound", "Message": "The specified vpc is not found."},
+	})
+	server.Register("CS", "/clusters/c-mock", MockResponse{
+		Body: map[string]interface{}{"cluster_id": "c-mock", "size": 3},
+	})
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+	client.config.CassetteMode = CassetteModeRecord
+	client.config.CassettePath = path
+	client.cassette, err = loadCassette(CassetteModeRecord, path)
+	assert.Nil(t, err)
+
+	body := map[string]interface{}{"VpcName": "tf-test", "Password": "Test123456"}
+	_, err = client.RpcPost("Vpc", "2016-04-28", "CreateVpc", nil, body, false)
+	assert.Nil(t, err)
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DescribeVpcAttribute", nil, map[string]interface{}{"VpcId": "vpc-mock"}, false)
+	assert.NotNil(t, err)
+	_, err = client.RoaGet("CS", "2015-12-15", "/clusters/c-mock", nil, nil, nil)
+	assert.Nil(t, err)
+	server.Close()
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(string(data), "Test123456"))
+	assert.False(t, strings.Contains(string(data), "MockAccessKey"))
+
+	// Replay the interactions from a new cassette without the server
+	cassettes.Lock()
+	delete(cassettes.byPath, path)
+	cassettes.Unlock()
+	client.cassette, err = loadCassette(CassetteModeReplay, path)
+	assert.Nil(t, err)
+
+	response, err := client.RpcPost("Vpc", "2016-04-28", "CreateVpc", nil, body, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "vpc-mock", response["VpcId"])
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DescribeVpcAttribute", nil, map[string]interface{}{"VpcId": "vpc-mock"}, false)
+	assert.NotNil(t, err)
+	assert.Equal(t, "InvalidVpcId.NotFound", tea.StringValue(err.(*tea.SDKError).Code))
+	assert.Equal(t, 404, tea.IntValue(err.(*tea.SDKError).StatusCode))
+	response, err = client.RoaGet("CS", "2015-12-15", "/clusters/c-mock", nil, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, json.Number("3"), response["size"])
+
+	_, err = client.RpcPost("Vpc", "2016-04-28", "CreateVpc", nil, body, false)
+	assert.NotNil(t, err)
+}
+
+func TestCassetteTransport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ecs.json")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"RequestId":"request-id","Instances":{"Instance":[{"InstanceId":"i-mock"}]}}`))
+	}))
+	cassette, err := loadCassette(CassetteModeRecord, path)
+	assert.Nil(t, err)
+	httpClient := &http.Client{Transport: &cassetteTransport{cassette: cassette}}
+	resp, err := httpClient.Get(server.URL + "/?Action=DescribeInstances&Version=2014-05-26&AccessKeyId=ak&Signature=signature")
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	server.Close()
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(string(data), "signature"))
+
+	cassettes.Lock()
+	delete(cassettes.byPath, path)
+	cassettes.Unlock()
+	cassette, err = loadCassette(CassetteModeReplay, path)
+	assert.Nil(t, err)
+	httpClient = &http.Client{Transport: &cassetteTransport{cassette: cassette}}
+	resp, err = httpClient.Get(server.URL + "/?Action=DescribeInstances&Version=2014-05-26&AccessKeyId=ak&Signature=another")
+	assert.Nil(t, err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Contains(t, string(body), "i-mock")
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+}
+
+func TestCassetteScrub(t *testing.T) {
+	scrubbed := scrubMap(map[string]interface{}{
+		"AccessKeyId": "ak",
+		"NextToken":   "next",
+		"Credentials": map[string]interface{}{
+			"AccessKeySecret": "secret",
+			"SecurityToken":   "token",
+		},
+		"Accounts": []interface{}{
+			map[string]interface{}{"AccountPassword": "password", "AccountName": "name"},
+		},
+		"Signature": "",
+	})
+	assert.Equal(t, scrubbedValue, scrubbed["AccessKeyId"])
+	assert.Equal(t, "next", scrubbed["NextToken"])
+	assert.Equal(t, scrubbedValue, scrubbed["Credentials"].(map[string]interface{})["AccessKeySecret"])
+	assert.Equal(t, scrubbedValue, scrubbed["Credentials"].(map[string]interface{})["SecurityToken"])
+	assert.Equal(t, scrubbedValue, scrubbed["Accounts"].([]interface{})[0].(map[string]interface{})["AccountPassword"])
+	assert.Equal(t, "name", scrubbed["Accounts"].([]interface{})[0].(map[string]interface{})["AccountName"])
+	assert.Equal(t, "", scrubbed["Signature"])
+}
@@ -84,16 +84,25 @@ type AliyunClient struct {
 	SecureTransport      string
 	skipRegionValidation bool
 	//In order to build ots table client, add accesskey and secretkey in aliyunclient temporarily.
-	AccessKey                    string
-	SecretKey                    string
-	SecurityToken                string
-	OtsInstanceName              string
-	accountIdMutex               sync.RWMutex
-	config                       *Config
-	teaSdkConfig                 rpc.Config
-	teaRoaSdkConfig              roa.Config
-	teaRpcOpenapiConfig          openapi.Config
-	teaRoaOpenapiConfig          openapi.Config
+	AccessKey           string
+	SecretKey           string
+	SecurityToken       string
+	OtsInstanceName     string
+	accountIdMutex      sync.RWMutex
+	config              *Config
+	teaSdkConfig        rpc.Config
+	teaRoaSdkConfig     roa.Config
+	teaRpcOpenapiConfig openapi.Config
+	teaRoaOpenapiConfig openapi.Config
+	cassette            *Cassette
+	rateLimiter         *RateLimiter
+	tracer              *Tracer
+	permissionRecorder  *PermissionRecorder
+	productProtocols    sync.Map
+	endpointCache       *EndpointCache
+	teaClientPool       *teaClientPool
+	// connectionStates are the locks and the credential generations of the cached connections
+	connectionStates             sync.Map
 	accountId                    string
 	ecsconn                      *ecs.Client
 	essconn                      *ess.Client
@@ -261,6 +270,33 @@ func (c *Config) Client() (*AliyunClient, error) {
 		otsTunnelConnByInstanceName:  make(map[string]otsTunnel.TunnelClient),
 		csprojectconnByKey:           make(map[string]*cs.ProjectClient),
 		skipRegionValidation:         c.SkipRegionValidation,
+		teaClientPool:                newTeaClientPool(),
+	}
+	if c.RateLimit != nil {
+		client.rateLimiter = NewRateLimiter(*c.RateLimit)
+	}
+	if c.TraceFile != "" || c.TraceEndpoint != "" {
+		client.tracer, err = NewTracer(c.TraceFile, c.TraceEndpoint, c.RegionId, c.TerraformTraceId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c.EndpointCache != nil {
+		client.endpointCache = NewEndpointCache(*c.EndpointCache)
+	}
+	if c.PermissionReportFile != "" {
+		client.permissionRecorder = NewPermissionRecorder(c.PermissionReportFile)
+	}
+	if c.EndpointsFile != "" {
+		if err := client.loadEndpointFile(c.EndpointsFile); err != nil {
+			return nil, err
+		}
+	}
+	if c.CassetteMode != "" {
+		client.cassette, err = loadCassette(c.CassetteMode, c.CassettePath)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if c.AccountType == "" {
 		c.AccountType = client.getAccountType()
@@ -271,210 +307,246 @@ func (c *Config) Client() (*AliyunClient, error) {
 }
 
 func (client *AliyunClient) WithEcsClient(do func(*ecs.Client) (interface{}, error)) (interface{}, error) {
-	if client.ecsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.ecsconn)
-	}
-	product := "ecs"
-	endpoint, err := client.loadApiEndpoint(product)
+	ecsconn, err := cachedConnection(client, &client.ecsconn, func() (*ecs.Client, error) {
+		product := "ecs"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		ecsconn, err := ecs.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(time.Duration(60)*time.Second), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the ECS client: %#v", err)
+		}
+		ecs.SetClientProperty(ecsconn, "EndpointMap", map[string]string{
+			client.RegionId: endpoint,
+		})
+		ecs.SetEndpointDataToClient(ecsconn)
+
+		ecsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		ecsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		ecsconn.SourceIp = client.config.SourceIp
+		ecsconn.SecureTransport = client.config.SecureTransport
+		return ecsconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	ecsconn, err := ecs.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(time.Duration(60)*time.Second), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the ECS client: %#v", err)
-	}
-	ecs.SetClientProperty(ecsconn, "EndpointMap", map[string]string{
-		client.RegionId: endpoint,
-	})
-	ecs.SetEndpointDataToClient(ecsconn)
-
-	ecsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	ecsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	ecsconn.SourceIp = client.config.SourceIp
-	ecsconn.SecureTransport = client.config.SecureTransport
-	client.ecsconn = ecsconn
-	return do(client.ecsconn)
+	return do(ecsconn)
 }
 
 func (client *AliyunClient) WithOfficalCSClient(do func(*officalCS.Client) (interface{}, error)) (interface{}, error) {
-	if client.officalCSConn != nil && !client.config.needRefreshCredential() {
-		return do(client.officalCSConn)
-	}
-	product := "cs"
-	endpoint, err := client.loadApiEndpoint(product)
+	officalCSConn, err := cachedConnection(client, &client.officalCSConn, func() (*officalCS.Client, error) {
+		product := "cs"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		csconn, err := officalCS.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the CS client: %#v", err)
+		}
+		csconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		csconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		csconn.SourceIp = client.config.SourceIp
+		csconn.SecureTransport = client.config.SecureTransport
+		return csconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	csconn, err := officalCS.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the CS client: %#v", err)
-	}
-	csconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	csconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	csconn.SourceIp = client.config.SourceIp
-	csconn.SecureTransport = client.config.SecureTransport
-	client.officalCSConn = csconn
-	return do(client.officalCSConn)
+	return do(officalCSConn)
 }
 
 func (client *AliyunClient) WithPolarDBClient(do func(*polardb.Client) (interface{}, error)) (interface{}, error) {
-	if client.polarDBconn != nil && !client.config.needRefreshCredential() {
-		return do(client.polarDBconn)
-	}
-	product := "polardb"
-	endpoint, err := client.loadApiEndpoint(product)
+	polarDBconn, err := cachedConnection(client, &client.polarDBconn, func() (*polardb.Client, error) {
+		product := "polardb"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		polarDBconn, err := polardb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the PolarDB client: %#v", err)
+
+		}
+		polarDBconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		polarDBconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		polarDBconn.SourceIp = client.config.SourceIp
+		polarDBconn.SecureTransport = client.config.SecureTransport
+		return polarDBconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	polarDBconn, err := polardb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the PolarDB client: %#v", err)
-
-	}
-	polarDBconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	polarDBconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	polarDBconn.SourceIp = client.config.SourceIp
-	polarDBconn.SecureTransport = client.config.SecureTransport
-	client.polarDBconn = polarDBconn
-	return do(client.polarDBconn)
+	return do(polarDBconn)
 }
 
 func (client *AliyunClient) WithSlbClient(do func(*slb.Client) (interface{}, error)) (interface{}, error) {
-	if client.slbconn != nil && !client.config.needRefreshCredential() {
-		return do(client.slbconn)
-	}
-	product := "slb"
-	endpoint, err := client.loadApiEndpoint(product)
+	slbconn, err := cachedConnection(client, &client.slbconn, func() (*slb.Client, error) {
+		product := "slb"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		slbconn, err := slb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the SLB client: %#v", err)
+		}
+		slbconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		slbconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		slbconn.SourceIp = client.config.SourceIp
+		slbconn.SecureTransport = client.config.SecureTransport
+		return slbconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	slbconn, err := slb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the SLB client: %#v", err)
-	}
-	slbconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	slbconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	slbconn.SourceIp = client.config.SourceIp
-	slbconn.SecureTransport = client.config.SecureTransport
-	client.slbconn = slbconn
-	return do(client.slbconn)
+	return do(slbconn)
 }
 
 func (client *AliyunClient) WithVpcClient(do func(*vpc.Client) (interface{}, error)) (interface{}, error) {
-	if client.vpcconn != nil && !client.config.needRefreshCredential() {
-		return do(client.vpcconn)
-	}
-	product := "vpc"
-	endpoint, err := client.loadApiEndpoint(product)
+	vpcconn, err := cachedConnection(client, &client.vpcconn, func() (*vpc.Client, error) {
+		product := "vpc"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		vpcconn, err := vpc.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(time.Duration(60)*time.Second), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the VPC client: %#v", err)
+		}
+		vpcconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		vpcconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		vpcconn.SourceIp = client.config.SourceIp
+		vpcconn.SecureTransport = client.config.SecureTransport
+		return vpcconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	vpcconn, err := vpc.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(time.Duration(60)*time.Second), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the VPC client: %#v", err)
-	}
-	vpcconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	vpcconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	vpcconn.SourceIp = client.config.SourceIp
-	vpcconn.SecureTransport = client.config.SecureTransport
-	client.vpcconn = vpcconn
-	return do(client.vpcconn)
+	return do(vpcconn)
 }
 
 func (client *AliyunClient) WithEssClient(do func(*ess.Client) (interface{}, error)) (interface{}, error) {
-	if client.essconn != nil && !client.config.needRefreshCredential() {
-		return do(client.essconn)
-	}
-	product := "ess"
-	endpoint, err := client.loadApiEndpoint(product)
+	essconn, err := cachedConnection(client, &client.essconn, func() (*ess.Client, error) {
+		product := "ess"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		essconn, err := ess.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the ESS client: %#v", err)
+		}
+		essconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		essconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		essconn.SourceIp = client.config.SourceIp
+		essconn.SecureTransport = client.config.SecureTransport
+		return essconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	essconn, err := ess.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the ESS client: %#v", err)
-	}
-	essconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	essconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	essconn.SourceIp = client.config.SourceIp
-	essconn.SecureTransport = client.config.SecureTransport
-	client.essconn = essconn
-	return do(client.essconn)
+	return do(essconn)
 }
 
 func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, error)) (interface{}, error) {
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
-	if client.ossconn != nil && !client.config.needRefreshCredential() {
-		return do(client.ossconn)
-	}
-	product := "oss"
-	endpoint, err := client.loadApiEndpoint(product)
-	if err != nil {
-		return nil, err
-	}
-	schma := strings.ToLower(client.config.Protocol)
-	if !strings.HasPrefix(endpoint, "http") {
-		endpoint = fmt.Sprintf("%s://%s", schma, endpoint)
-	}
-
-	clientOptions := []oss.ClientOption{oss.UserAgent(client.config.getUserAgent())}
-	proxy, err := client.getHttpProxy()
-	if proxy != nil {
-		skip, err := client.skipProxy(endpoint)
+	ossconn, err := cachedConnection(client, &client.ossconn, func() (*oss.Client, error) {
+		product := "oss"
+		endpoint, err := client.loadApiEndpoint(product)
 		if err != nil {
 			return nil, err
 		}
-		if !skip {
-			clientOptions = append(clientOptions, oss.Proxy(proxy.String()))
+		schma := strings.ToLower(client.config.Protocol)
+		if !strings.HasPrefix(endpoint, "http") {
+			endpoint = fmt.Sprintf("%s://%s", schma, endpoint)
 		}
-	}
-
-	clientOptions = append(clientOptions, oss.SetCredentialsProvider(&ossCredentialsProvider{client: client}))
 
-	// region
-	clientOptions = append(clientOptions, oss.Region(client.config.RegionId))
+		clientOptions := []oss.ClientOption{oss.UserAgent(client.config.getUserAgent())}
+		proxy, err := client.getHttpProxy()
+		if proxy != nil {
+			skip, err := client.skipProxy(endpoint)
+			if err != nil {
+				return nil, err
+			}
+			if !skip {
+				clientOptions = append(clientOptions, oss.Proxy(proxy.String()))
+			} else {
+				proxy = nil
+			}
+		}
 
-	// SignVersion
-	if ossV, ok := client.config.SignVersion.Load("oss"); ok {
-		clientOptions = append(clientOptions, oss.AuthVersion(func(v any) oss.AuthVersionType {
-			switch fmt.Sprintf("%v", v) {
-			case "v4":
-				return oss.AuthV4
-			case "v2":
-				return oss.AuthV2
+		// The OSS SDK sends the requests with its own HTTP client, so it is replaced to record the permissions.
+		if client.permissionRecorder != nil {
+			transport := client.getTransport()
+			if proxy != nil {
+				transport.Proxy = http.ProxyURL(proxy)
 			}
-			//default is v1
-			return oss.AuthV1
-		}(ossV)))
-	}
+			serviceHost := ""
+			if u, err := url.Parse(endpoint); err == nil {
+				serviceHost = u.Hostname()
+			}
+			clientOptions = append(clientOptions, oss.HTTPClient(&http.Client{
+				Transport: &permissionTransport{client: client, base: transport, apiName: func(req *http.Request) (string, string) {
+					return "Oss", ossApiName(req, serviceHost)
+				}},
+				CheckRedirect: func(*http.Request, []*http.Request) error {
+					return http.ErrUseLastResponse
+				},
+			}))
+		}
+
+		clientOptions = append(clientOptions, oss.SetCredentialsProvider(&ossCredentialsProvider{client: client}))
+
+		// region
+		clientOptions = append(clientOptions, oss.Region(client.config.RegionId))
 
-	ossconn, err := oss.New(endpoint, "", "", clientOptions...)
+		// SignVersion
+		if ossV, ok := client.config.SignVersion.Load("oss"); ok {
+			clientOptions = append(clientOptions, oss.AuthVersion(func(v any) oss.AuthVersionType {
+				switch fmt.Sprintf("%v", v) {
+				case "v4":
+					return oss.AuthV4
+				case "v2":
+					return oss.AuthV2
+				}
+				//default is v1
+				return oss.AuthV1
+			}(ossV)))
+		}
+
+		ossconn, err := oss.New(endpoint, "", "", clientOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the OSS client: %#v", err)
+		}
+
+		return ossconn, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the OSS client: %#v", err)
+		return nil, err
 	}
-
-	client.ossconn = ossconn
-	return do(client.ossconn)
+	return do(ossconn)
 }
 
 func (client *AliyunClient) WithOssBucketByName(bucketName string, do func(*oss.Bucket) (interface{}, error)) (interface{}, error) {
@@ -488,79 +560,84 @@ func (client *AliyunClient) WithOssBucketByName(bucketName string, do func(*oss.
 }
 
 func (client *AliyunClient) WithDnsClient(do func(*alidns.Client) (interface{}, error)) (interface{}, error) {
-	if client.dnsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.dnsconn)
-	}
-	product := "alidns"
-	endpoint, err := client.loadApiEndpoint(product)
-	if err != nil {
-		return nil, err
-	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, string(DNSCode), endpoint)
-	}
+	dnsconn, err := cachedConnection(client, &client.dnsconn, func() (*alidns.Client, error) {
+		product := "alidns"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, string(DNSCode), endpoint)
+		}
 
-	dnsconn, err := alidns.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		dnsconn, err := alidns.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the DNS client: %#v", err)
+		}
+		dnsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		dnsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		dnsconn.SourceIp = client.config.SourceIp
+		dnsconn.SecureTransport = client.config.SecureTransport
+		return dnsconn, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the DNS client: %#v", err)
+		return nil, err
 	}
-	dnsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	dnsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	dnsconn.SourceIp = client.config.SourceIp
-	dnsconn.SecureTransport = client.config.SecureTransport
-	client.dnsconn = dnsconn
-	return do(client.dnsconn)
+	return do(dnsconn)
 }
 
 func (client *AliyunClient) WithRamClient(do func(*ram.Client) (interface{}, error)) (interface{}, error) {
-	if client.ramconn != nil && !client.config.needRefreshCredential() {
-		return do(client.ramconn)
-	}
-	product := "ram"
-	endpoint, err := client.loadApiEndpoint(product)
+	ramconn, err := cachedConnection(client, &client.ramconn, func() (*ram.Client, error) {
+		product := "ram"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		ramconn, err := ram.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the RAM client: %#v", err)
+		}
+		ramconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		ramconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		ramconn.SourceIp = client.config.SourceIp
+		ramconn.SecureTransport = client.config.SecureTransport
+		return ramconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	ramconn, err := ram.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the RAM client: %#v", err)
-	}
-	ramconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	ramconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	ramconn.SourceIp = client.config.SourceIp
-	ramconn.SecureTransport = client.config.SecureTransport
-	client.ramconn = ramconn
-
-	return do(client.ramconn)
+	return do(ramconn)
 }
 
 func (client *AliyunClient) WithCsClient(do func(*cs.Client) (interface{}, error)) (interface{}, error) {
-	if client.csconn != nil && !client.config.needRefreshCredential() {
-		return do(client.csconn)
-	}
-	product := "cs"
-	endpoint, err := client.loadApiEndpoint(product)
+	csconn, err := cachedConnection(client, &client.csconn, func() (*cs.Client, error) {
+		product := "cs"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = fmt.Sprintf("https://%s", endpoint)
+		accessKey, secretKey, stsToken := client.config.AccessKey, client.config.SecretKey, client.config.SecurityToken
+		credential, err := client.config.Credential.GetCredential()
+		if err != nil || credential == nil {
+			log.Printf("[WARN] get credential failed. Error: %#v", err)
+		} else {
+			accessKey, secretKey, stsToken = *credential.AccessKeyId, *credential.AccessKeySecret, *credential.SecurityToken
+		}
+		csconn := cs.NewClientForAussumeRole(accessKey, secretKey, stsToken)
+		csconn.SetUserAgent(client.config.getUserAgent())
+		csconn.SetEndpoint(endpoint)
+		csconn.SetSourceIp(client.config.SourceIp)
+		csconn.SetSecureTransport(client.config.SecureTransport)
+		return csconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	endpoint = fmt.Sprintf("https://%s", endpoint)
-	accessKey, secretKey, stsToken := client.config.AccessKey, client.config.SecretKey, client.config.SecurityToken
-	credential, err := client.config.Credential.GetCredential()
-	if err != nil || credential == nil {
-		log.Printf("[WARN] get credential failed. Error: %#v", err)
-	} else {
-		accessKey, secretKey, stsToken = *credential.AccessKeyId, *credential.AccessKeySecret, *credential.SecurityToken
-	}
-	csconn := cs.NewClientForAussumeRole(accessKey, secretKey, stsToken)
-	csconn.SetUserAgent(client.config.getUserAgent())
-	csconn.SetEndpoint(endpoint)
-	csconn.SetSourceIp(client.config.SourceIp)
-	csconn.SetSecureTransport(client.config.SecureTransport)
-	client.csconn = csconn
-	return do(client.csconn)
+	return do(csconn)
 }
 
 func (client *AliyunClient) NewRoaCsClient() (*roaCS.Client, error) {
@@ -601,53 +678,55 @@ func (client *AliyunClient) NewRoaCsClient() (*roaCS.Client, error) {
 }
 
 func (client *AliyunClient) WithCrClient(do func(*cr.Client) (interface{}, error)) (interface{}, error) {
-	if client.crconn != nil && !client.config.needRefreshCredential() {
-		return do(client.crconn)
-	}
-	product := "cr"
-	endpoint, err := client.loadApiEndpoint(product)
+	crconn, err := cachedConnection(client, &client.crconn, func() (*cr.Client, error) {
+		product := "cr"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, string(CRCode), endpoint)
+		}
+		crconn, err := cr.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the CR client: %#v", err)
+		}
+		crconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		crconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		crconn.SourceIp = client.config.SourceIp
+		crconn.SecureTransport = client.config.SecureTransport
+		return crconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, string(CRCode), endpoint)
-	}
-	crconn, err := cr.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the CR client: %#v", err)
-	}
-	crconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	crconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	crconn.SourceIp = client.config.SourceIp
-	crconn.SecureTransport = client.config.SecureTransport
-	client.crconn = crconn
-
-	return do(client.crconn)
+	return do(crconn)
 }
 
 func (client *AliyunClient) WithCrEEClient(do func(*cr_ee.Client) (interface{}, error)) (interface{}, error) {
-	if client.creeconn != nil && !client.config.needRefreshCredential() {
-		return do(client.creeconn)
-	}
-	product := "cr"
-	endpoint, err := client.loadApiEndpoint(product)
+	creeconn, err := cachedConnection(client, &client.creeconn, func() (*cr_ee.Client, error) {
+		product := "cr"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, string(CRCode), endpoint)
+		}
+		creeconn, err := cr_ee.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the CR EE client: %#v", err)
+		}
+		creeconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		creeconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		creeconn.SourceIp = client.config.SourceIp
+		creeconn.SecureTransport = client.config.SecureTransport
+		return creeconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, string(CRCode), endpoint)
-	}
-	creeconn, err := cr_ee.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the CR EE client: %#v", err)
-	}
-	creeconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	creeconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	creeconn.SourceIp = client.config.SourceIp
-	creeconn.SecureTransport = client.config.SecureTransport
-	client.creeconn = creeconn
-
-	return do(client.creeconn)
+	return do(creeconn)
 }
 
 func (client *AliyunClient) WithCdnClient(do func(*cdn.CdnClient) (interface{}, error)) (interface{}, error) {
@@ -655,11 +734,14 @@ func (client *AliyunClient) WithCdnClient(do func(*cdn.CdnClient) (interface{},
 	defer goSdkMutex.Unlock()
 
 	// Initialize the CDN client if necessary
-	if client.cdnconn == nil {
-		cdnconn := cdn.NewClient(client.config.AccessKey, client.config.SecretKey)
+	state := client.connectionState(&client.cdnconn)
+	generation, upToDate := client.upToDate(state)
+	if client.cdnconn == nil || !upToDate {
+		accessKey, secretKey, token := client.config.GetRefreshCredential()
+		cdnconn := cdn.NewClient(accessKey, secretKey)
 		cdnconn.SetBusinessInfo(businessInfoKey)
 		cdnconn.SetUserAgent(client.getUserAgent())
-		cdnconn.SetSecurityToken(client.config.SecurityToken)
+		cdnconn.SetSecurityToken(token)
 		endpoint := client.config.CdnEndpoint
 		if endpoint == "" {
 			endpoint = loadEndpoint(client.config.RegionId, CDNCode)
@@ -667,61 +749,63 @@ func (client *AliyunClient) WithCdnClient(do func(*cdn.CdnClient) (interface{},
 		if endpoint != "" && !strings.HasPrefix(endpoint, "http") {
 			cdnconn.SetEndpoint(fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://")))
 		}
-		client.cdnconn = cdnconn
+		client.cdnconn, state.generation = cdnconn, generation
 	}
 	return do(client.cdnconn)
 }
 
 func (client *AliyunClient) WithCdnClient_new(do func(*cdn_new.Client) (interface{}, error)) (interface{}, error) {
-	if client.cdnconn_new != nil && !client.config.needRefreshCredential() {
-		return do(client.cdnconn_new)
-	}
-	product := "cdn"
-	endpoint, err := client.loadApiEndpoint(product)
+	cdnconn_new, err := cachedConnection(client, &client.cdnconn_new, func() (*cdn_new.Client, error) {
+		product := "cdn"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, string(CDNCode), endpoint)
+		}
+		cdnconn, err := cdn_new.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the CDN client: %#v", err)
+		}
+		cdnconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		cdnconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		cdnconn.SourceIp = client.config.SourceIp
+		cdnconn.SecureTransport = client.config.SecureTransport
+		return cdnconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, string(CDNCode), endpoint)
-	}
-	cdnconn, err := cdn_new.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the CDN client: %#v", err)
-	}
-	cdnconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	cdnconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	cdnconn.SourceIp = client.config.SourceIp
-	cdnconn.SecureTransport = client.config.SecureTransport
-	client.cdnconn_new = cdnconn
-
-	return do(client.cdnconn_new)
+	return do(cdnconn_new)
 }
 
 // WithOtsClient init ots openapi publish sdk client(if necessary), and exec do func by client
 func (client *AliyunClient) WithOtsClient(do func(*ots.Client) (interface{}, error)) (interface{}, error) {
-	if client.otsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.otsconn)
-	}
-	product := "ots"
-	endpoint, err := client.loadApiEndpoint(product)
+	otsconn, err := cachedConnection(client, &client.otsconn, func() (*ots.Client, error) {
+		product := "ots"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		otsconn, err := ots.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the OTS client: %#v", err)
+		}
+
+		otsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		otsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		otsconn.SourceIp = client.config.SourceIp
+		otsconn.SecureTransport = client.config.SecureTransport
+		return otsconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	otsconn, err := ots.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the OTS client: %#v", err)
-	}
-
-	otsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	otsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	otsconn.SourceIp = client.config.SourceIp
-	otsconn.SecureTransport = client.config.SecureTransport
-	client.otsconn = otsconn
-
-	return do(client.otsconn)
+	return do(otsconn)
 }
 
 // NewOtsRoaClient rpc client for common sdk
@@ -757,60 +841,64 @@ func (client *AliyunClient) NewOtsRoaClient(productCode string) (*roa.Client, er
 }
 
 func (client *AliyunClient) WithCmsClient(do func(*cms.Client) (interface{}, error)) (interface{}, error) {
-	if client.cmsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.cmsconn)
-	}
-	product := "cms"
-	endpoint, err := client.loadApiEndpoint(product)
+	cmsconn, err := cachedConnection(client, &client.cmsconn, func() (*cms.Client, error) {
+		product := "cms"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		cmsconn, err := cms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the CMS client: %#v", err)
+		}
+		cmsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		cmsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		cmsconn.SourceIp = client.config.SourceIp
+		cmsconn.SecureTransport = client.config.SecureTransport
+		return cmsconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	cmsconn, err := cms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the CMS client: %#v", err)
-	}
-	cmsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	cmsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	cmsconn.SourceIp = client.config.SourceIp
-	cmsconn.SecureTransport = client.config.SecureTransport
-	client.cmsconn = cmsconn
-
-	return do(client.cmsconn)
+	return do(cmsconn)
 }
 
 func (client *AliyunClient) WithLogPopClient(do func(*slsPop.Client) (interface{}, error)) (interface{}, error) {
-	if client.logpopconn != nil && !client.config.needRefreshCredential() {
-		return do(client.logpopconn)
-	}
-	product := "sls"
-	endpoint, err := client.loadApiEndpoint(product)
-	if err != nil {
-		return nil, err
-	}
+	logpopconn, err := cachedConnection(client, &client.logpopconn, func() (*slsPop.Client, error) {
+		product := "sls"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
 
-	logpopconn, err := slsPop.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		logpopconn, err := slsPop.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the sls client: %#v", err)
+		}
+		logpopconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		logpopconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		logpopconn.SourceIp = client.config.SourceIp
+		logpopconn.SecureTransport = client.config.SecureTransport
+		endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://")
+		logpopconn.Domain = endpoint + "/open-api"
+		return logpopconn, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the sls client: %#v", err)
+		return nil, err
 	}
-	logpopconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	logpopconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	logpopconn.SourceIp = client.config.SourceIp
-	logpopconn.SecureTransport = client.config.SecureTransport
-	endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://")
-	logpopconn.Domain = endpoint + "/open-api"
-	client.logpopconn = logpopconn
-
-	return do(client.logpopconn)
+	return do(logpopconn)
 }
 
 func (client *AliyunClient) WithLogClient(do func(*sls.Client) (interface{}, error)) (interface{}, error) {
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
-	if client.logconn != nil && !client.config.needRefreshCredential() {
+	state := client.connectionState(&client.logconn)
+	generation, upToDate := client.upToDate(state)
+	if client.logconn != nil && upToDate {
 		return do(client.logconn)
 	}
 	product := "sls"
@@ -830,144 +918,174 @@ func (client *AliyunClient) WithLogClient(do func(*sls.Client) (interface{}, err
 		SecurityToken:   securityToken,
 		UserAgent:       client.getUserAgent(),
 	}
+	// SignVersion
+	if client.config.SignVersion != nil {
+		if slsV, ok := client.config.SignVersion.Load("sls"); ok && fmt.Sprint(slsV) == "v4" {
+			client.logconn.AuthVersion = sls.AuthV4
+			client.logconn.Region = client.config.RegionId
+		}
+	}
+	// The SLS SDK sends the requests with its own HTTP client, so it is replaced to record the permissions.
+	if client.permissionRecorder != nil {
+		serviceHost := ""
+		if u, err := url.Parse(endpoint); err == nil {
+			serviceHost = u.Hostname()
+		}
+		transport := client.getTransport()
+		transport.Proxy = http.ProxyFromEnvironment
+		client.logconn.HTTPClient = &http.Client{
+			Timeout: 60 * time.Second,
+			Transport: &permissionTransport{client: client, base: transport, apiName: func(req *http.Request) (string, string) {
+				return "Sls", slsApiName(req, serviceHost)
+			}},
+		}
+	}
+	state.generation = generation
 
 	return do(client.logconn)
 }
 
 func (client *AliyunClient) WithDrdsClient(do func(*drds.Client) (interface{}, error)) (interface{}, error) {
-	if client.drdsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.drdsconn)
-	}
-	product := "drds"
-	endpoint, err := client.loadApiEndpoint(product)
+	drdsconn, err := cachedConnection(client, &client.drdsconn, func() (*drds.Client, error) {
+		product := "drds"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		drdsconn, err := drds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the DRDS client: %#v", err)
+
+		}
+		drdsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		drdsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		drdsconn.SourceIp = client.config.SourceIp
+		drdsconn.SecureTransport = client.config.SecureTransport
+		return drdsconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	drdsconn, err := drds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the DRDS client: %#v", err)
-
-	}
-	drdsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	drdsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	drdsconn.SourceIp = client.config.SourceIp
-	drdsconn.SecureTransport = client.config.SecureTransport
-	client.drdsconn = drdsconn
-	return do(client.drdsconn)
+	return do(drdsconn)
 }
 
 func (client *AliyunClient) WithDdsClient(do func(*dds.Client) (interface{}, error)) (interface{}, error) {
-	if client.ddsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.ddsconn)
-	}
-	product := "dds"
-	endpoint, err := client.loadApiEndpoint(product)
-	if err != nil {
-		return nil, err
-	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
+	ddsconn, err := cachedConnection(client, &client.ddsconn, func() (*dds.Client, error) {
+		product := "dds"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
 
-	ddsconn, err := dds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		ddsconn, err := dds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the mongoDB client: %#v", err)
+		}
+		ddsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		ddsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		ddsconn.SourceIp = client.config.SourceIp
+		ddsconn.SecureTransport = client.config.SecureTransport
+		return ddsconn, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the mongoDB client: %#v", err)
+		return nil, err
 	}
-	ddsconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	ddsconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	ddsconn.SourceIp = client.config.SourceIp
-	ddsconn.SecureTransport = client.config.SecureTransport
-	client.ddsconn = ddsconn
-
-	return do(client.ddsconn)
+	return do(ddsconn)
 }
 
 func (client *AliyunClient) WithGpdbClient(do func(*gpdb.Client) (interface{}, error)) (interface{}, error) {
-	if client.gpdbconn != nil && !client.config.needRefreshCredential() {
-		return do(client.gpdbconn)
-	}
-	product := "gpdb"
-	endpoint, err := client.loadApiEndpoint(product)
-	if err != nil {
-		return nil, err
-	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
+	gpdbconn, err := cachedConnection(client, &client.gpdbconn, func() (*gpdb.Client, error) {
+		product := "gpdb"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
 
-	gpdbconn, err := gpdb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		gpdbconn, err := gpdb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the GPDB client: %#v", err)
+		}
+		gpdbconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		gpdbconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		gpdbconn.SourceIp = client.config.SourceIp
+		gpdbconn.SecureTransport = client.config.SecureTransport
+		return gpdbconn, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the GPDB client: %#v", err)
+		return nil, err
 	}
-	gpdbconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	gpdbconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	gpdbconn.SourceIp = client.config.SourceIp
-	gpdbconn.SecureTransport = client.config.SecureTransport
-	client.gpdbconn = gpdbconn
-	return do(client.gpdbconn)
+	return do(gpdbconn)
 }
 
 func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error)) (interface{}, error) {
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
-	if client.fcconn != nil && !client.config.needRefreshCredential() {
-		return do(client.fcconn)
-	}
-	product := "fc"
-	endpoint, err := client.loadApiEndpoint(product)
-	if err != nil {
-		return nil, err
-	}
-	if strings.HasPrefix(endpoint, "http") {
-		endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
-	}
+	fcconn, err := cachedConnection(client, &client.fcconn, func() (*fc.Client, error) {
+		product := "fc"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(endpoint, "http") {
+			endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
+		}
 
-	config := client.getSdkConfig(0)
-	transport := config.HttpTransport
-	// Receiving proxy config from environment
-	transport.Proxy = http.ProxyFromEnvironment
-	clientOptions := []fc.ClientOption{fc.WithSecurityToken(client.config.SecurityToken), fc.WithTransport(transport),
-		fc.WithTimeout(30), fc.WithRetryCount(DefaultClientRetryCountSmall)}
+		config := client.getSdkConfig(0)
+		transport := config.HttpTransport
+		// Receiving proxy config from environment
+		transport.Proxy = http.ProxyFromEnvironment
+		clientOptions := []fc.ClientOption{fc.WithSecurityToken(client.config.SecurityToken), fc.WithTransport(transport),
+			fc.WithTimeout(30), fc.WithRetryCount(DefaultClientRetryCountSmall)}
 
-	accessKey, secretKey, secretToken := client.config.GetRefreshCredential()
-	fcconn, err := fc.NewClient(fmt.Sprintf("https://%s", endpoint), string(ApiVersion20160815), accessKey, secretKey, clientOptions...)
+		accessKey, secretKey, secretToken := client.config.GetRefreshCredential()
+		fcconn, err := fc.NewClient(fmt.Sprintf("https://%s", endpoint), string(ApiVersion20160815), accessKey, secretKey, clientOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the FC client: %#v", err)
+		}
+
+		fcconn.Config.UserAgent = client.config.getUserAgent()
+		fcconn.Config.SecurityToken = secretToken
+		return fcconn, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the FC client: %#v", err)
+		return nil, err
 	}
-
-	fcconn.Config.UserAgent = client.config.getUserAgent()
-	fcconn.Config.SecurityToken = secretToken
-	client.fcconn = fcconn
-
-	return do(client.fcconn)
+	return do(fcconn)
 }
 
 func (client *AliyunClient) WithCloudApiClient(do func(*cloudapi.Client) (interface{}, error)) (interface{}, error) {
-	if client.cloudapiconn != nil && !client.config.needRefreshCredential() {
-		return do(client.cloudapiconn)
-	}
-	product := "cloudapi"
-	endpoint, err := client.loadApiEndpoint(product)
+	cloudapiconn, err := cachedConnection(client, &client.cloudapiconn, func() (*cloudapi.Client, error) {
+		product := "cloudapi"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.RegionId, product, endpoint)
+		}
+		cloudapiconn, err := cloudapi.NewClientWithOptions(client.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the CloudAPI client: %#v", err)
+		}
+		cloudapiconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		cloudapiconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		cloudapiconn.SourceIp = client.config.SourceIp
+		cloudapiconn.SecureTransport = client.config.SecureTransport
+		return cloudapiconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.RegionId, product, endpoint)
-	}
-	cloudapiconn, err := cloudapi.NewClientWithOptions(client.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the CloudAPI client: %#v", err)
-	}
-	cloudapiconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	cloudapiconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	cloudapiconn.SourceIp = client.config.SourceIp
-	cloudapiconn.SecureTransport = client.config.SecureTransport
-	client.cloudapiconn = cloudapiconn
-
-	return do(client.cloudapiconn)
+	return do(cloudapiconn)
 }
 
 func (client *AliyunClient) NewTeaCommonClient(endpoint string) (*rpc.Client, error) {
@@ -1014,7 +1132,9 @@ func (client *AliyunClient) WithDataHubClient(do func(api datahub.DataHubApi) (i
 	defer goSdkMutex.Unlock()
 
 	// Initialize the DataHub client if necessary
-	if client.dhconn == nil {
+	state := client.connectionState(&client.dhconn)
+	generation, upToDate := client.upToDate(state)
+	if client.dhconn == nil || !upToDate {
 		endpoint := client.config.DatahubEndpoint
 		if endpoint == "" {
 			endpoint = loadEndpoint(client.RegionId, DATAHUBCode)
@@ -1030,40 +1150,42 @@ func (client *AliyunClient) WithDataHubClient(do func(api datahub.DataHubApi) (i
 			endpoint = fmt.Sprintf("https://%s", endpoint)
 		}
 
-		account := datahub.NewStsCredential(client.config.AccessKey, client.config.SecretKey, client.config.SecurityToken)
+		accessKey, secretKey, token := client.config.GetRefreshCredential()
+		account := datahub.NewStsCredential(accessKey, secretKey, token)
 		config := &datahub.Config{
 			UserAgent: client.getUserAgent(),
 		}
 
-		client.dhconn = datahub.NewClientWithConfig(endpoint, config, account)
+		client.dhconn, state.generation = datahub.NewClientWithConfig(endpoint, config, account), generation
 	}
 
 	return do(client.dhconn)
 }
 
 func (client *AliyunClient) WithElasticsearchClient(do func(*elasticsearch.Client) (interface{}, error)) (interface{}, error) {
-	if client.elasticsearchconn != nil && !client.config.needRefreshCredential() {
-		return do(client.elasticsearchconn)
-	}
-	product := "elasticsearch"
-	endpoint, err := client.loadApiEndpoint(product)
+	elasticsearchconn, err := cachedConnection(client, &client.elasticsearchconn, func() (*elasticsearch.Client, error) {
+		product := "elasticsearch"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		elasticsearchconn, err := elasticsearch.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the Elasticsearch client: %#v", err)
+		}
+		elasticsearchconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		elasticsearchconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		elasticsearchconn.SourceIp = client.config.SourceIp
+		elasticsearchconn.SecureTransport = client.config.SecureTransport
+		return elasticsearchconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	elasticsearchconn, err := elasticsearch.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the Elasticsearch client: %#v", err)
-	}
-	elasticsearchconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	elasticsearchconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	elasticsearchconn.SourceIp = client.config.SourceIp
-	elasticsearchconn.SecureTransport = client.config.SecureTransport
-	client.elasticsearchconn = elasticsearchconn
-
-	return do(client.elasticsearchconn)
+	return do(elasticsearchconn)
 }
 
 func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface{}, error)) (interface{}, error) {
@@ -1071,7 +1193,9 @@ func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface
 	defer goSdkMutex.Unlock()
 
 	// Initialize the MNS client if necessary
-	if client.mnsconn == nil {
+	state := client.connectionState(&client.mnsconn)
+	generation, upToDate := client.upToDate(state)
+	if client.mnsconn == nil || !upToDate {
 		endpoint := client.config.MnsEndpoint
 		if endpoint == "" {
 			endpoint = loadEndpoint(client.config.RegionId, MNSCode)
@@ -1100,7 +1224,7 @@ func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface
 				mnsClient.SetProxy(proxy.String())
 			}
 		}
-		client.mnsconn = &mnsClient
+		client.mnsconn, state.generation = &mnsClient, generation
 	}
 
 	return do(client.mnsconn)
@@ -1131,8 +1255,10 @@ func (client *AliyunClient) WithTableStoreClient(instanceName string, do func(*t
 	defer goSdkMutex.Unlock()
 
 	// Initialize the TABLESTORE client if necessary
+	state := client.connectionState("tablestore:" + instanceName)
+	generation, upToDate := client.upToDate(state)
 	tableStoreClient, ok := client.tablestoreconnByInstanceName[instanceName]
-	if ok && !client.config.needRefreshCredential() {
+	if ok && upToDate {
 		return do(tableStoreClient)
 	}
 	endpoint := client.config.OtsEndpoint
@@ -1155,6 +1281,7 @@ func (client *AliyunClient) WithTableStoreClient(instanceName string, do func(*t
 	accessKey, secretKey, token := client.config.GetRefreshCredential()
 	tableStoreClient = tablestore.NewClientWithExternalHeader(endpoint, instanceName, accessKey, secretKey, token, tablestore.NewDefaultTableStoreConfig(), externalHeaders)
 	client.tablestoreconnByInstanceName[instanceName] = tableStoreClient
+	state.generation = generation
 
 	return do(tableStoreClient)
 }
@@ -1164,8 +1291,10 @@ func (client *AliyunClient) WithTableStoreTunnelClient(instanceName string, do f
 	defer goSdkMutex.Unlock()
 
 	// Initialize the TABLESTORE tunnel client if necessary
+	state := client.connectionState("tunnel:" + instanceName)
+	generation, upToDate := client.upToDate(state)
 	tunnelClient, ok := client.otsTunnelConnByInstanceName[instanceName]
-	if ok && !client.config.needRefreshCredential() {
+	if ok && upToDate {
 		return do(tunnelClient)
 	}
 	endpoint := client.config.OtsEndpoint
@@ -1189,6 +1318,7 @@ func (client *AliyunClient) WithTableStoreTunnelClient(instanceName string, do f
 	accessKey, secretKey, token := client.config.GetRefreshCredential()
 	tunnelClient = otsTunnel.NewTunnelClientWithConfigAndExternalHeader(endpoint, instanceName, accessKey, secretKey, token, otsTunnel.DefaultTunnelConfig, externalHeaders)
 	client.otsTunnelConnByInstanceName[instanceName] = tunnelClient
+	state.generation = generation
 
 	return do(tunnelClient)
 }
@@ -1333,7 +1463,7 @@ func (client *AliyunClient) getSdkConfig(timeout time.Duration) *sdk.Config {
 		timeout = time.Duration(30) * time.Second
 	}
 	// WithUserAgent will add a prefix Extra/ for user agent value
-	return sdk.NewConfig().
+	config := sdk.NewConfig().
 		WithMaxRetryTime(DefaultClientRetryCountSmall).
 		WithTimeout(timeout).
 		WithEnableAsync(false).
@@ -1343,6 +1473,35 @@ func (client *AliyunClient) getSdkConfig(timeout time.Duration) *sdk.Config {
 		WithHttpTransport(client.getTransport()).
 		WithScheme(client.config.Protocol).
 		WithUserAgent(fmt.Sprintf("Terraform %s", client.config.getUserAgent()))
+	var transport http.RoundTripper
+	if client.rateLimiter != nil {
+		transport = &rateLimitTransport{client: client, base: config.HttpTransport}
+	}
+	if client.tracer != nil {
+		base := transport
+		if base == nil {
+			base = config.HttpTransport
+		}
+		transport = &tracingTransport{client: client, base: base}
+	}
+	if client.permissionRecorder != nil {
+		base := transport
+		if base == nil {
+			base = config.HttpTransport
+		}
+		transport = &permissionTransport{client: client, base: base}
+	}
+	if client.cassette != nil {
+		base := transport
+		if base == nil {
+			base = config.HttpTransport
+		}
+		transport = &cassetteTransport{cassette: client.cassette, base: base}
+	}
+	if transport != nil {
+		config.Transport = transport
+	}
+	return config
 }
 
 func (client *AliyunClient) getUserAgent() string {
@@ -1429,356 +1588,373 @@ func (client *AliyunClient) GetCallerIdentity() (*sts.GetCallerIdentityResponse,
 	return identity, err
 }
 func (client *AliyunClient) WithDdosbgpClient(do func(*ddosbgp.Client) (interface{}, error)) (interface{}, error) {
-	if client.ddosbgpconn != nil && !client.config.needRefreshCredential() {
-		return do(client.ddosbgpconn)
-	}
-	product := "ddosbgp"
-	endpoint, err := client.loadApiEndpoint(product)
+	ddosbgpconn, err := cachedConnection(client, &client.ddosbgpconn, func() (*ddosbgp.Client, error) {
+		product := "ddosbgp"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		ddosbgpconn, err := ddosbgp.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the DDOSBGP client: %#v", err)
+		}
+		ddosbgpconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		ddosbgpconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		ddosbgpconn.SourceIp = client.config.SourceIp
+		ddosbgpconn.SecureTransport = client.config.SecureTransport
+		return ddosbgpconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	ddosbgpconn, err := ddosbgp.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the DDOSBGP client: %#v", err)
-	}
-	ddosbgpconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	ddosbgpconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	ddosbgpconn.SourceIp = client.config.SourceIp
-	ddosbgpconn.SecureTransport = client.config.SecureTransport
-	client.ddosbgpconn = ddosbgpconn
-
-	return do(client.ddosbgpconn)
+	return do(ddosbgpconn)
 }
 func (client *AliyunClient) WithAlikafkaClient(do func(*alikafka.Client) (interface{}, error)) (interface{}, error) {
-	if client.alikafkaconn != nil && !client.config.needRefreshCredential() {
-		return do(client.alikafkaconn)
-	}
-	product := "alikafka"
-	endpoint, err := client.loadApiEndpoint(product)
-	if err != nil {
-		return nil, err
-	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	alikafkaconn, err := alikafka.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+	alikafkaconn, err := cachedConnection(client, &client.alikafkaconn, func() (*alikafka.Client, error) {
+		product := "alikafka"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		alikafkaconn, err := alikafka.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the ALIKAFKA client: %#v", err)
+		}
+		alikafkaconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		alikafkaconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		alikafkaconn.SourceIp = client.config.SourceIp
+		alikafkaconn.SecureTransport = client.config.SecureTransport
+		return alikafkaconn, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the ALIKAFKA client: %#v", err)
+		return nil, err
 	}
-	alikafkaconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	alikafkaconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	alikafkaconn.SourceIp = client.config.SourceIp
-	alikafkaconn.SecureTransport = client.config.SecureTransport
-	client.alikafkaconn = alikafkaconn
-
-	return do(client.alikafkaconn)
+	return do(alikafkaconn)
 }
 
 func (client *AliyunClient) WithEmrClient(do func(*emr.Client) (interface{}, error)) (interface{}, error) {
-	if client.emrconn != nil && !client.config.needRefreshCredential() {
-		return do(client.emrconn)
-	}
-	product := "emr"
-	endpoint, err := client.loadApiEndpoint(product)
+	emrconn, err := cachedConnection(client, &client.emrconn, func() (*emr.Client, error) {
+		product := "emr"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		emrConn, err := emr.NewClientWithOptions(client.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the E-MapReduce client: %#v", err)
+		}
+		emrConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		emrConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		emrConn.SourceIp = client.config.SourceIp
+		emrConn.SecureTransport = client.config.SecureTransport
+		return emrConn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	emrConn, err := emr.NewClientWithOptions(client.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the E-MapReduce client: %#v", err)
-	}
-	emrConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	emrConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	emrConn.SourceIp = client.config.SourceIp
-	emrConn.SecureTransport = client.config.SecureTransport
-	client.emrconn = emrConn
-
-	return do(client.emrconn)
+	return do(emrconn)
 }
 
 func (client *AliyunClient) WithSagClient(do func(*smartag.Client) (interface{}, error)) (interface{}, error) {
-	if client.sagconn != nil && !client.config.needRefreshCredential() {
-		return do(client.sagconn)
-	}
-	product := "smartag"
-	endpoint, err := client.loadApiEndpoint(product)
+	sagconn, err := cachedConnection(client, &client.sagconn, func() (*smartag.Client, error) {
+		product := "smartag"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		sagconn, err := smartag.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the SAG client: %#v", err)
+		}
+		sagconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		sagconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		sagconn.SourceIp = client.config.SourceIp
+		sagconn.SecureTransport = client.config.SecureTransport
+		return sagconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	sagconn, err := smartag.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the SAG client: %#v", err)
-	}
-	sagconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	sagconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	sagconn.SourceIp = client.config.SourceIp
-	sagconn.SecureTransport = client.config.SecureTransport
-	client.sagconn = sagconn
-
-	return do(client.sagconn)
+	return do(sagconn)
 }
 
 func (client *AliyunClient) WithDbauditClient(do func(*yundun_dbaudit.Client) (interface{}, error)) (interface{}, error) {
-	if client.dbauditconn != nil && !client.config.needRefreshCredential() {
-		return do(client.dbauditconn)
-	}
-	product := "yundun_dbaudit"
-	endpoint, err := client.loadApiEndpoint(product)
+	dbauditconn, err := cachedConnection(client, &client.dbauditconn, func() (*yundun_dbaudit.Client, error) {
+		product := "yundun_dbaudit"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		dbauditconn, err := yundun_dbaudit.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the DBAUDIT client: %#v", err)
+		}
+
+		dbauditconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		dbauditconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		dbauditconn.SourceIp = client.config.SourceIp
+		dbauditconn.SecureTransport = client.config.SecureTransport
+		return dbauditconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	dbauditconn, err := yundun_dbaudit.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the DBAUDIT client: %#v", err)
-	}
-
-	dbauditconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	dbauditconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	dbauditconn.SourceIp = client.config.SourceIp
-	dbauditconn.SecureTransport = client.config.SecureTransport
-	client.dbauditconn = dbauditconn
-
-	return do(client.dbauditconn)
+	return do(dbauditconn)
 }
 func (client *AliyunClient) WithMarketClient(do func(*market.Client) (interface{}, error)) (interface{}, error) {
-	if client.marketconn != nil && !client.config.needRefreshCredential() {
-		return do(client.marketconn)
-	}
-	product := "market"
-	endpoint, err := client.loadApiEndpoint(product)
+	marketconn, err := cachedConnection(client, &client.marketconn, func() (*market.Client, error) {
+		product := "market"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		marketconn, err := market.NewClientWithOptions(client.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the Market client: %#v", err)
+		}
+
+		marketconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		marketconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		marketconn.SourceIp = client.config.SourceIp
+		marketconn.SecureTransport = client.config.SecureTransport
+		return marketconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	marketconn, err := market.NewClientWithOptions(client.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the Market client: %#v", err)
-	}
-
-	marketconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	marketconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	marketconn.SourceIp = client.config.SourceIp
-	marketconn.SecureTransport = client.config.SecureTransport
-	client.marketconn = marketconn
-
-	return do(client.marketconn)
+	return do(marketconn)
 }
 
 func (client *AliyunClient) WithHbaseClient(do func(*hbase.Client) (interface{}, error)) (interface{}, error) {
-	if client.hbaseconn != nil && !client.config.needRefreshCredential() {
-		return do(client.hbaseconn)
-	}
-	product := "hbase"
-	endpoint, err := client.loadApiEndpoint(product)
+	hbaseconn, err := cachedConnection(client, &client.hbaseconn, func() (*hbase.Client, error) {
+		product := "hbase"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		hbaseconn, err := hbase.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the hbase client: %#v", err)
+		}
+		hbaseconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		hbaseconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		hbaseconn.SourceIp = client.config.SourceIp
+		hbaseconn.SecureTransport = client.config.SecureTransport
+
+		return hbaseconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	hbaseconn, err := hbase.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the hbase client: %#v", err)
-	}
-	hbaseconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	hbaseconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	hbaseconn.SourceIp = client.config.SourceIp
-	hbaseconn.SecureTransport = client.config.SecureTransport
-
-	client.hbaseconn = hbaseconn
-
-	return do(client.hbaseconn)
+	return do(hbaseconn)
 }
 
 func (client *AliyunClient) WithAdbClient(do func(*adb.Client) (interface{}, error)) (interface{}, error) {
-	if client.adbconn != nil && !client.config.needRefreshCredential() {
-		return do(client.adbconn)
-	}
-	product := "adb"
-	endpoint, err := client.loadApiEndpoint(product)
+	adbconn, err := cachedConnection(client, &client.adbconn, func() (*adb.Client, error) {
+		product := "adb"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		adbconn, err := adb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the adb client: %#v", err)
+
+		}
+		adbconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		adbconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		adbconn.SourceIp = client.config.SourceIp
+		adbconn.SecureTransport = client.config.SecureTransport
+		return adbconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	adbconn, err := adb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the adb client: %#v", err)
-
-	}
-	adbconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	adbconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	adbconn.SourceIp = client.config.SourceIp
-	adbconn.SecureTransport = client.config.SecureTransport
-	client.adbconn = adbconn
-
-	return do(client.adbconn)
+	return do(adbconn)
 }
 func (client *AliyunClient) WithCbnClient(do func(*cbn.Client) (interface{}, error)) (interface{}, error) {
-	if client.cbnConn != nil && !client.config.needRefreshCredential() {
-		return do(client.cbnConn)
-	}
-	product := "cbn"
-	endpoint, err := client.loadApiEndpoint(product)
+	cbnConn, err := cachedConnection(client, &client.cbnConn, func() (*cbn.Client, error) {
+		product := "cbn"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		cbnConn, err := cbn.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the Cbnclient: %#v", err)
+		}
+
+		cbnConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		cbnConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		cbnConn.SourceIp = client.config.SourceIp
+		cbnConn.SecureTransport = client.config.SecureTransport
+		return cbnConn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	cbnConn, err := cbn.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the Cbnclient: %#v", err)
-	}
-
-	cbnConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	cbnConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	cbnConn.SourceIp = client.config.SourceIp
-	cbnConn.SecureTransport = client.config.SecureTransport
-	client.cbnConn = cbnConn
-
-	return do(client.cbnConn)
+	return do(cbnConn)
 }
 
 func (client *AliyunClient) WithEdasClient(do func(*edas.Client) (interface{}, error)) (interface{}, error) {
-	if client.edasconn != nil && !client.config.needRefreshCredential() {
-		return do(client.edasconn)
-	}
-	product := "edas"
-	endpoint, err := client.loadApiEndpoint(product)
+	edasconn, err := cachedConnection(client, &client.edasconn, func() (*edas.Client, error) {
+		product := "edas"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		edasconn, err := edas.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(time.Duration(60)*time.Second), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the EDAS client: %#v", err)
+		}
+		edasconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		edasconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		edasconn.SourceIp = client.config.SourceIp
+		edasconn.SecureTransport = client.config.SecureTransport
+		return edasconn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	edasconn, err := edas.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(time.Duration(60)*time.Second), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the EDAS client: %#v", err)
-	}
-	edasconn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	edasconn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	edasconn.SourceIp = client.config.SourceIp
-	edasconn.SecureTransport = client.config.SecureTransport
-	client.edasconn = edasconn
-
-	return do(client.edasconn)
+	return do(edasconn)
 }
 
 func (client *AliyunClient) WithAlidnsClient(do func(*alidns.Client) (interface{}, error)) (interface{}, error) {
-	if client.alidnsConn != nil && !client.config.needRefreshCredential() {
-		return do(client.alidnsConn)
-	}
-	product := "alidns"
-	endpoint, err := client.loadApiEndpoint(product)
-	if err != nil {
-		return nil, err
-	}
+	alidnsConn, err := cachedConnection(client, &client.alidnsConn, func() (*alidns.Client, error) {
+		product := "alidns"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
 
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
 
-	alidnsConn, err := alidns.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		alidnsConn, err := alidns.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the Alidnsclient: %#v", err)
+		}
+		alidnsConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		alidnsConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		alidnsConn.SourceIp = client.config.SourceIp
+		alidnsConn.SecureTransport = client.config.SecureTransport
+		return alidnsConn, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the Alidnsclient: %#v", err)
+		return nil, err
 	}
-	alidnsConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	alidnsConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	alidnsConn.SourceIp = client.config.SourceIp
-	alidnsConn.SecureTransport = client.config.SecureTransport
-	client.alidnsConn = alidnsConn
-	return do(client.alidnsConn)
+	return do(alidnsConn)
 }
 
 func (client *AliyunClient) WithCassandraClient(do func(*cassandra.Client) (interface{}, error)) (interface{}, error) {
-	if client.cassandraConn != nil && !client.config.needRefreshCredential() {
-		return do(client.cassandraConn)
-	}
-	product := "cassandra"
-	endpoint, err := client.loadApiEndpoint(product)
+	cassandraConn, err := cachedConnection(client, &client.cassandraConn, func() (*cassandra.Client, error) {
+		product := "cassandra"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
+		}
+		cassandraConn, err := cassandra.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the Cassandraclient: %#v", err)
+		}
+		cassandraConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		cassandraConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		cassandraConn.SourceIp = client.config.SourceIp
+		cassandraConn.SecureTransport = client.config.SecureTransport
+		return cassandraConn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, product, endpoint)
-	}
-	cassandraConn, err := cassandra.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the Cassandraclient: %#v", err)
-	}
-	cassandraConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	cassandraConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	cassandraConn.SourceIp = client.config.SourceIp
-	cassandraConn.SecureTransport = client.config.SecureTransport
-	client.cassandraConn = cassandraConn
-	return do(client.cassandraConn)
+	return do(cassandraConn)
 }
 
 func (client *AliyunClient) WithEciClient(do func(*eci.Client) (interface{}, error)) (interface{}, error) {
-	if client.eciConn != nil && !client.config.needRefreshCredential() {
-		return do(client.eciConn)
-	}
-	product := "eci"
-	endpoint, err := client.loadApiEndpoint(product)
-	if err != nil {
-		return nil, err
-	}
+	eciConn, err := cachedConnection(client, &client.eciConn, func() (*eci.Client, error) {
+		product := "eci"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
 
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, string(EciCode), endpoint)
-	}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, string(EciCode), endpoint)
+		}
 
-	eciConn, err := eci.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		eciConn, err := eci.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the Eciclient: %#v", err)
+		}
+		eciConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		eciConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		eciConn.SourceIp = client.config.SourceIp
+		eciConn.SecureTransport = client.config.SecureTransport
+		return eciConn, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the Eciclient: %#v", err)
+		return nil, err
 	}
-	eciConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	eciConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	eciConn.SourceIp = client.config.SourceIp
-	eciConn.SecureTransport = client.config.SecureTransport
-	client.eciConn = eciConn
-	return do(client.eciConn)
+	return do(eciConn)
 }
 func (client *AliyunClient) WithRKvstoreClient(do func(*r_kvstore.Client) (interface{}, error)) (interface{}, error) {
-	if client.r_kvstoreConn != nil && !client.config.needRefreshCredential() {
-		return do(client.r_kvstoreConn)
-	}
-	product := "r_kvstore"
-	endpoint, err := client.loadApiEndpoint(product)
+	r_kvstoreConn, err := cachedConnection(client, &client.r_kvstoreConn, func() (*r_kvstore.Client, error) {
+		product := "r_kvstore"
+		endpoint, err := client.loadApiEndpoint(product)
+		if err != nil {
+			return nil, err
+		}
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, "r-kvstore", endpoint)
+		}
+		r_kvstoreConn, err := r_kvstore.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the RKvstoreclient: %#v", err)
+		}
+
+		r_kvstoreConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
+		r_kvstoreConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
+		r_kvstoreConn.SourceIp = client.config.SourceIp
+		r_kvstoreConn.SecureTransport = client.config.SecureTransport
+		return r_kvstoreConn, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if endpoint != "" {
-		endpoints.AddEndpointMapping(client.config.RegionId, "r-kvstore", endpoint)
-	}
-	r_kvstoreConn, err := r_kvstore.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(0), client.config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the RKvstoreclient: %#v", err)
-	}
-
-	r_kvstoreConn.SetReadTimeout(time.Duration(client.config.ClientReadTimeout) * time.Millisecond)
-	r_kvstoreConn.SetConnectTimeout(time.Duration(client.config.ClientConnectTimeout) * time.Millisecond)
-	r_kvstoreConn.SourceIp = client.config.SourceIp
-	r_kvstoreConn.SecureTransport = client.config.SecureTransport
-	client.r_kvstoreConn = r_kvstoreConn
-
-	return do(client.r_kvstoreConn)
+	return do(r_kvstoreConn)
 }
 
 func (client *AliyunClient) NewQuotasClientV2() (*openapi.Client, error) {
@@ -1860,6 +2036,45 @@ func (client *AliyunClient) RpcGet(apiProductCode string, apiVersion string, api
 }
 
 func (client *AliyunClient) rpcRequest(method string, apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}, autoRetry bool, endpoint string) (map[string]interface{}, error) {
+	client.permissionRecorder.Record(apiProductCode, apiName)
+	span := client.tracer.StartSpan(apiProductCode, apiVersion, apiName)
+	response, err := client.retryRequest(autoRetry, span, func(autoRetry bool) (map[string]interface{}, error) {
+		return client.recordRpcRequest(method, apiProductCode, apiVersion, apiName, query, body, autoRetry, endpoint)
+	})
+	span.End(response, err)
+	return response, err
+}
+
+// retryRequest invokes the request with the provider retry policy, or the Tea runtime retries it when there is no policy.
+func (client *AliyunClient) retryRequest(autoRetry bool, span *Span, invoke func(autoRetry bool) (map[string]interface{}, error)) (map[string]interface{}, error) {
+	if policy := client.config.Retry; policy != nil && autoRetry {
+		return policy.retry(func() (map[string]interface{}, error) {
+			span.Attempt()
+			return invoke(false)
+		})
+	}
+	span.Attempt()
+	return invoke(autoRetry)
+}
+
+func (client *AliyunClient) recordRpcRequest(method string, apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}, autoRetry bool, endpoint string) (map[string]interface{}, error) {
+	if client.cassette == nil {
+		return client.doRpcRequest(method, apiProductCode, apiVersion, apiName, query, body, autoRetry, endpoint)
+	}
+	interaction := &Interaction{
+		Kind:           interactionKindRpc,
+		ApiProductCode: strings.ToLower(ConvertKebabToSnake(apiProductCode)),
+		ApiVersion:     apiVersion,
+		ApiName:        apiName,
+		Method:         method,
+		Request:        interactionRequest(map[string]interface{}{"query": query, "body": body}),
+	}
+	return client.cassette.do(interaction, func() (map[string]interface{}, error) {
+		return client.doRpcRequest(method, apiProductCode, apiVersion, apiName, query, body, autoRetry, endpoint)
+	})
+}
+
+func (client *AliyunClient) doRpcRequest(method string, apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}, autoRetry bool, endpoint string) (map[string]interface{}, error) {
 	var err error
 	if endpoint == "" {
 		apiProductCode = strings.ToLower(ConvertKebabToSnake(apiProductCode))
@@ -1868,23 +2083,17 @@ func (client *AliyunClient) rpcRequest(method string, apiProductCode string, api
 			return nil, err
 		}
 	}
-	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
-	credential, err := client.config.Credential.GetCredential()
-	if err != nil || credential == nil {
-		return nil, fmt.Errorf("get credential failed. Error: %#v", err)
-	}
-	sdkConfig.SetAccessKeyId(*credential.AccessKeyId)
-	sdkConfig.SetAccessKeySecret(*credential.AccessKeySecret)
-	sdkConfig.SetSecurityToken(*credential.SecurityToken)
-	conn, err := rpc.NewClient(&sdkConfig)
+	conn, err := client.getTeaRpcClient(apiProductCode, endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the %s api client: %#v", apiProductCode, err)
+		return nil, err
 	}
 	runtime := &util.RuntimeOptions{}
 	runtime.SetAutoretry(autoRetry)
+	client.rateLimiter.Wait(apiProductCode, apiName)
 	response, err := conn.DoRequest(tea.String(apiName), nil, tea.String(method), tea.String(apiVersion), tea.String("AK"), query, body, runtime)
-	return response, formatError(response, err)
+	err = formatError(response, err)
+	client.rateLimiter.Observe(apiProductCode, err)
+	return response, err
 }
 
 // RoaPost invoking ROA API request with POST method
@@ -2031,27 +2240,51 @@ func (client *AliyunClient) RoaPatchWithApiName(apiProductCode string, apiVersio
 }
 
 func (client *AliyunClient) roaRequest(method string, apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
+	if apiName != "" {
+		client.permissionRecorder.Record(apiProductCode, apiName)
+	} else {
+		client.permissionRecorder.RecordUnknown(apiProductCode, method+" "+pathName)
+	}
+	span := client.tracer.StartSpan(apiProductCode, apiVersion, apiName)
+	response, err := client.retryRequest(autoRetry, span, func(autoRetry bool) (map[string]interface{}, error) {
+		return client.recordRoaRequest(method, apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry)
+	})
+	span.End(response, err)
+	return response, err
+}
+
+func (client *AliyunClient) recordRoaRequest(method string, apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
+	if client.cassette == nil {
+		return client.doRoaRequest(method, apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry)
+	}
+	interaction := &Interaction{
+		Kind:           interactionKindRoa,
+		ApiProductCode: strings.ToLower(ConvertKebabToSnake(apiProductCode)),
+		ApiVersion:     apiVersion,
+		ApiName:        apiName,
+		Method:         method,
+		Path:           pathName,
+		Request:        interactionRequest(map[string]interface{}{"query": query, "headers": headers, "body": body}),
+	}
+	return client.cassette.do(interaction, func() (map[string]interface{}, error) {
+		return client.doRoaRequest(method, apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry)
+	})
+}
+
+func (client *AliyunClient) doRoaRequest(method string, apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
 	apiProductCode = strings.ToLower(ConvertKebabToSnake(apiProductCode))
 	endpoint, err := client.loadApiEndpoint(apiProductCode)
 	if err != nil {
 		return nil, err
 	}
-	sdkConfig := client.teaRoaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
-	credential, err := client.config.Credential.GetCredential()
-	if err != nil || credential == nil {
-		return nil, fmt.Errorf("get credential failed. Error: %#v", err)
-	}
-	sdkConfig.SetAccessKeyId(*credential.AccessKeyId)
-	sdkConfig.SetAccessKeySecret(*credential.AccessKeySecret)
-	sdkConfig.SetSecurityToken(*credential.SecurityToken)
-	conn, err := roa.NewClient(&sdkConfig)
+	conn, err := client.getTeaRoaClient(apiProductCode, endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the %s api client: %#v", apiProductCode, err)
+		return nil, err
 	}
 	var response map[string]interface{}
 	runtime := &util.RuntimeOptions{}
 	runtime.SetAutoretry(autoRetry)
+	client.rateLimiter.Wait(apiProductCode, apiName)
 	if apiName != "" {
 		response, err = conn.DoRequestWithAction(tea.String(apiName), tea.String(apiVersion), nil, tea.String(method), tea.String("AK"), tea.String(pathName), query, headers, body, runtime)
 	} else {
@@ -2060,7 +2293,9 @@ func (client *AliyunClient) roaRequest(method string, apiProductCode string, api
 	if respBody, isExist := response["body"]; isExist && respBody != nil {
 		response = respBody.(map[string]interface{})
 	}
-	return response, formatError(response, err)
+	err = formatError(response, err)
+	client.rateLimiter.Observe(apiProductCode, err)
+	return response, err
 }
 
 // Do invoking API request with SDK v2
@@ -2074,6 +2309,34 @@ func (client *AliyunClient) roaRequest(method string, apiProductCode string, api
 //	hostMap - API parameters in hostMap
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
 func (client *AliyunClient) Do(apiProductCode string, apiParams *openapi.Params, query map[string]*string, body interface{}, headers map[string]*string, hostMap map[string]*string, autoRetry bool) (map[string]interface{}, error) {
+	client.permissionRecorder.Record(apiProductCode, tea.StringValue(apiParams.Action))
+	span := client.tracer.StartSpan(apiProductCode, tea.StringValue(apiParams.Version), tea.StringValue(apiParams.Action))
+	response, err := client.retryRequest(autoRetry, span, func(autoRetry bool) (map[string]interface{}, error) {
+		return client.recordDo(apiProductCode, apiParams, query, body, headers, hostMap, autoRetry)
+	})
+	span.End(response, err)
+	return response, err
+}
+
+func (client *AliyunClient) recordDo(apiProductCode string, apiParams *openapi.Params, query map[string]*string, body interface{}, headers map[string]*string, hostMap map[string]*string, autoRetry bool) (map[string]interface{}, error) {
+	if client.cassette == nil {
+		return client.do(apiProductCode, apiParams, query, body, headers, hostMap, autoRetry)
+	}
+	interaction := &Interaction{
+		Kind:           interactionKindOpenapi,
+		ApiProductCode: strings.ToLower(ConvertKebabToSnake(apiProductCode)),
+		ApiVersion:     tea.StringValue(apiParams.Version),
+		ApiName:        tea.StringValue(apiParams.Action),
+		Method:         tea.StringValue(apiParams.Method),
+		Path:           tea.StringValue(apiParams.Pathname),
+		Request:        interactionRequest(map[string]interface{}{"query": query, "headers": headers, "body": body}),
+	}
+	return client.cassette.do(interaction, func() (map[string]interface{}, error) {
+		return client.do(apiProductCode, apiParams, query, body, headers, hostMap, autoRetry)
+	})
+}
+
+func (client *AliyunClient) do(apiProductCode string, apiParams *openapi.Params, query map[string]*string, body interface{}, headers map[string]*string, hostMap map[string]*string, autoRetry bool) (map[string]interface{}, error) {
 	apiProductCode = strings.ToLower(ConvertKebabToSnake(apiProductCode))
 	endpoint, err := client.loadApiEndpoint(apiProductCode)
 	if err != nil {
@@ -2088,7 +2351,7 @@ func (client *AliyunClient) Do(apiProductCode string, apiParams *openapi.Params,
 		sdkConfig = openapi.Config{}
 	}
 	if apiParams.Protocol == nil || *apiParams.Protocol == "" {
-		apiParams.Protocol = tea.String(client.config.Protocol)
+		apiParams.Protocol = tea.String(client.productProtocol(apiProductCode))
 	}
 	sdkConfig.SetEndpoint(endpoint)
 	credential, err := client.config.Credential.GetCredential()
@@ -2118,11 +2381,12 @@ func (client *AliyunClient) Do(apiProductCode string, apiParams *openapi.Params,
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the %s api client: %#v", apiProductCode, err)
 		}
-		openapiClient.Protocol = tea.String(client.config.Protocol)
+		openapiClient.Protocol = tea.String(client.productProtocol(apiProductCode))
 	}
 	var response map[string]interface{}
 	runtime := &utilV2.RuntimeOptions{}
 	runtime.SetAutoretry(autoRetry)
+	client.rateLimiter.Wait(apiProductCode, tea.StringValue(apiParams.Action))
 	if apiParams.Style != nil && *apiParams.Style == "RPC" {
 		response, err = openapiClient.CallApi(apiParams, &openapi.OpenApiRequest{Query: query, Body: body, Headers: headers, HostMap: hostMap}, runtime)
 	} else {
@@ -2133,7 +2397,9 @@ func (client *AliyunClient) Do(apiProductCode string, apiParams *openapi.Params,
 			response = v
 		}
 	}
-	return response, formatError(response, err)
+	err = formatError(response, err)
+	client.rateLimiter.Observe(apiProductCode, err)
+	return response, err
 }
 func formatError(response map[string]interface{}, err error) error {
 	if err != nil {
@@ -2238,3 +2504,47 @@ func (client *AliyunClient) GetRetryTimeout(defaultTimeout time.Duration) time.D
 
 	return defaultTimeout
 }
+
+// DefaultTags returns the tags which are configured in the provider default_tags block
+// and should be applied to all of the taggable resources.
+func (client *AliyunClient) DefaultTags() map[string]string {
+	result := make(map[string]string, len(client.config.DefaultTags))
+	for key, value := range client.config.DefaultTags {
+		result[key] = value
+	}
+	return result
+}
+
+// Close releases the resources held by the client, like flushing and closing the tracer.
+func (client *AliyunClient) Close() error {
+	return client.tracer.Close()
+}
+
+// TagIgnored reports whether the tag key is ignored by the ignore_tags block of the provider configuration.
+func (client *AliyunClient) TagIgnored(key string) bool {
+	if client == nil || client.config == nil {
+		return false
+	}
+	for _, ignored := range client.config.IgnoreTagKeys {
+		if ignored != "" && ignored == key {
+			return true
+		}
+	}
+	for _, prefix := range client.config.IgnoreTagKeyPrefixes {
+		if prefix != "" && strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// PlanTimeValidation reports whether the instance types, zones and quotas requested by the resources are checked against
+// the ECS DescribeAvailableResource and the Quota Center at plan time.
+func (client *AliyunClient) PlanTimeValidation() bool {
+	return client.config.PlanTimeValidation
+}
+
+// PlanDryRun reports whether the create APIs accepting the DryRun parameter are called with it at plan time.
+func (client *AliyunClient) PlanDryRun() bool {
+	return client.config.PlanDryRun
+}
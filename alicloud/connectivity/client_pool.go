@@ -0,0 +1,114 @@
+package connectivity
+
+import (
+	"fmt"
+	"sync"
+
+	roa "github.com/alibabacloud-go/tea-roa/client"
+	rpc "github.com/alibabacloud-go/tea-rpc/client"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// teaClientPool caches the Tea RPC and ROA clients by endpoint, so the API requests do not construct a client each time.
+// A cached client is rebuilt once the credential changes, like the STS token of an assumed role is refreshed.
+type teaClientPool struct {
+	mutex      sync.Mutex
+	rpcClients map[string]*pooledRpcClient
+	roaClients map[string]*pooledRoaClient
+}
+
+type pooledRpcClient struct {
+	credential teaCredential
+	client     *rpc.Client
+}
+
+type pooledRoaClient struct {
+	credential teaCredential
+	client     *roa.Client
+}
+
+type teaCredential struct {
+	accessKeyId     string
+	accessKeySecret string
+	securityToken   string
+}
+
+func newTeaClientPool() *teaClientPool {
+	return &teaClientPool{
+		rpcClients: make(map[string]*pooledRpcClient),
+		roaClients: make(map[string]*pooledRoaClient),
+	}
+}
+
+// currentTeaCredential returns the credential used by the next request, and it may be refreshed.
+func (client *AliyunClient) currentTeaCredential() (teaCredential, error) {
+	credential, err := client.config.Credential.GetCredential()
+	if err != nil || credential == nil {
+		return teaCredential{}, fmt.Errorf("get credential failed. Error: %#v", err)
+	}
+	return teaCredential{
+		accessKeyId:     tea.StringValue(credential.AccessKeyId),
+		accessKeySecret: tea.StringValue(credential.AccessKeySecret),
+		securityToken:   tea.StringValue(credential.SecurityToken),
+	}, nil
+}
+
+// getTeaRpcClient returns the RPC client of the endpoint, building it when it is missing or its credential is out of date.
+func (client *AliyunClient) getTeaRpcClient(apiProductCode, endpoint string) (*rpc.Client, error) {
+	credential, err := client.currentTeaCredential()
+	if err != nil {
+		return nil, err
+	}
+	pool := client.teaClientPool
+	if pool != nil {
+		pool.mutex.Lock()
+		defer pool.mutex.Unlock()
+		if pooled, ok := pool.rpcClients[endpoint]; ok && pooled.credential == credential {
+			return pooled.client, nil
+		}
+	}
+	sdkConfig := client.teaSdkConfig
+	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetProtocol(client.productProtocol(apiProductCode))
+	sdkConfig.SetAccessKeyId(credential.accessKeyId)
+	sdkConfig.SetAccessKeySecret(credential.accessKeySecret)
+	sdkConfig.SetSecurityToken(credential.securityToken)
+	conn, err := rpc.NewClient(&sdkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the %s api client: %#v", apiProductCode, err)
+	}
+	if pool != nil {
+		pool.rpcClients[endpoint] = &pooledRpcClient{credential: credential, client: conn}
+	}
+	return conn, nil
+}
+
+// getTeaRoaClient returns the ROA client of the endpoint, building it when it is missing or its credential is out of date.
+func (client *AliyunClient) getTeaRoaClient(apiProductCode, endpoint string) (*roa.Client, error) {
+	credential, err := client.currentTeaCredential()
+	if err != nil {
+		return nil, err
+	}
+	pool := client.teaClientPool
+	if pool != nil {
+		pool.mutex.Lock()
+		defer pool.mutex.Unlock()
+		if pooled, ok := pool.roaClients[endpoint]; ok && pooled.credential == credential {
+			return pooled.client, nil
+		}
+	}
+	sdkConfig := client.teaRoaSdkConfig
+	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetProtocol(client.productProtocol(apiProductCode))
+	sdkConfig.SetAccessKeyId(credential.accessKeyId)
+	sdkConfig.SetAccessKeySecret(credential.accessKeySecret)
+	sdkConfig.SetSecurityToken(credential.securityToken)
+	conn, err := roa.NewClient(&sdkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the %s api client: %#v", apiProductCode, err)
+	}
+	if pool != nil {
+		pool.roaClients[endpoint] = &pooledRoaClient{credential: credential, client: conn}
+	}
+	return conn, nil
+}
@@ -0,0 +1,109 @@
+package connectivity
+
+import (
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	credential "github.com/aliyun/credentials-go/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeaClientPool(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+
+	rpcClient, err := client.getTeaRpcClient("vpc", "vpc."+MockEndpointSuffix)
+	assert.Nil(t, err)
+	cached, err := client.getTeaRpcClient("vpc", "vpc."+MockEndpointSuffix)
+	assert.Nil(t, err)
+	assert.True(t, rpcClient == cached)
+	other, err := client.getTeaRpcClient("ecs", "ecs."+MockEndpointSuffix)
+	assert.Nil(t, err)
+	assert.False(t, rpcClient == other)
+
+	roaClient, err := client.getTeaRoaClient("cs", "cs."+MockEndpointSuffix)
+	assert.Nil(t, err)
+	cachedRoa, err := client.getTeaRoaClient("cs", "cs."+MockEndpointSuffix)
+	assert.Nil(t, err)
+	assert.True(t, roaClient == cachedRoa)
+
+	// The client is rebuilt after the credential is refreshed
+	refreshed, err := credential.NewCredential(new(credential.Config).
+		SetType("sts").
+		SetAccessKeyId("RefreshedAccessKeyId").
+		SetAccessKeySecret("RefreshedAccessKeySecret").
+		SetSecurityToken("RefreshedSecurityToken"))
+	assert.Nil(t, err)
+	client.config.Credential = refreshed
+	rebuilt, err := client.getTeaRpcClient("vpc", "vpc."+MockEndpointSuffix)
+	assert.Nil(t, err)
+	assert.False(t, rpcClient == rebuilt)
+	accessKeyId, err := rebuilt.GetAccessKeyId()
+	assert.Nil(t, err)
+	assert.Equal(t, "RefreshedAccessKeyId", tea.StringValue(accessKeyId))
+}
+
+func benchmarkRpcRequest(b *testing.B, pooled bool) {
+	server := NewMockServer()
+	defer server.Close()
+	server.Register("Vpc", "DescribeVpcAttribute", MockResponse{
+		Body: map[string]interface{}{"VpcId": "vpc-mock"},
+	})
+	client, err := server.Client("cn-hangzhou")
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !pooled {
+		client.teaClientPool = nil
+	}
+	request := map[string]interface{}{
+		"VpcId": "vpc-mock",
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.RpcPost("Vpc", "2016-04-28", "DescribeVpcAttribute", nil, request, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRpcRequestWithoutPool(b *testing.B) {
+	benchmarkRpcRequest(b, false)
+}
+
+func BenchmarkRpcRequestWithPool(b *testing.B) {
+	benchmarkRpcRequest(b, true)
+}
+
+func benchmarkRoaRequest(b *testing.B, pooled bool) {
+	server := NewMockServer()
+	defer server.Close()
+	server.Register("CS", "/clusters/c-mock", MockResponse{
+		Body: map[string]interface{}{"cluster_id": "c-mock"},
+	})
+	client, err := server.Client("cn-hangzhou")
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !pooled {
+		client.teaClientPool = nil
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.RoaGet("CS", "2015-12-15", "/clusters/c-mock", nil, nil, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRoaRequestWithoutPool(b *testing.B) {
+	benchmarkRoaRequest(b, false)
+}
+
+func BenchmarkRoaRequestWithPool(b *testing.B) {
+	benchmarkRoaRequest(b, true)
+}
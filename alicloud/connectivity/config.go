@@ -7,6 +7,7 @@ import (
 	roa "github.com/alibabacloud-go/tea-roa/client"
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/alibabacloud-go/tea/tea"
+	"io/ioutil"
 	"log"
 	"regexp"
 	"strings"
@@ -17,9 +18,11 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
 	credential "github.com/aliyun/credentials-go/credentials"
+	"github.com/aliyun/credentials-go/credentials/providers"
 )
 
 var securityCredURL = "http://100.100.100.200/latest/meta-data/ram/security-credentials/"
+var metadataTokenURL = "http://100.100.100.200/latest/api/token"
 
 // Config of aliyun
 type Config struct {
@@ -39,6 +42,22 @@ type Config struct {
 	SecureTransport      string
 	MaxRetryTimeout      int
 	Credential           credential.Credential
+	DefaultTags          map[string]string
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
+	CassetteMode         string
+	CassettePath         string
+	TraceFile            string
+	TraceEndpoint        string
+	EndpointsFile        string
+	Retry                *RetryPolicy
+	RateLimit            *RateLimitPolicy
+	EndpointCache        *EndpointCachePolicy
+	PlanTimeValidation   bool
+	PlanDryRun           bool
+	PermissionReportFile string
+	CredentialProcess    []string
+	credentialProcess    *credentialProcessProvider
 
 	RamRoleArn               string
 	RamRoleSessionName       string
@@ -92,6 +111,15 @@ type Config struct {
 	AdbEndpoint              string
 	MaxComputeEndpoint       string
 
+	// AssumeRoleChain is the ordered assume_role hops, and the RamRole fields are the first one
+	AssumeRoleChain []*AssumeRole
+	assumeRoleChain *assumeRoleChainProvider
+	// credentialManager owns the temporary credentials which are refreshed before they expire
+	credentialManager *CredentialManager
+	clock             Clock
+	// endpointSources records where the endpoints are loaded from, keyed by the product code
+	endpointSources sync.Map
+
 	edasEndpoint                string
 	SkipRegionValidation        bool
 	ConfigurationSource         string
@@ -256,39 +284,29 @@ func (c *Config) setAuthByAssumeRole() (err error) {
 	if c.AccessKey == "" || c.RamRoleArn == "" {
 		return
 	}
-
-	config := new(credential.Config).
-		SetType("ram_role_arn").
-		SetAccessKeyId(c.AccessKey).
-		SetAccessKeySecret(c.SecretKey).
-		SetRoleArn(c.RamRoleArn).
-		SetRoleSessionName(c.RamRoleSessionName).
-		SetPolicy(c.RamRolePolicy).
-		SetExternalId(c.RamRoleExternalId).
-		SetRoleSessionExpiration(c.RamRoleSessionExpiration)
-	if c.SecurityToken != "" {
-		config.SetSecurityToken(c.SecurityToken)
-	}
-	if c.StsEndpoint != "" {
-		config.SetSTSEndpoint(c.StsEndpoint)
-	}
-	if c.ClientConnectTimeout != 0 {
-		config.SetConnectTimeout(c.ClientConnectTimeout)
-	}
-	if c.ClientReadTimeout != 0 {
-		config.SetTimeout(c.ClientReadTimeout)
-	}
-	provider, err := credential.NewCredential(config)
-	if err != nil {
-		return
+	if c.assumeRoleChain == nil {
+		hops := c.AssumeRoleChain
+		if len(hops) == 0 {
+			hops = []*AssumeRole{{
+				RoleArn:           c.RamRoleArn,
+				SessionName:       c.RamRoleSessionName,
+				Policy:            c.RamRolePolicy,
+				ExternalId:        c.RamRoleExternalId,
+				SessionExpiration: c.RamRoleSessionExpiration,
+			}}
+		}
+		var source providers.CredentialsProvider = &staticCredentialsProvider{
+			credentials: providers.Credentials{AccessKeyId: c.AccessKey, AccessKeySecret: c.SecretKey, SecurityToken: c.SecurityToken},
+		}
+		if c.Credential != nil {
+			source = &credentialSource{credential: c.Credential}
+		}
+		c.assumeRoleChain = &assumeRoleChainProvider{source: source, hops: hops, assumeRole: c.stsAssumeRole}
 	}
-	c.Credential = provider
-	credential, err := provider.GetCredential()
-	if err != nil || credential == nil {
-		return fmt.Errorf("refresh Ram Role Arn credential failed. Error: %v", err)
+	if c.assumeRoleChain.manager == nil {
+		c.assumeRoleChain.manager = NewCredentialManager("ram_role_arn", c.clock, c.assumeRoleChain.walk)
 	}
-	c.AccessKey, c.SecretKey, c.SecurityToken = *credential.AccessKeyId, *credential.AccessKeySecret, *credential.SecurityToken
-	return nil
+	return c.setAuthByCredentialManager(c.assumeRoleChain.manager)
 }
 
 // setAuthCredentialByEcsRoleName aims to access meta to get sts credential
@@ -300,19 +318,7 @@ func (c *Config) setAuthCredentialByEcsRoleName() (err error) {
 	if c.AccessKey != "" || c.EcsRoleName == "" {
 		return
 	}
-	config := new(credential.Config).SetType("ecs_ram_role").SetRoleName(c.EcsRoleName)
-	provider, err := credential.NewCredential(config)
-	if err != nil {
-		return
-	}
-	c.Credential = provider
-	credential, err := provider.GetCredential()
-	if err != nil || credential == nil {
-		return fmt.Errorf("refresh Ecs Ram Role credential failed. Error: %v", err)
-	}
-
-	c.AccessKey, c.SecretKey, c.SecurityToken = *credential.AccessKeyId, *credential.AccessKeySecret, *credential.SecurityToken
-	return
+	return c.setAuthByCredentialManager(NewCredentialManager("ecs_ram_role", c.clock, c.ecsRoleCredentials))
 }
 
 // setAuthCredentialByOidc aims to access meta to get sts credential
@@ -322,95 +328,88 @@ func (c *Config) setAuthCredentialByOidc() (err error) {
 	if c.AccessKey != "" || c.AssumeRoleWithOidc == nil {
 		return
 	}
-	credConfig := new(credential.Config)
-	if c.AssumeRoleWithOidc.OIDCToken == "" && c.AssumeRoleWithOidc.OIDCTokenFile != "" {
-		credConfig.SetType("oidc_role_arn").
-			SetOIDCProviderArn(c.AssumeRoleWithOidc.OIDCProviderArn).
-			SetOIDCTokenFilePath(c.AssumeRoleWithOidc.OIDCTokenFile).
-			SetRoleSessionName(c.AssumeRoleWithOidc.RoleSessionName).
-			SetPolicy(c.AssumeRoleWithOidc.Policy).
-			SetRoleArn(c.AssumeRoleWithOidc.RoleARN).
-			SetSessionExpiration(c.AssumeRoleWithOidc.DurationSeconds)
-		if c.StsEndpoint != "" {
-			credConfig.SetSTSEndpoint(c.StsEndpoint)
-		}
-		if c.ClientConnectTimeout != 0 {
-			credConfig.SetConnectTimeout(c.ClientConnectTimeout)
-		}
-		if c.ClientReadTimeout != 0 {
-			credConfig.SetTimeout(c.ClientReadTimeout)
-		}
-	} else {
-		conf := &openapi.Config{
-			RegionId:  tea.String(c.RegionId),
-			Endpoint:  tea.String(c.StsEndpoint),
-			UserAgent: tea.String(c.getUserAgent()),
-			// currently, sts endpoint only supports https
-			Protocol:       tea.String("HTTPS"),
-			ReadTimeout:    tea.Int(c.ClientReadTimeout),
-			ConnectTimeout: tea.Int(c.ClientConnectTimeout),
-			MaxIdleConns:   tea.Int(500),
-		}
-		query := map[string]*string{
-			"AcceptLanguage": tea.String("en-US"),
-		}
-		if c.SourceIp != "" {
-			query["SourceIp"] = tea.String(c.SourceIp)
+	return c.setAuthByCredentialManager(NewCredentialManager("oidc_role_arn", c.clock, c.assumeRoleWithOidc))
+}
+
+// assumeRoleWithOidc calls the STS AssumeRoleWithOIDC. The token file is read each time, because the token mounted by
+// the service account is rotated.
+func (c *Config) assumeRoleWithOidc() (*providers.Credentials, time.Time, error) {
+	token := c.AssumeRoleWithOidc.OIDCToken
+	if c.AssumeRoleWithOidc.OIDCTokenFile != "" {
+		data, err := ioutil.ReadFile(c.AssumeRoleWithOidc.OIDCTokenFile)
+		if err != nil && token == "" {
+			return nil, time.Time{}, fmt.Errorf("reading the OIDC token file %s got an error: %v", c.AssumeRoleWithOidc.OIDCTokenFile, err)
 		}
-		if c.SecureTransport != "" {
-			query["SecureTransport"] = tea.String(c.SecureTransport)
+		if err == nil {
+			token = strings.TrimSpace(string(data))
 		}
+	}
+	conf := &openapi.Config{
+		RegionId:  tea.String(c.RegionId),
+		Endpoint:  tea.String(c.StsEndpoint),
+		UserAgent: tea.String(c.getUserAgent()),
+		// currently, sts endpoint only supports https
+		Protocol:       tea.String("HTTPS"),
+		ReadTimeout:    tea.Int(c.ClientReadTimeout),
+		ConnectTimeout: tea.Int(c.ClientConnectTimeout),
+		MaxIdleConns:   tea.Int(500),
+	}
+	query := map[string]*string{
+		"AcceptLanguage": tea.String("en-US"),
+	}
+	if c.SourceIp != "" {
+		query["SourceIp"] = tea.String(c.SourceIp)
+	}
+	if c.SecureTransport != "" {
+		query["SecureTransport"] = tea.String(c.SecureTransport)
+	}
 
-		param := &openapi.GlobalParameters{Queries: query}
-		conf.GlobalParameters = param
-		stsClient, err := sts20150401.NewClient(conf)
-		if err != nil {
-			return fmt.Errorf("refreshing credential failed when building sts client. Error: %v", err)
-		}
+	param := &openapi.GlobalParameters{Queries: query}
+	conf.GlobalParameters = param
+	stsClient, err := sts20150401.NewClient(conf)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("building sts client got an error: %v", err)
+	}
 
-		request := &sts20150401.AssumeRoleWithOIDCRequest{
-			OIDCProviderArn: tea.String(c.AssumeRoleWithOidc.OIDCProviderArn),
-			RoleArn:         tea.String(c.AssumeRoleWithOidc.RoleARN),
-			OIDCToken:       tea.String(c.AssumeRoleWithOidc.OIDCToken),
-			RoleSessionName: tea.String(c.AssumeRoleWithOidc.RoleSessionName),
-		}
-		if c.AssumeRoleWithOidc.Policy != "" {
-			request.Policy = tea.String(c.AssumeRoleWithOidc.Policy)
-		}
-		if c.AssumeRoleWithOidc.DurationSeconds != 0 {
-			request.DurationSeconds = tea.Int64(int64(c.AssumeRoleWithOidc.DurationSeconds))
-		}
-		runtime := &util.RuntimeOptions{}
-		var response *sts20150401.AssumeRoleWithOIDCResponse
-		maxRetries := 5
-		for i := 0; i <= maxRetries; i++ {
-			response, err = stsClient.AssumeRoleWithOIDCWithOptions(request, runtime)
-			if err != nil {
-				if needRetry(err) && i < maxRetries {
-					time.Sleep(time.Duration(i))
-					continue
-				}
-				return fmt.Errorf("refreshing credential failed by AssumeRoleWithOIDC. Error: %v", err)
+	request := &sts20150401.AssumeRoleWithOIDCRequest{
+		OIDCProviderArn: tea.String(c.AssumeRoleWithOidc.OIDCProviderArn),
+		RoleArn:         tea.String(c.AssumeRoleWithOidc.RoleARN),
+		OIDCToken:       tea.String(token),
+		RoleSessionName: tea.String(c.AssumeRoleWithOidc.RoleSessionName),
+	}
+	if c.AssumeRoleWithOidc.Policy != "" {
+		request.Policy = tea.String(c.AssumeRoleWithOidc.Policy)
+	}
+	if c.AssumeRoleWithOidc.DurationSeconds != 0 {
+		request.DurationSeconds = tea.Int64(int64(c.AssumeRoleWithOidc.DurationSeconds))
+	}
+	runtime := &util.RuntimeOptions{}
+	var response *sts20150401.AssumeRoleWithOIDCResponse
+	maxRetries := 5
+	for i := 0; i <= maxRetries; i++ {
+		response, err = stsClient.AssumeRoleWithOIDCWithOptions(request, runtime)
+		if err != nil {
+			if needRetry(err) && i < maxRetries {
+				time.Sleep(time.Duration(i))
+				continue
 			}
-			break
+			return nil, time.Time{}, fmt.Errorf("AssumeRoleWithOIDC got an error: %v", err)
 		}
-		credConfig.SetType("sts").
-			SetAccessKeyId(*response.Body.Credentials.AccessKeyId).
-			SetAccessKeySecret(*response.Body.Credentials.AccessKeySecret).
-			SetSecurityToken(*response.Body.Credentials.SecurityToken)
+		break
 	}
-	provider, err := credential.NewCredential(credConfig)
-	if err != nil {
-		return
+	if response.Body == nil || response.Body.Credentials == nil {
+		return nil, time.Time{}, fmt.Errorf("the AssumeRoleWithOIDC response misses the credentials")
 	}
-	c.Credential = provider
-	credential, err := provider.GetCredential()
-	if err != nil || credential == nil {
-		return fmt.Errorf("refresh OIDC credential failed. Error: %v", err)
+	credential := response.Body.Credentials
+	expiration, err := time.Parse(time.RFC3339, tea.StringValue(credential.Expiration))
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("parsing the expiration %q got an error: %v", tea.StringValue(credential.Expiration), err)
 	}
-
-	c.AccessKey, c.SecretKey, c.SecurityToken = *credential.AccessKeyId, *credential.AccessKeySecret, *credential.SecurityToken
-	return
+	return &providers.Credentials{
+		AccessKeyId:     tea.StringValue(credential.AccessKeyId),
+		AccessKeySecret: tea.StringValue(credential.AccessKeySecret),
+		SecurityToken:   tea.StringValue(credential.SecurityToken),
+	}, expiration, nil
 }
 func needRetry(err error) bool {
 	postRegex := regexp.MustCompile("^Post [\"]*https://.*")
@@ -454,6 +453,9 @@ func isExpectedErrors(err error, expectCodes []string) bool {
 	return false
 }
 func (c *Config) RefreshAuthCredential() error {
+	if err := c.setAuthCredentialByProcess(); err != nil {
+		return err
+	}
 	if err := c.setAuthCredentialByEcsRoleName(); err != nil {
 		return err
 	}
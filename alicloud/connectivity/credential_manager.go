@@ -0,0 +1,245 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/credentials-go/credentials"
+	"github.com/aliyun/credentials-go/credentials/providers"
+)
+
+// Clock tells the current time. It is replaced by a fake clock in the tests.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// CredentialManager owns the temporary credentials of the assume_role, assume_role_with_oidc and ECS RAM role, which
+// expire in an hour by default while an apply creating an ACK or PolarDB cluster may run much longer. The credentials are
+// refreshed credentialsRefreshAhead before they expire, and each refresh bumps the generation, so that the AliyunClient
+// drops the cached connections signing with the previous security token.
+type CredentialManager struct {
+	name    string
+	refresh func() (*providers.Credentials, time.Time, error)
+	clock   Clock
+
+	mutex       sync.Mutex
+	credentials *providers.Credentials
+	expiration  time.Time
+	generation  uint64
+}
+
+// NewCredentialManager returns a CredentialManager loading the credentials by the refresh, which returns them with their
+// expiration. A nil clock is the system clock.
+func NewCredentialManager(name string, clock Clock, refresh func() (*providers.Credentials, time.Time, error)) *CredentialManager {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &CredentialManager{name: name, refresh: refresh, clock: clock}
+}
+
+// GetCredentials returns the credentials, refreshing them when they are about to expire.
+func (m *CredentialManager) GetCredentials() (*providers.Credentials, error) {
+	credentials, _, err := m.current()
+	return credentials, err
+}
+
+func (m *CredentialManager) GetProviderName() string {
+	return m.name
+}
+
+// Expiration returns the expiration of the current credentials.
+func (m *CredentialManager) Expiration() time.Time {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.expiration
+}
+
+// Generation returns how many times the credentials have been loaded.
+func (m *CredentialManager) Generation() uint64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.generation
+}
+
+func (m *CredentialManager) current() (*providers.Credentials, uint64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.credentials == nil || (!m.expiration.IsZero() && m.clock.Now().Add(credentialsRefreshAhead).After(m.expiration)) {
+		credentials, expiration, err := m.refresh()
+		if err != nil {
+			return nil, m.generation, err
+		}
+		m.credentials, m.expiration = credentials, expiration
+		m.generation++
+		log.Printf("[DEBUG] the %s credential has been refreshed, expiration: %s", m.name, expiration.Format(time.RFC3339))
+	}
+	return &providers.Credentials{
+		AccessKeyId:     m.credentials.AccessKeyId,
+		AccessKeySecret: m.credentials.AccessKeySecret,
+		SecurityToken:   m.credentials.SecurityToken,
+		ProviderName:    m.name,
+	}, m.generation, nil
+}
+
+// setAuthByCredentialManager makes the credentials of the manager the ones used by the clients.
+func (c *Config) setAuthByCredentialManager(manager *CredentialManager) error {
+	creds, err := manager.GetCredentials()
+	if err != nil {
+		return fmt.Errorf("refresh %s credential failed. Error: %v", manager.name, err)
+	}
+	c.credentialManager = manager
+	c.Credential = credentials.FromCredentialsProvider(manager.name, manager)
+	c.AccessKey, c.SecretKey, c.SecurityToken = creds.AccessKeyId, creds.AccessKeySecret, creds.SecurityToken
+	return nil
+}
+
+// metadataTokenTTLSeconds is how long the metadata token is valid, which is the same as the one of credentials-go.
+const metadataTokenTTLSeconds = 21600
+
+type ecsRoleCredentialsResponse struct {
+	Code            string `json:"Code"`
+	AccessKeyId     string `json:"AccessKeyId"`
+	AccessKeySecret string `json:"AccessKeySecret"`
+	SecurityToken   string `json:"SecurityToken"`
+	Expiration      string `json:"Expiration"`
+}
+
+// ecsRoleCredentials reads the credentials of the ECS RAM role from the instance metadata service. Like the ecs_ram_role
+// provider of credentials-go, it signs the request with a metadata token, and only falls back to IMDSv1 without the token
+// when ALIBABA_CLOUD_IMDSV1_DISABLED is not true.
+func (c *Config) ecsRoleCredentials() (*providers.Credentials, time.Time, error) {
+	if strings.ToLower(os.Getenv("ALIBABA_CLOUD_ECS_METADATA_DISABLED")) == "true" {
+		return nil, time.Time{}, fmt.Errorf("getting the credentials of the ECS RAM role %s got an error: IMDS credentials is disabled", c.EcsRoleName)
+	}
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	token, err := metadataToken(httpClient)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	req, err := http.NewRequest(http.MethodGet, securityCredURL+c.EcsRoleName, nil)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if token != "" {
+		req.Header.Set("X-aliyun-ecs-metadata-token", token)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var response ecsRoleCredentialsResponse
+	if err := json.Unmarshal(data, &response); err != nil || resp.StatusCode != http.StatusOK || response.Code != "Success" {
+		return nil, time.Time{}, fmt.Errorf("getting the credentials of the ECS RAM role %s got an error: status %d, response %s", c.EcsRoleName, resp.StatusCode, string(data))
+	}
+	expiration, err := time.Parse(time.RFC3339, response.Expiration)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("parsing the ECS RAM role credential expiration %q got an error: %v", response.Expiration, err)
+	}
+	return &providers.Credentials{
+		AccessKeyId:     response.AccessKeyId,
+		AccessKeySecret: response.AccessKeySecret,
+		SecurityToken:   response.SecurityToken,
+	}, expiration, nil
+}
+
+// metadataToken gets the IMDSv2 token of the instance metadata service. The failure is ignored and the token is empty
+// unless ALIBABA_CLOUD_IMDSV1_DISABLED is true, in which case the credentials can not be read without the token.
+func metadataToken(httpClient *http.Client) (string, error) {
+	disableIMDSv1 := strings.ToLower(os.Getenv("ALIBABA_CLOUD_IMDSV1_DISABLED")) == "true"
+	req, err := http.NewRequest(http.MethodPut, metadataTokenURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-aliyun-ecs-metadata-token-ttl-seconds", strconv.Itoa(metadataTokenTTLSeconds))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		if disableIMDSv1 {
+			return "", fmt.Errorf("getting the metadata token got an error: %v", err)
+		}
+		return "", nil
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		if disableIMDSv1 {
+			return "", fmt.Errorf("getting the metadata token got an error: status %d, response %s", resp.StatusCode, string(data))
+		}
+		return "", nil
+	}
+	return string(data), nil
+}
+
+// connectionState is the lock of a cached connection and the generation of the credentials it is built with.
+type connectionState struct {
+	sync.Mutex
+	generation uint64
+}
+
+// connectionState returns the state of the connection of the key, which is the address of the field caching it or the
+// product and the instance name of the connections cached by instance.
+func (client *AliyunClient) connectionState(key interface{}) *connectionState {
+	state, _ := client.connectionStates.LoadOrStore(key, &connectionState{})
+	return state.(*connectionState)
+}
+
+// credentialGeneration returns the generation of the current credentials, and whether the connections have to be built
+// with every use because the credentials are refreshed by the credentials-go provider instead of a CredentialManager.
+func (client *AliyunClient) credentialGeneration() (uint64, bool) {
+	manager := client.config.credentialManager
+	if manager == nil {
+		return 0, client.config.needRefreshCredential()
+	}
+	_, generation, err := manager.current()
+	if err != nil {
+		log.Printf("[WARN] refreshing the %s credential got an error: %v", manager.name, err)
+		return manager.Generation(), false
+	}
+	return generation, false
+}
+
+// upToDate reports whether the connection of the state is built with the current credentials, and returns the
+// generation to record once it is built again. The caller must hold the lock of the state or the goSdkMutex.
+func (client *AliyunClient) upToDate(state *connectionState) (uint64, bool) {
+	generation, always := client.credentialGeneration()
+	return generation, !always && state.generation == generation
+}
+
+// cachedConnection returns the connection cached in the conn, and builds it again by the build when it has not been
+// built yet or the credentials it is built with have been refreshed. Both the check and the build hold the lock of the
+// connection, so that the goroutines of a parallel apply never use a connection which is being replaced.
+func cachedConnection[T comparable](client *AliyunClient, conn *T, build func() (T, error)) (T, error) {
+	state := client.connectionState(conn)
+	state.Lock()
+	defer state.Unlock()
+
+	var zero T
+	generation, ok := client.upToDate(state)
+	if ok && *conn != zero {
+		return *conn, nil
+	}
+	built, err := build()
+	if err != nil {
+		return zero, err
+	}
+	*conn, state.generation = built, generation
+	return built, nil
+}
@@ -0,0 +1,228 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/aliyun/credentials-go/credentials/providers"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// fakeSession returns the credentials numbered by the refreshes, which expire in an hour.
+func fakeSession(clock *fakeClock, refreshes *int) func() (*providers.Credentials, time.Time, error) {
+	return func() (*providers.Credentials, time.Time, error) {
+		*refreshes++
+		return &providers.Credentials{
+			AccessKeyId:     fmt.Sprintf("STS.AccessKeyId%d", *refreshes),
+			AccessKeySecret: "AccessKeySecret",
+			SecurityToken:   fmt.Sprintf("SecurityToken%d", *refreshes),
+		}, clock.Now().Add(time.Hour), nil
+	}
+}
+
+func TestCredentialManager(t *testing.T) {
+	clock := newFakeClock()
+	refreshes := 0
+	manager := NewCredentialManager("ram_role_arn", clock, fakeSession(clock, &refreshes))
+
+	credentials, err := manager.GetCredentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "STS.AccessKeyId1", credentials.AccessKeyId)
+	assert.Equal(t, "ram_role_arn", credentials.ProviderName)
+	assert.Equal(t, uint64(1), manager.Generation())
+	assert.Equal(t, clock.Now().Add(time.Hour), manager.Expiration())
+
+	clock.Advance(56 * time.Minute)
+	credentials, err = manager.GetCredentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "STS.AccessKeyId1", credentials.AccessKeyId)
+
+	// The credentials are refreshed ahead of the expiration
+	clock.Advance(2 * time.Minute)
+	credentials, err = manager.GetCredentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "STS.AccessKeyId2", credentials.AccessKeyId)
+	assert.Equal(t, "SecurityToken2", credentials.SecurityToken)
+	assert.Equal(t, uint64(2), manager.Generation())
+}
+
+func TestCredentialManagerError(t *testing.T) {
+	clock := newFakeClock()
+	refreshes := 0
+	session := fakeSession(clock, &refreshes)
+	failing := false
+	manager := NewCredentialManager("oidc_role_arn", clock, func() (*providers.Credentials, time.Time, error) {
+		if failing {
+			return nil, time.Time{}, fmt.Errorf("Throttling")
+		}
+		return session()
+	})
+	config := &Config{}
+	assert.Nil(t, config.setAuthByCredentialManager(manager))
+	assert.Equal(t, "STS.AccessKeyId1", config.AccessKey)
+
+	failing = true
+	clock.Advance(time.Hour)
+	_, err := manager.GetCredentials()
+	assert.Contains(t, err.Error(), "Throttling")
+	assert.Equal(t, uint64(1), manager.Generation())
+
+	// The failed refresh is retried by the next request
+	failing = false
+	credential, err := config.Credential.GetCredential()
+	assert.Nil(t, err)
+	assert.Equal(t, "STS.AccessKeyId2", *credential.AccessKeyId)
+}
+
+func TestConfigEcsRoleCredentialManager(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest/api/token" {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "21600", r.Header.Get("X-aliyun-ecs-metadata-token-ttl-seconds"))
+			w.Write([]byte("MetadataToken"))
+			return
+		}
+		calls++
+		assert.Equal(t, "/latest/meta-data/ram/security-credentials/EcsRole", r.URL.Path)
+		assert.Equal(t, "MetadataToken", r.Header.Get("X-aliyun-ecs-metadata-token"))
+		w.Write([]byte(fmt.Sprintf(`{"Code": "Success", "AccessKeyId": "STS.EcsAccessKeyId%d", "AccessKeySecret": "EcsAccessKeySecret", "SecurityToken": "EcsSecurityToken", "Expiration": "2024-01-01T01:00:00Z"}`, calls)))
+	}))
+	defer server.Close()
+	defer func(url, tokenURL string) { securityCredURL, metadataTokenURL = url, tokenURL }(securityCredURL, metadataTokenURL)
+	securityCredURL = server.URL + "/latest/meta-data/ram/security-credentials/"
+	metadataTokenURL = server.URL + "/latest/api/token"
+
+	clock := newFakeClock()
+	config := &Config{EcsRoleName: "EcsRole", clock: clock}
+	assert.Nil(t, config.RefreshAuthCredential())
+	assert.Equal(t, "STS.EcsAccessKeyId1", config.AccessKey)
+	assert.Equal(t, "EcsSecurityToken", config.SecurityToken)
+
+	clock.Advance(58 * time.Minute)
+	accessKey, _, _ := config.GetRefreshCredential()
+	assert.Equal(t, "STS.EcsAccessKeyId2", accessKey)
+	assert.Equal(t, 2, calls)
+}
+
+func TestConfigEcsRoleCredentialWithoutMetadataToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest/api/token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		assert.Equal(t, "", r.Header.Get("X-aliyun-ecs-metadata-token"))
+		w.Write([]byte(`{"Code": "Success", "AccessKeyId": "STS.EcsAccessKeyId", "AccessKeySecret": "EcsAccessKeySecret", "SecurityToken": "EcsSecurityToken", "Expiration": "2024-01-01T01:00:00Z"}`))
+	}))
+	defer server.Close()
+	defer func(url, tokenURL string) { securityCredURL, metadataTokenURL = url, tokenURL }(securityCredURL, metadataTokenURL)
+	securityCredURL = server.URL + "/latest/meta-data/ram/security-credentials/"
+	metadataTokenURL = server.URL + "/latest/api/token"
+
+	// IMDSv1 is used when the metadata token is not available
+	config := &Config{EcsRoleName: "EcsRole", clock: newFakeClock()}
+	_, _, err := config.ecsRoleCredentials()
+	assert.Nil(t, err)
+
+	t.Setenv("ALIBABA_CLOUD_IMDSV1_DISABLED", "true")
+	_, _, err = config.ecsRoleCredentials()
+	assert.NotNil(t, err)
+}
+
+func TestClientRebuildsConnectionsWithRefreshedCredential(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+
+	clock := newFakeClock()
+	refreshes := 0
+	assert.Nil(t, client.config.setAuthByCredentialManager(NewCredentialManager("ram_role_arn", clock, fakeSession(clock, &refreshes))))
+
+	var first, second *ecs.Client
+	_, err = client.WithEcsClient(func(conn *ecs.Client) (interface{}, error) {
+		first = conn
+		return nil, nil
+	})
+	assert.Nil(t, err)
+	_, err = client.WithEcsClient(func(conn *ecs.Client) (interface{}, error) {
+		assert.Same(t, first, conn)
+		return nil, nil
+	})
+	assert.Nil(t, err)
+
+	// The cached connections are dropped once the credentials are refreshed
+	clock.Advance(time.Hour)
+	_, err = client.WithEcsClient(func(conn *ecs.Client) (interface{}, error) {
+		second = conn
+		return nil, nil
+	})
+	assert.Nil(t, err)
+	assert.NotSame(t, first, second)
+	assert.Equal(t, 2, refreshes)
+	assert.Equal(t, uint64(2), client.connectionState(&client.ecsconn).generation)
+	assert.Equal(t, "STS.AccessKeyId2", client.config.AccessKey)
+}
+
+func TestClientRebuildsConnectionsInParallel(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+
+	clock := newFakeClock()
+	refreshes := 0
+	assert.Nil(t, client.config.setAuthByCredentialManager(NewCredentialManager("ram_role_arn", clock, fakeSession(clock, &refreshes))))
+
+	// The connections in use are never dropped by the goroutine seeing the refreshed credentials first
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i == 10 {
+				clock.Advance(time.Hour)
+			}
+			_, err := client.WithEcsClient(func(conn *ecs.Client) (interface{}, error) {
+				assert.NotNil(t, conn)
+				return nil, nil
+			})
+			assert.Nil(t, err)
+			_, err = client.WithVpcClient(func(conn *vpc.Client) (interface{}, error) {
+				assert.NotNil(t, conn)
+				return nil, nil
+			})
+			assert.Nil(t, err)
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, uint64(2), client.connectionState(&client.ecsconn).generation)
+	assert.Equal(t, uint64(2), client.connectionState(&client.vpcconn).generation)
+}
@@ -0,0 +1,95 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/aliyun/credentials-go/credentials"
+	"github.com/aliyun/credentials-go/credentials/providers"
+)
+
+// credentialProcessProvider runs the command set by the provider credential_process, which prints the credentials like
+// {"AccessKeyId": "...", "AccessKeySecret": "...", "SecurityToken": "...", "Expiration": "2006-01-02T15:04:05Z"}.
+// The SecurityToken and Expiration are optional, and the command runs again before the credentials expire.
+type credentialProcessProvider struct {
+	command []string
+	session sessionCredentials
+}
+
+type credentialProcessOutput struct {
+	AccessKeyId     string `json:"AccessKeyId"`
+	AccessKeySecret string `json:"AccessKeySecret"`
+	SecurityToken   string `json:"SecurityToken"`
+	Expiration      string `json:"Expiration"`
+}
+
+func (p *credentialProcessProvider) GetCredentials() (*providers.Credentials, error) {
+	return p.session.get(p.GetProviderName(), p.run)
+}
+
+func (p *credentialProcessProvider) GetProviderName() string {
+	return "credential_process"
+}
+
+func (p *credentialProcessProvider) run() (*providers.Credentials, time.Time, error) {
+	data, err := runCredentialCommand("credential_process", p.command)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var output credentialProcessOutput
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, time.Time{}, fmt.Errorf("parsing the output of the credential_process %s got an error: %v", p.command[0], err)
+	}
+	if output.AccessKeyId == "" || output.AccessKeySecret == "" {
+		return nil, time.Time{}, fmt.Errorf("the output of the credential_process %s misses the AccessKeyId or AccessKeySecret", p.command[0])
+	}
+	var expiration time.Time
+	if output.Expiration != "" {
+		expiration, err = time.Parse(time.RFC3339, output.Expiration)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("parsing the Expiration %q in the output of the credential_process %s got an error: %v", output.Expiration, p.command[0], err)
+		}
+	}
+	return &providers.Credentials{
+		AccessKeyId:     output.AccessKeyId,
+		AccessKeySecret: output.AccessKeySecret,
+		SecurityToken:   output.SecurityToken,
+	}, expiration, nil
+}
+
+// runCredentialCommand runs the command printing the credentials and returns its output. The command is not run by a
+// shell, and its stderr is reported when it fails.
+func runCredentialCommand(name string, command []string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), externalProcessTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("running the %s %s got an error: %v. Stderr: %s", name, command[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// setAuthCredentialByProcess loads the credentials by the credential_process. It runs the command only when there are no
+// credentials or they are about to expire, so it is cheap to call it each time the credentials are refreshed.
+func (c *Config) setAuthCredentialByProcess() error {
+	if len(c.CredentialProcess) == 0 {
+		return nil
+	}
+	if c.credentialProcess == nil {
+		c.credentialProcess = &credentialProcessProvider{command: c.CredentialProcess}
+	}
+	creds, err := c.credentialProcess.GetCredentials()
+	if err != nil {
+		return fmt.Errorf("refresh credential by the credential_process failed. Error: %v", err)
+	}
+	c.Credential = credentials.FromCredentialsProvider(c.credentialProcess.GetProviderName(), c.credentialProcess)
+	c.AccessKey, c.SecretKey, c.SecurityToken = creds.AccessKeyId, creds.AccessKeySecret, creds.SecurityToken
+	return nil
+}
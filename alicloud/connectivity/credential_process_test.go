@@ -0,0 +1,49 @@
+package connectivity
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigCredentialProcess(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "counter")
+	expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	config := &Config{CredentialProcess: []string{"sh", "testdata/credential_process.sh", counter, expiration}}
+	assert.Nil(t, config.RefreshAuthCredential())
+	assert.Equal(t, "STS.ProcessAccessKeyId1", config.AccessKey)
+	assert.Equal(t, "ProcessAccessKeySecret", config.SecretKey)
+	assert.Equal(t, "ProcessSecurityToken", config.SecurityToken)
+	assert.True(t, config.needRefreshCredential())
+
+	// The credentials are cached until they are about to expire
+	assert.Nil(t, config.RefreshAuthCredential())
+	credential, err := config.Credential.GetCredential()
+	assert.Nil(t, err)
+	assert.Equal(t, "STS.ProcessAccessKeyId1", *credential.AccessKeyId)
+}
+
+func TestConfigCredentialProcessExpiring(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "counter")
+	expiration := time.Now().Add(time.Minute).UTC().Format(time.RFC3339)
+	config := &Config{CredentialProcess: []string{"sh", "testdata/credential_process.sh", counter, expiration}}
+	assert.Nil(t, config.RefreshAuthCredential())
+	assert.Equal(t, "STS.ProcessAccessKeyId1", config.AccessKey)
+	assert.Nil(t, config.RefreshAuthCredential())
+	assert.Equal(t, "STS.ProcessAccessKeyId2", config.AccessKey)
+	credential, err := config.Credential.GetCredential()
+	assert.Nil(t, err)
+	assert.Equal(t, "STS.ProcessAccessKeyId3", *credential.AccessKeyId)
+}
+
+func TestConfigCredentialProcessError(t *testing.T) {
+	config := &Config{CredentialProcess: []string{"sh", "-c", "echo denied >&2; exit 1"}}
+	err := config.RefreshAuthCredential()
+	assert.Contains(t, err.Error(), "Stderr: denied")
+
+	config = &Config{CredentialProcess: []string{"echo", `{"AccessKeyId": "id"}`}}
+	err = config.RefreshAuthCredential()
+	assert.Contains(t, err.Error(), "misses the AccessKeyId or AccessKeySecret")
+}
@@ -114,7 +114,7 @@ var loadLocalEndpoint = false
 func hasLocalEndpoint() bool {
 	data, err := ioutil.ReadFile(localEndpointPath)
 	if err != nil || len(data) <= 0 {
-		d, e := ioutil.ReadFile(os.Getenv(localEndpointPathEnv))
+		d, e := readLocalEndpointPathEnv()
 		if e != nil {
 			return false
 		}
@@ -123,6 +123,15 @@ func hasLocalEndpoint() bool {
 	return len(data) > 0
 }
 
+// readLocalEndpointPathEnv reads the endpoints.xml set by TF_ENDPOINT_PATH. A JSON or YAML file is loaded as the endpoint file instead.
+func readLocalEndpointPathEnv() ([]byte, error) {
+	file := os.Getenv(localEndpointPathEnv)
+	if IsEndpointFile(file) {
+		return nil, fmt.Errorf("%s is not an endpoints.xml file", file)
+	}
+	return ioutil.ReadFile(file)
+}
+
 func LoadRegionalEndpoint(region string, serviceCode string) string {
 	if region == "" || serviceCode == "" {
 		return ""
@@ -142,7 +151,7 @@ func loadEndpoint(region string, serviceCode ServiceCode) string {
 	}
 	data, err := ioutil.ReadFile(localEndpointPath)
 	if err != nil || len(data) <= 0 {
-		d, e := ioutil.ReadFile(os.Getenv(localEndpointPathEnv))
+		d, e := readLocalEndpointPathEnv()
 		if e != nil {
 			return ""
 		}
@@ -530,13 +539,8 @@ var regularProductEndpointReplace = map[string]string{
 // NOTE: The productCode must be lowed.
 func (client *AliyunClient) loadEndpoint(productCode string) error {
 	// Firstly, load endpoint from environment variables
-	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("ALIBABA_CLOUD_ENDPOINT_%s", strings.ToUpper(productCode))))
-	if endpoint == "" {
-		// Compatible with the previous implementation method
-		endpoint = strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", strings.ToUpper(productCode))))
-	}
-	if endpoint != "" {
-		client.config.Endpoints.Store(productCode, endpoint)
+	if name, endpoint := EndpointFromEnv(productCode); endpoint != "" {
+		client.storeEndpoint(productCode, endpoint, "environment variable "+name)
 		return nil
 	}
 
@@ -554,12 +558,12 @@ func (client *AliyunClient) loadEndpoint(productCode string) error {
 		if strings.Contains(endpointFmt, "%s") {
 			endpointFmt = fmt.Sprintf(endpointFmt, client.RegionId)
 		}
-		client.config.Endpoints.Store(productCode, endpointFmt)
+		client.storeEndpoint(productCode, endpointFmt, "built-in endpoint rule")
 		return nil
 	}
 
-	// Thirdly, load endpoint from location
-	endpoint, err := client.describeEndpointForService(productCode)
+	// Thirdly, load endpoint from location, or the endpoint cache if it is enabled
+	endpoint, source, err := client.describeEndpointWithCache(productCode)
 	if err == nil {
 		if v, ok := regularProductEndpointForIntlAccount[productCode]; ok && client.IsInternationalAccount() {
 			endpoint = v
@@ -567,7 +571,7 @@ func (client *AliyunClient) loadEndpoint(productCode string) error {
 		if v, ok := regularProductEndpointReplace[endpoint]; ok {
 			endpoint = v
 		}
-		client.config.Endpoints.Store(strings.ToLower(productCode), endpoint)
+		client.storeEndpoint(strings.ToLower(productCode), endpoint, source)
 	} else if endpointFmt, ok := regularProductEndpoint[productCode]; ok {
 		if v, ok := regularProductEndpointForIntlRegion[productCode]; ok && client.isInternationalRegion() {
 			endpointFmt = v
@@ -584,21 +588,40 @@ func (client *AliyunClient) loadEndpoint(productCode string) error {
 		if v, ok := regularProductEndpointReplace[endpointFmt]; ok {
 			endpointFmt = v
 		}
-		client.config.Endpoints.Store(productCode, endpointFmt)
+		client.storeEndpoint(productCode, endpointFmt, "built-in endpoint rule")
 		log.Printf("[WARN] loading %s endpoint got an error: %#v. Using the endpoint %s instead.", productCode, err, endpointFmt)
 		return nil
 	}
 	return err
 }
 
+// EndpointFromEnv returns the environment variable setting the endpoint of the product and its value.
+func EndpointFromEnv(productCode string) (string, string) {
+	name := fmt.Sprintf("ALIBABA_CLOUD_ENDPOINT_%s", strings.ToUpper(productCode))
+	if endpoint := strings.TrimSpace(os.Getenv(name)); endpoint != "" {
+		return name, endpoint
+	}
+	// Compatible with the previous implementation method
+	name = fmt.Sprintf("%s_ENDPOINT", strings.ToUpper(productCode))
+	return name, strings.TrimSpace(os.Getenv(name))
+}
+
+func (client *AliyunClient) storeEndpoint(productCode, endpoint, source string) {
+	client.config.Endpoints.Store(productCode, endpoint)
+	client.config.endpointSources.Store(productCode, source)
+	log.Printf("[DEBUG] loaded the %s endpoint %s from the %s.", productCode, endpoint, source)
+}
+
 // Load current path endpoint file endpoints.xml, if failed, it will load from environment variables TF_ENDPOINT_PATH
 func (config *Config) loadEndpointFromLocal() error {
+	source := localEndpointPath
 	data, err := ioutil.ReadFile(localEndpointPath)
 	if err != nil || len(data) <= 0 {
-		d, e := ioutil.ReadFile(os.Getenv(localEndpointPathEnv))
+		d, e := readLocalEndpointPathEnv()
 		if e != nil {
 			return e
 		}
+		source = os.Getenv(localEndpointPathEnv)
 		data = d
 	}
 	var endpoints Endpoints
@@ -610,6 +633,7 @@ func (config *Config) loadEndpointFromLocal() error {
 		if endpoint.RegionIds.RegionId == string(config.RegionId) {
 			for _, product := range endpoint.Products.Product {
 				config.Endpoints.Store(strings.ToLower(product.ProductName), strings.TrimSpace(product.DomainName))
+				config.endpointSources.Store(strings.ToLower(product.ProductName), "endpoint file "+source)
 			}
 		}
 	}
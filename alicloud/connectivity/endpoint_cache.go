@@ -0,0 +1,136 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// EndpointCachePolicy is configured by the provider endpoint_cache block.
+type EndpointCachePolicy struct {
+	// Path is the cache file shared by the provider processes, like ~/.terraform.d/alicloud-endpoints.json.
+	Path string
+	// TTL is how long a cached endpoint is used before it is described from the Location service again.
+	TTL time.Duration
+}
+
+// EndpointCache persists the endpoints described from the Location service, so that the following provider processes,
+// like the ones of the next terraform plan, do not describe them again. An expired endpoint is still used when the
+// Location service fails.
+type EndpointCache struct {
+	policy EndpointCachePolicy
+	mutex  sync.Mutex
+}
+
+type endpointCacheFile struct {
+	Endpoints map[string]endpointCacheEntry `json:"endpoints"`
+}
+
+type endpointCacheEntry struct {
+	Endpoint  string    `json:"endpoint"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewEndpointCache returns an EndpointCache of the policy.
+func NewEndpointCache(policy EndpointCachePolicy) *EndpointCache {
+	return &EndpointCache{policy: policy}
+}
+
+// Get returns the cached endpoint of the product in the region, and whether it has not expired.
+func (c *EndpointCache) Get(regionId, productCode string) (endpoint string, fresh bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.read().Endpoints[endpointCacheKey(regionId, productCode)]
+	if !ok {
+		return "", false
+	}
+	return entry.Endpoint, time.Now().Before(entry.ExpiresAt)
+}
+
+// Put caches the endpoint of the product in the region. The file is read again before writing, so the endpoints cached by
+// the other provider processes are kept.
+func (c *EndpointCache) Put(regionId, productCode, endpoint string) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	cache := c.read()
+	cache.Endpoints[endpointCacheKey(regionId, productCode)] = endpointCacheEntry{
+		Endpoint:  endpoint,
+		ExpiresAt: time.Now().Add(c.policy.TTL),
+	}
+	if err := c.write(cache); err != nil {
+		log.Printf("[WARN] writing the endpoint cache %s got an error: %v", c.policy.Path, err)
+	}
+}
+
+func (c *EndpointCache) read() *endpointCacheFile {
+	cache := &endpointCacheFile{}
+	data, err := ioutil.ReadFile(c.policy.Path)
+	if err == nil {
+		if err = json.Unmarshal(data, cache); err != nil {
+			log.Printf("[WARN] the endpoint cache %s is broken and ignored: %v", c.policy.Path, err)
+		}
+	}
+	if cache.Endpoints == nil {
+		cache.Endpoints = make(map[string]endpointCacheEntry)
+	}
+	return cache
+}
+
+// write replaces the cache file with a temporary file, so the concurrent readers never see a partial file.
+func (c *EndpointCache) write(cache *endpointCacheFile) error {
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(c.policy.Path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(dir, filepath.Base(c.policy.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.policy.Path)
+}
+
+func endpointCacheKey(regionId, productCode string) string {
+	return fmt.Sprintf("%s/%s", regionId, productCode)
+}
+
+// describeEndpointWithCache describes the endpoint of the product from the Location service, unless it is cached and has
+// not expired. When the Location service fails, the expired endpoint is used instead.
+func (client *AliyunClient) describeEndpointWithCache(productCode string) (string, string, error) {
+	cached, fresh := client.endpointCache.Get(client.config.RegionId, productCode)
+	if fresh {
+		return cached, "endpoint cache " + client.endpointCache.policy.Path, nil
+	}
+	endpoint, err := client.describeEndpointForService(productCode)
+	if err == nil {
+		client.endpointCache.Put(client.config.RegionId, productCode, endpoint)
+		return endpoint, "Location service", nil
+	}
+	if cached != "" {
+		log.Printf("[WARN] describing %s endpoint got an error: %#v. Using the expired endpoint %s in the endpoint cache instead.", productCode, err, cached)
+		return cached, "expired endpoint cache " + client.endpointCache.policy.Path, nil
+	}
+	return "", "", err
+}
@@ -0,0 +1,70 @@
+package connectivity
+
+import (
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "endpoints.json")
+	cache := NewEndpointCache(EndpointCachePolicy{Path: path, TTL: time.Hour})
+	endpoint, fresh := cache.Get("cn-hangzhou", "ecs")
+	assert.Equal(t, "", endpoint)
+	assert.False(t, fresh)
+
+	cache.Put("cn-hangzhou", "ecs", "ecs.cn-hangzhou.aliyuncs.com")
+	other := NewEndpointCache(EndpointCachePolicy{Path: path, TTL: -time.Hour})
+	other.Put("cn-beijing", "ecs", "ecs.cn-beijing.aliyuncs.com")
+
+	endpoint, fresh = cache.Get("cn-hangzhou", "ecs")
+	assert.Equal(t, "ecs.cn-hangzhou.aliyuncs.com", endpoint)
+	assert.True(t, fresh)
+	endpoint, fresh = cache.Get("cn-beijing", "ecs")
+	assert.Equal(t, "ecs.cn-beijing.aliyuncs.com", endpoint)
+	assert.False(t, fresh)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("{"), 0644))
+	endpoint, _ = cache.Get("cn-hangzhou", "ecs")
+	assert.Equal(t, "", endpoint)
+
+	var disabled *EndpointCache
+	disabled.Put("cn-hangzhou", "ecs", "ecs.cn-hangzhou.aliyuncs.com")
+	endpoint, fresh = disabled.Get("cn-hangzhou", "ecs")
+	assert.Equal(t, "", endpoint)
+	assert.False(t, fresh)
+}
+
+func TestClientDescribeEndpointWithCache(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+	// The Location service is unavailable
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	client.config.LocationEndpoint = listener.Addr().String()
+	listener.Close()
+
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	client.endpointCache = NewEndpointCache(EndpointCachePolicy{Path: path, TTL: time.Hour})
+	client.endpointCache.Put("cn-hangzhou", "foo", "foo.cn-hangzhou.example.com")
+	assert.Nil(t, client.loadEndpoint("foo"))
+	endpoint, err := client.loadApiEndpoint("foo")
+	assert.Nil(t, err)
+	assert.Equal(t, "foo.cn-hangzhou.example.com", endpoint)
+	assert.Equal(t, "endpoint cache "+path, client.EndpointSource("foo"))
+
+	NewEndpointCache(EndpointCachePolicy{Path: path, TTL: -time.Hour}).Put("cn-hangzhou", "bar", "bar.cn-hangzhou.example.com")
+	assert.Nil(t, client.loadEndpoint("bar"))
+	endpoint, err = client.loadApiEndpoint("bar")
+	assert.Nil(t, err)
+	assert.Equal(t, "bar.cn-hangzhou.example.com", endpoint)
+	assert.Equal(t, "expired endpoint cache "+path, client.EndpointSource("bar"))
+
+	assert.NotNil(t, client.loadEndpoint("baz"))
+}
@@ -0,0 +1,270 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// EndpointFile is the JSON or YAML file set by the provider endpoints_file, which configures the endpoints of the products
+// by region, like the regions of the Apsara Stack. For example:
+//
+//	endpoints:
+//	  - regions: ["cn-qingdao-env17-d01"]
+//	    products:
+//	      ecs:
+//	        endpoint: ecs-internal.env17.example.com
+//	        protocol: HTTP
+//	  - regions: ["cn-*-env17-*"]
+//	    products:
+//	      oss:
+//	        endpoint: oss-{region}.env17.example.com
+//	        sign_version: v1
+//
+// The region patterns support the wildcards of path.Match, and {region} in an endpoint is replaced by the region.
+// When the patterns of several entries match a region, the product is loaded from the most specific one.
+type EndpointFile struct {
+	Path      string               `json:"-" yaml:"-"`
+	Endpoints []EndpointFileRegion `json:"endpoints" yaml:"endpoints"`
+}
+
+type EndpointFileRegion struct {
+	Regions  []string                       `json:"regions" yaml:"regions"`
+	Products map[string]EndpointFileProduct `json:"products" yaml:"products"`
+}
+
+type EndpointFileProduct struct {
+	Endpoint    string `json:"endpoint" yaml:"endpoint"`
+	Protocol    string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	SignVersion string `json:"sign_version,omitempty" yaml:"sign_version,omitempty"`
+}
+
+// ResolvedEndpoint is the endpoint of a product in a region, and Source describes where it is loaded from.
+type ResolvedEndpoint struct {
+	ProductCode string
+	Endpoint    string
+	Protocol    string
+	SignVersion string
+	Source      string
+}
+
+// supportedSignVersions records the products supporting the sign_version and their valid values.
+var supportedSignVersions = map[string][]string{
+	"oss": {"v1", "v2", "v4"},
+	"sls": {"v1", "v4"},
+}
+
+func signVersionProducts() []string {
+	products := make([]string, 0, len(supportedSignVersions))
+	for product := range supportedSignVersions {
+		products = append(products, product)
+	}
+	sort.Strings(products)
+	return products
+}
+
+// IsEndpointFile checks whether the file is a JSON or YAML endpoint file, instead of the legacy endpoints.xml.
+func IsEndpointFile(file string) bool {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// LoadEndpointFile reads and validates the endpoint file. All of the invalid settings are reported in one error.
+func LoadEndpointFile(file string) (*EndpointFile, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading the endpoint file %s got an error: %#v", file, err)
+	}
+	endpointFile := &EndpointFile{Path: file}
+	if strings.ToLower(filepath.Ext(file)) == ".json" {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(endpointFile)
+	} else {
+		err = yaml.UnmarshalStrict(data, endpointFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing the endpoint file %s got an error: %v", file, err)
+	}
+	if err := endpointFile.validate(); err != nil {
+		return nil, err
+	}
+	return endpointFile, nil
+}
+
+func (f *EndpointFile) validate() error {
+	var errs []string
+	defined := make(map[string]int)
+	for i, entry := range f.Endpoints {
+		if len(entry.Regions) == 0 {
+			errs = append(errs, fmt.Sprintf("endpoints[%d]: regions is required", i))
+		}
+		for _, pattern := range entry.Regions {
+			if _, err := path.Match(pattern, ""); err != nil || strings.TrimSpace(pattern) == "" {
+				errs = append(errs, fmt.Sprintf("endpoints[%d]: invalid region pattern %q", i, pattern))
+			}
+		}
+		productCodes := make([]string, 0, len(entry.Products))
+		for productCode := range entry.Products {
+			productCodes = append(productCodes, productCode)
+		}
+		sort.Strings(productCodes)
+		for _, productCode := range productCodes {
+			product := entry.Products[productCode]
+			code := strings.ToLower(ConvertKebabToSnake(productCode))
+			if strings.TrimSpace(product.Endpoint) == "" {
+				errs = append(errs, fmt.Sprintf("endpoints[%d].products.%s: endpoint is required", i, productCode))
+			}
+			if product.Protocol != "" && strings.ToUpper(product.Protocol) != "HTTP" && strings.ToUpper(product.Protocol) != "HTTPS" {
+				errs = append(errs, fmt.Sprintf("endpoints[%d].products.%s: invalid protocol %q, valid values: HTTP, HTTPS", i, productCode, product.Protocol))
+			}
+			if product.SignVersion != "" {
+				if versions, ok := supportedSignVersions[code]; !ok {
+					errs = append(errs, fmt.Sprintf("endpoints[%d].products.%s: sign_version is only supported by the products %s", i, productCode, strings.Join(signVersionProducts(), ", ")))
+				} else if !stringInSlice(product.SignVersion, versions) {
+					errs = append(errs, fmt.Sprintf("endpoints[%d].products.%s: invalid sign_version %q, valid values: %s", i, productCode, product.SignVersion, strings.Join(versions, ", ")))
+				}
+			}
+			for _, pattern := range entry.Regions {
+				key := pattern + "/" + code
+				if j, ok := defined[key]; ok && j != i {
+					errs = append(errs, fmt.Sprintf("endpoints[%d].products.%s: region %q is already configured by endpoints[%d]", i, productCode, pattern, j))
+				}
+				defined[key] = i
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("the endpoint file %s is invalid:\n\t%s", f.Path, strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+// Resolve returns the endpoints of the products in the region, sorted by the product code.
+func (f *EndpointFile) Resolve(regionId string) []ResolvedEndpoint {
+	type candidate struct {
+		ResolvedEndpoint
+		specificity int
+	}
+	candidates := make(map[string]candidate)
+	for i, entry := range f.Endpoints {
+		pattern, specificity := matchRegionPattern(entry.Regions, regionId)
+		if specificity < 0 {
+			continue
+		}
+		for productCode, product := range entry.Products {
+			code := strings.ToLower(ConvertKebabToSnake(productCode))
+			if c, ok := candidates[code]; ok && c.specificity >= specificity {
+				continue
+			}
+			candidates[code] = candidate{
+				ResolvedEndpoint: ResolvedEndpoint{
+					ProductCode: code,
+					Endpoint:    strings.ReplaceAll(strings.TrimSpace(product.Endpoint), "{region}", regionId),
+					Protocol:    strings.ToUpper(product.Protocol),
+					SignVersion: product.SignVersion,
+					Source:      fmt.Sprintf("endpoint file %s (endpoints[%d], region %q)", f.Path, i, pattern),
+				},
+				specificity: specificity,
+			}
+		}
+	}
+	resolved := make([]ResolvedEndpoint, 0, len(candidates))
+	for _, c := range candidates {
+		resolved = append(resolved, c.ResolvedEndpoint)
+	}
+	sort.Slice(resolved, func(i, j int) bool {
+		return resolved[i].ProductCode < resolved[j].ProductCode
+	})
+	return resolved
+}
+
+// matchRegionPattern returns the most specific pattern matching the region and its specificity, which is -1 if none matches.
+// An exact region is more specific than any wildcard, and a wildcard with more literal characters is more specific.
+func matchRegionPattern(patterns []string, regionId string) (string, int) {
+	matched, specificity := "", -1
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, regionId); err != nil || !ok {
+			continue
+		}
+		s := len(strings.NewReplacer("*", "", "?", "").Replace(pattern))
+		if !strings.ContainsAny(pattern, "*?[") {
+			s = 1 << 16
+		}
+		if s > specificity {
+			matched, specificity = pattern, s
+		}
+	}
+	return matched, specificity
+}
+
+func stringInSlice(s string, list []string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// loadEndpointFile loads the endpoints of the client region from the endpoint file. The endpoints set by the provider
+// endpoints block and the environment variables take precedence over the file.
+func (client *AliyunClient) loadEndpointFile(file string) error {
+	endpointFile, err := LoadEndpointFile(file)
+	if err != nil {
+		return err
+	}
+	for _, endpoint := range endpointFile.Resolve(client.config.RegionId) {
+		if v, ok := client.config.Endpoints.Load(endpoint.ProductCode); ok && v.(string) != "" {
+			log.Printf("[INFO] the %s endpoint %s in the %s is overridden by the provider endpoints block.", endpoint.ProductCode, endpoint.Endpoint, endpoint.Source)
+			continue
+		}
+		if name, value := EndpointFromEnv(endpoint.ProductCode); value != "" {
+			log.Printf("[INFO] the %s endpoint %s in the %s is overridden by the environment variable %s.", endpoint.ProductCode, endpoint.Endpoint, endpoint.Source, name)
+			continue
+		}
+		client.config.Endpoints.Store(endpoint.ProductCode, endpoint.Endpoint)
+		client.config.endpointSources.Store(endpoint.ProductCode, endpoint.Source)
+		if endpoint.Protocol != "" {
+			client.productProtocols.Store(endpoint.ProductCode, endpoint.Protocol)
+		}
+		if endpoint.SignVersion != "" && client.config.SignVersion != nil {
+			if _, ok := client.config.SignVersion.Load(endpoint.ProductCode); !ok {
+				client.config.SignVersion.Store(endpoint.ProductCode, endpoint.SignVersion)
+			}
+		}
+		log.Printf("[INFO] loaded the %s endpoint %s from the %s.", endpoint.ProductCode, endpoint.Endpoint, endpoint.Source)
+	}
+	return nil
+}
+
+// EndpointSource returns where the endpoint of the product is loaded from, like the environment variable or the Location service.
+func (client *AliyunClient) EndpointSource(productCode string) string {
+	productCode = strings.ToLower(ConvertKebabToSnake(productCode))
+	if v, ok := client.config.endpointSources.Load(productCode); ok {
+		return v.(string)
+	}
+	if v, ok := client.config.Endpoints.Load(productCode); ok && v.(string) != "" {
+		return "provider endpoints block"
+	}
+	return ""
+}
+
+// productProtocol returns the protocol of the product requests, which may be overridden by the endpoint file.
+func (client *AliyunClient) productProtocol(productCode string) string {
+	if v, ok := client.productProtocols.Load(productCode); ok {
+		return v.(string)
+	}
+	return client.config.Protocol
+}
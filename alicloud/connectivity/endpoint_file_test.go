@@ -0,0 +1,157 @@
+package connectivity
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+const testEndpointFileYaml = `
+endpoints:
+  - regions: ["cn-qingdao-env17-d01"]
+    products:
+      ecs:
+        endpoint: ecs-internal.env17.example.com
+        protocol: http
+  - regions: ["cn-*-env17-*"]
+    products:
+      ecs:
+        endpoint: ecs-{region}.env17.example.com
+      oss:
+        endpoint: oss-{region}.env17.example.com
+        sign_version: v1
+  - regions: ["*"]
+    products:
+      vpc:
+        endpoint: vpc.example.com
+      oss:
+        endpoint: oss.example.com
+`
+
+func writeEndpointFile(t *testing.T, name, content string) string {
+	file := filepath.Join(t.TempDir(), name)
+	assert.Nil(t, ioutil.WriteFile(file, []byte(content), 0644))
+	return file
+}
+
+func TestEndpointFileResolve(t *testing.T) {
+	file := writeEndpointFile(t, "endpoints.yaml", testEndpointFileYaml)
+	endpointFile, err := LoadEndpointFile(file)
+	assert.Nil(t, err)
+
+	resolved := endpointFile.Resolve("cn-qingdao-env17-d01")
+	assert.Len(t, resolved, 3)
+	assert.Equal(t, ResolvedEndpoint{ProductCode: "ecs", Endpoint: "ecs-internal.env17.example.com", Protocol: "HTTP", Source: "endpoint file " + file + ` (endpoints[0], region "cn-qingdao-env17-d01")`}, resolved[0])
+	assert.Equal(t, "oss-cn-qingdao-env17-d01.env17.example.com", resolved[1].Endpoint)
+	assert.Equal(t, "v1", resolved[1].SignVersion)
+	assert.Equal(t, "vpc.example.com", resolved[2].Endpoint)
+
+	resolved = endpointFile.Resolve("cn-beijing-env17-d01")
+	assert.Equal(t, "ecs-cn-beijing-env17-d01.env17.example.com", resolved[0].Endpoint)
+
+	resolved = endpointFile.Resolve("cn-hangzhou")
+	assert.Len(t, resolved, 2)
+	assert.Equal(t, "oss.example.com", resolved[0].Endpoint)
+}
+
+func TestEndpointFileJson(t *testing.T) {
+	file := writeEndpointFile(t, "endpoints.json", `{"endpoints": [{"regions": ["cn-hangzhou"], "products": {"fc-open": {"endpoint": "fc.example.com"}}}]}`)
+	endpointFile, err := LoadEndpointFile(file)
+	assert.Nil(t, err)
+	resolved := endpointFile.Resolve("cn-hangzhou")
+	assert.Len(t, resolved, 1)
+	assert.Equal(t, "fc_open", resolved[0].ProductCode)
+
+	file = writeEndpointFile(t, "unknown.json", `{"endpoints": [{"region": ["cn-hangzhou"]}]}`)
+	_, err = LoadEndpointFile(file)
+	assert.Contains(t, err.Error(), `unknown field "region"`)
+}
+
+func TestEndpointFileValidate(t *testing.T) {
+	file := writeEndpointFile(t, "endpoints.yml", `
+endpoints:
+  - products:
+      ecs:
+        protocol: ftp
+  - regions: ["cn-[hangzhou"]
+    products:
+      vpc:
+        endpoint: vpc.example.com
+        sign_version: v4
+  - regions: ["cn-hangzhou"]
+    products:
+      oss:
+        endpoint: oss.example.com
+        sign_version: v3
+      sls:
+        endpoint: sls.example.com
+        sign_version: v2
+  - regions: ["cn-hangzhou"]
+    products:
+      oss:
+        endpoint: oss-internal.example.com
+`)
+	_, err := LoadEndpointFile(file)
+	assert.NotNil(t, err)
+	for _, message := range []string{
+		"endpoints[0]: regions is required",
+		"endpoints[0].products.ecs: endpoint is required",
+		`endpoints[0].products.ecs: invalid protocol "ftp"`,
+		`endpoints[1]: invalid region pattern "cn-[hangzhou"`,
+		"endpoints[1].products.vpc: sign_version is only supported by the products oss, sls",
+		`endpoints[2].products.oss: invalid sign_version "v3"`,
+		`endpoints[2].products.sls: invalid sign_version "v2", valid values: v1, v4`,
+		`endpoints[3].products.oss: region "cn-hangzhou" is already configured by endpoints[2]`,
+	} {
+		assert.Contains(t, err.Error(), message)
+	}
+}
+
+func TestClientLoadEndpointFile(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.Register("Vpc", "DescribeVpcs", MockResponse{StatusCode: http.StatusOK, Body: map[string]interface{}{"TotalCount": 0}})
+	client, err := server.Client("cn-qingdao-env17-d01")
+	assert.Nil(t, err)
+	client.config.Endpoints.Store("oss", "oss-provider.example.com")
+	client.config.Endpoints.Delete("vpc")
+	t.Setenv("ALIBABA_CLOUD_ENDPOINT_VPC", "vpc.mock.aliyuncs.com")
+
+	file := writeEndpointFile(t, "endpoints.yaml", testEndpointFileYaml)
+	assert.Nil(t, client.loadEndpointFile(file))
+
+	endpoint, err := client.loadApiEndpoint("ecs")
+	assert.Nil(t, err)
+	assert.Equal(t, "ecs-internal.env17.example.com", endpoint)
+	assert.Contains(t, client.EndpointSource("ecs"), `(endpoints[0], region "cn-qingdao-env17-d01")`)
+	assert.Equal(t, "HTTP", client.productProtocol("ecs"))
+	assert.Equal(t, client.config.Protocol, client.productProtocol("vpc"))
+
+	endpoint, err = client.loadApiEndpoint("oss")
+	assert.Nil(t, err)
+	assert.Equal(t, "oss-provider.example.com", endpoint)
+	assert.Equal(t, "provider endpoints block", client.EndpointSource("oss"))
+
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DescribeVpcs", nil, nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "environment variable ALIBABA_CLOUD_ENDPOINT_VPC", client.EndpointSource("vpc"))
+}
+
+func TestClientSlsSignVersion(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+	client.config.SignVersion.Store("sls", "v4")
+
+	_, err = client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+		assert.Equal(t, sls.AuthV4, slsClient.AuthVersion)
+		assert.Equal(t, "cn-hangzhou", slsClient.Region)
+		return nil, nil
+	})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,191 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/alibabacloud-go/tea/tea"
+	credential "github.com/aliyun/credentials-go/credentials"
+)
+
+// MockEndpointSuffix is the domain suffix of the product endpoints which are served by the MockServer.
+const MockEndpointSuffix = "mock.aliyuncs.com"
+
+// MockResponse is a canned response returned by the MockServer.
+// A response with a StatusCode not less than 400 is returned as an OpenAPI error, and its Body should contain Code and Message.
+type MockResponse struct {
+	StatusCode int
+	Body       map[string]interface{}
+}
+
+// MockRequest records a request received by the MockServer.
+type MockRequest struct {
+	ApiProductCode string
+	ApiName        string
+	Method         string
+	Path           string
+	Query          url.Values
+	Headers        http.Header
+	Body           string
+}
+
+// MockServer is an in-process stand-in for the RPC and ROA OpenAPI gateways.
+// All of the product endpoints of the client created by it are routed to the server by an HTTP proxy,
+// and the server returns the responses registered by apiProductCode and apiName in order.
+// The apiName of the ROA request without action is its request path, like "/clusters".
+type MockServer struct {
+	server    *httptest.Server
+	endpoints *sync.Map
+	mutex     sync.Mutex
+	responses map[string][]MockResponse
+	requests  []MockRequest
+}
+
+// NewMockServer starts a MockServer. The caller should call Close when finished.
+func NewMockServer() *MockServer {
+	s := &MockServer{
+		endpoints: new(sync.Map),
+		responses: make(map[string][]MockResponse),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Close shuts down the server.
+func (s *MockServer) Close() {
+	s.server.Close()
+}
+
+// URL returns the address of the server.
+func (s *MockServer) URL() string {
+	return s.server.URL
+}
+
+// Register appends the responses of the API. The last one is returned repeatedly once the others are consumed.
+func (s *MockServer) Register(apiProductCode, apiName string, responses ...MockResponse) {
+	apiProductCode = strings.ToLower(ConvertKebabToSnake(apiProductCode))
+	s.endpoints.Store(apiProductCode, fmt.Sprintf("%s.%s", apiProductCode, MockEndpointSuffix))
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	key := mockApiKey(apiProductCode, apiName)
+	s.responses[key] = append(s.responses[key], responses...)
+}
+
+// Requests returns the requests received by the server in order.
+func (s *MockServer) Requests() []MockRequest {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]MockRequest{}, s.requests...)
+}
+
+// Client returns an AliyunClient whose product endpoints registered in the server are pointed at it.
+func (s *MockServer) Client(regionId string) (*AliyunClient, error) {
+	return s.ClientWithConfig(regionId, nil)
+}
+
+// ClientWithConfig is like Client, and the configure changes the Config of the client before it is created, like
+// enabling the provider options.
+func (s *MockServer) ClientWithConfig(regionId string, configure func(config *Config)) (*AliyunClient, error) {
+	config := &Config{
+		AccessKey:            "MockAccessKeyId",
+		SecretKey:            "MockAccessKeySecret",
+		Region:               Region(regionId),
+		RegionId:             regionId,
+		AccountId:            "123456789",
+		AccountType:          "Domestic",
+		Protocol:             "HTTP",
+		ClientReadTimeout:    30000,
+		ClientConnectTimeout: 30000,
+		SkipRegionValidation: true,
+		ConfigurationSource:  "mock",
+		Endpoints:            s.endpoints,
+		SignVersion:          new(sync.Map),
+	}
+	if configure != nil {
+		configure(config)
+	}
+	credential, err := credential.NewCredential(config.getCredentialConfig(true))
+	if err != nil {
+		return nil, err
+	}
+	config.Credential = credential
+	client, err := config.Client()
+	if err != nil {
+		return nil, err
+	}
+	proxy := tea.String(s.server.URL)
+	client.teaSdkConfig.HttpProxy = proxy
+	client.teaRoaSdkConfig.HttpProxy = proxy
+	client.teaRpcOpenapiConfig.HttpProxy = proxy
+	client.teaRoaOpenapiConfig.HttpProxy = proxy
+	return client, nil
+}
+
+func (s *MockServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	request := MockRequest{
+		ApiProductCode: strings.TrimSuffix(strings.Split(r.Host, ":")[0], "."+MockEndpointSuffix),
+		Method:         r.Method,
+		Path:           r.URL.Path,
+		Query:          r.URL.Query(),
+		Headers:        r.Header,
+		Body:           string(body),
+	}
+	request.ApiName = r.URL.Query().Get("Action")
+	if request.ApiName == "" {
+		request.ApiName = r.Header.Get("x-acs-action")
+	}
+	if request.ApiName == "" {
+		request.ApiName = r.URL.Path
+	}
+
+	s.mutex.Lock()
+	s.requests = append(s.requests, request)
+	key := mockApiKey(request.ApiProductCode, request.ApiName)
+	responses := s.responses[key]
+	var response MockResponse
+	if len(responses) == 0 {
+		response = MockResponse{
+			StatusCode: http.StatusNotFound,
+			Body: map[string]interface{}{
+				"Code":    "MockResponseNotFound",
+				"Message": fmt.Sprintf("There is no mock response registered for %s %s.", request.ApiProductCode, request.ApiName),
+			},
+		}
+	} else {
+		response = responses[0]
+		if len(responses) > 1 {
+			s.responses[key] = responses[1:]
+		}
+	}
+	s.mutex.Unlock()
+
+	if response.StatusCode == 0 {
+		response.StatusCode = http.StatusOK
+	}
+	responseBody := map[string]interface{}{
+		"RequestId": "MockRequestId",
+	}
+	for key, value := range response.Body {
+		responseBody[key] = value
+	}
+	data, err := json.Marshal(responseBody)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("x-acs-request-id", "MockRequestId")
+	w.WriteHeader(response.StatusCode)
+	w.Write(data)
+}
+
+func mockApiKey(apiProductCode, apiName string) string {
+	return fmt.Sprintf("%s:%s", apiProductCode, apiName)
+}
@@ -0,0 +1,73 @@
+package connectivity
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockServerRpc(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.Register("Vpc", "DescribeVpcAttribute",
+		MockResponse{
+			StatusCode: http.StatusBadRequest,
+			Body:       map[string]interface{}{"Code": "Throttling", "Message": "Request was denied due to request throttling."},
+		},
+		MockResponse{
+			Body: map[string]interface{}{"VpcId": "vpc-mock", "Status": "Available"},
+		},
+	)
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+
+	request := map[string]interface{}{
+		"VpcId": "vpc-mock",
+	}
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DescribeVpcAttribute", request, nil, false)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Throttling", tea.StringValue(err.(*tea.SDKError).Code))
+
+	response, err := client.RpcPost("Vpc", "2016-04-28", "DescribeVpcAttribute", request, nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "Available", response["Status"])
+
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DeleteVpc", request, nil, false)
+	assert.NotNil(t, err)
+	assert.Equal(t, "MockResponseNotFound", tea.StringValue(err.(*tea.SDKError).Code))
+
+	requests := server.Requests()
+	assert.Len(t, requests, 3)
+	assert.Equal(t, "vpc", requests[0].ApiProductCode)
+	assert.Equal(t, "DescribeVpcAttribute", requests[0].ApiName)
+	assert.Equal(t, "vpc-mock", requests[0].Query.Get("VpcId"))
+	assert.Equal(t, "MockAccessKeyId", requests[0].Query.Get("AccessKeyId"))
+}
+
+func TestMockServerRoa(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.Register("CS", "/clusters/c-mock", MockResponse{
+		Body: map[string]interface{}{"cluster_id": "c-mock", "state": "running"},
+	})
+	server.Register("CS", "DeleteCluster", MockResponse{
+		Body: map[string]interface{}{"task_id": "T-mock"},
+	})
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+
+	response, err := client.RoaGet("CS", "2015-12-15", "/clusters/c-mock", nil, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "running", response["state"])
+
+	response, err = client.RoaDeleteWithApiName("CS", "2015-12-15", "DeleteCluster", "/clusters/c-mock", nil, nil, nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "T-mock", response["task_id"])
+
+	requests := server.Requests()
+	assert.Len(t, requests, 2)
+	assert.Equal(t, http.MethodDelete, requests[1].Method)
+	assert.Equal(t, "/clusters/c-mock", requests[1].Path)
+}
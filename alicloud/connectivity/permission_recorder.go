@@ -0,0 +1,395 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ramServiceCodes are the RAM service codes of the products whose code is not the lowercase product code.
+var ramServiceCodes = map[string]string{
+	"r-kvstore": "kvstore",
+	"sls":       "log",
+	"ims":       "ram",
+}
+
+// PermissionRecorder records the RAM actions of the APIs invoked by the provider, and writes them into a RAM policy
+// document file, which can be used as the policy_document of the alicloud_ram_policy. The actions in the file are
+// read again before writing, so that the actions invoked by the terraform plan and apply are merged.
+type PermissionRecorder struct {
+	path    string
+	mutex   sync.Mutex
+	actions map[string]struct{}
+	unknown map[string]struct{}
+}
+
+type permissionPolicyDocument struct {
+	Statement []permissionPolicyStatement
+	Version   string
+}
+
+type permissionPolicyStatement struct {
+	Effect   string
+	Action   interface{}
+	Resource interface{}
+}
+
+// NewPermissionRecorder returns a PermissionRecorder writing to the file.
+func NewPermissionRecorder(path string) *PermissionRecorder {
+	return &PermissionRecorder{path: path, actions: make(map[string]struct{}), unknown: make(map[string]struct{})}
+}
+
+// RamAction returns the RAM action of the API, like ecs:RunInstances.
+func RamAction(apiProductCode, apiName string) string {
+	service := strings.ToLower(strings.Replace(apiProductCode, "_", "-", -1))
+	if code, ok := ramServiceCodes[service]; ok {
+		service = code
+	}
+	return fmt.Sprintf("%s:%s", service, apiName)
+}
+
+// Record records the API, and the file is written when it is recorded for the first time.
+func (r *PermissionRecorder) Record(apiProductCode, apiName string) {
+	if r == nil || apiProductCode == "" || apiName == "" {
+		return
+	}
+	action := RamAction(apiProductCode, apiName)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.actions[action]; ok {
+		return
+	}
+	r.actions[action] = struct{}{}
+	actions := r.read()
+	for recorded := range r.actions {
+		actions[recorded] = struct{}{}
+	}
+	if err := r.write(actions); err != nil {
+		log.Printf("[WARN] writing the permission report %s got an error: %v", r.path, err)
+	}
+}
+
+// RecordUnknown records the request whose API name is unknown, like the ROA request sent without the API name. Its RAM
+// action can not be written into the policy document, so the request is written into the file UnknownPath, in order
+// to let the missing permissions be granted by hand.
+func (r *PermissionRecorder) RecordUnknown(apiProductCode, request string) {
+	if r == nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	key := apiProductCode + " " + request
+	if _, ok := r.unknown[key]; ok {
+		return
+	}
+	r.unknown[key] = struct{}{}
+	log.Printf("[WARN] the RAM action of the %s request %s is unknown, and it is written into %s", apiProductCode, request, r.UnknownPath())
+	requests := make(map[string]struct{})
+	if data, err := ioutil.ReadFile(r.UnknownPath()); err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				requests[line] = struct{}{}
+			}
+		}
+	}
+	for recorded := range r.unknown {
+		requests[recorded] = struct{}{}
+	}
+	sorted := make([]string, 0, len(requests))
+	for request := range requests {
+		sorted = append(sorted, request)
+	}
+	sort.Strings(sorted)
+	if err := writeFile(r.UnknownPath(), []byte(strings.Join(sorted, "\n")+"\n")); err != nil {
+		log.Printf("[WARN] writing the unknown requests %s got an error: %v", r.UnknownPath(), err)
+	}
+}
+
+// UnknownPath returns the path of the file listing the requests whose RAM actions are unknown.
+func (r *PermissionRecorder) UnknownPath() string {
+	return r.path + ".unknown"
+}
+
+// read returns the actions in the file, and the Action of a statement can be a string or a list.
+func (r *PermissionRecorder) read() map[string]struct{} {
+	actions := make(map[string]struct{})
+	data, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		return actions
+	}
+	document := &permissionPolicyDocument{}
+	if err := json.Unmarshal(data, document); err != nil {
+		log.Printf("[WARN] the permission report %s is broken and overwritten: %v", r.path, err)
+		return actions
+	}
+	for _, statement := range document.Statement {
+		switch v := statement.Action.(type) {
+		case string:
+			actions[v] = struct{}{}
+		case []interface{}:
+			for _, action := range v {
+				actions[fmt.Sprint(action)] = struct{}{}
+			}
+		}
+	}
+	return actions
+}
+
+// write writes the actions into the file as a RAM policy document.
+func (r *PermissionRecorder) write(actions map[string]struct{}) error {
+	sorted := make([]string, 0, len(actions))
+	for action := range actions {
+		sorted = append(sorted, action)
+	}
+	sort.Strings(sorted)
+	data, err := json.MarshalIndent(permissionPolicyDocument{
+		Statement: []permissionPolicyStatement{{Effect: "Allow", Action: sorted, Resource: "*"}},
+		Version:   "1",
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(r.path, data)
+}
+
+// writeFile replaces the file with a temporary file, so the concurrent readers never see a partial file.
+func writeFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// permissionTransport records the permissions of the HTTP requests sent by the SDK clients built with getSdkConfig,
+// and the OSS and SLS clients.
+type permissionTransport struct {
+	client *AliyunClient
+	base   http.RoundTripper
+	// apiName returns the product code and the API name of the request. The requests are recorded by their Action
+	// if it is nil.
+	apiName func(req *http.Request) (string, string)
+}
+
+func (t *permissionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var apiProductCode, apiName string
+	if t.apiName != nil {
+		apiProductCode, apiName = t.apiName(req)
+	} else {
+		apiProductCode = t.client.productCodeByHost(req.URL.Hostname())
+		apiName = req.URL.Query().Get("Action")
+		if apiName == "" {
+			apiName = req.Header.Get("x-acs-action")
+		}
+	}
+	if apiName != "" {
+		t.client.permissionRecorder.Record(apiProductCode, apiName)
+	} else {
+		t.client.permissionRecorder.RecordUnknown(apiProductCode, req.Method+" "+req.URL.Path)
+	}
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// ossSubResources are the sub-resources of the OSS requests which name the APIs, like GetBucketAcl and PutObjectTagging.
+var ossSubResources = map[string]string{
+	"acl":                  "Acl",
+	"accessMonitor":        "AccessMonitor",
+	"bucketInfo":           "Info",
+	"cors":                 "Cors",
+	"encryption":           "Encryption",
+	"httpsConfig":          "HttpsConfig",
+	"inventory":            "Inventory",
+	"lifecycle":            "Lifecycle",
+	"location":             "Location",
+	"logging":              "Logging",
+	"objectWorm":           "ObjectWormConfiguration",
+	"policy":               "Policy",
+	"policyStatus":         "PolicyStatus",
+	"publicAccessBlock":    "PublicAccessBlock",
+	"referer":              "Referer",
+	"replication":          "Replication",
+	"requestPayment":       "RequestPayment",
+	"resourceGroup":        "ResourceGroup",
+	"stat":                 "Stat",
+	"tagging":              "Tagging",
+	"transferAcceleration": "TransferAcceleration",
+	"versioning":           "Versioning",
+	"website":              "Website",
+	"worm":                 "Worm",
+}
+
+// ossApiNames are the OSS APIs whose RAM actions are not named by the sub-resources, in the order of matching.
+var ossApiNames = []struct {
+	request string
+	apiName string
+}{
+	{"POST uploads", "PutObject"},
+	{"GET uploads", "ListMultipartUploads"},
+	{"PUT uploadId", "PutObject"},
+	{"POST uploadId", "PutObject"},
+	{"DELETE uploadId", "AbortMultipartUpload"},
+	{"GET uploadId", "ListParts"},
+	{"POST append", "PutObject"},
+	{"PUT symlink", "PutObject"},
+	{"GET symlink", "GetObject"},
+	{"GET objectMeta", "GetObject"},
+	{"HEAD objectMeta", "GetObject"},
+	{"POST restore", "RestoreObject"},
+	{"POST delete", "DeleteObject"},
+	{"GET versions", "ListObjectVersions"},
+	{"POST wormExtend", "ExtendBucketWorm"},
+	{"POST wormId", "CompleteBucketWorm"},
+	{"POST worm", "InitiateBucketWorm"},
+	{"DELETE worm", "AbortBucketWorm"},
+}
+
+// ossApiName returns the API name of the OSS request, and the serviceHost is the host of the OSS endpoint, which the
+// requests without the bucket are sent to.
+func ossApiName(req *http.Request, serviceHost string) string {
+	query := req.URL.Query()
+	for _, v := range ossApiNames {
+		parts := strings.SplitN(v.request, " ", 2)
+		if _, ok := query[parts[1]]; ok && parts[0] == req.Method {
+			return v.apiName
+		}
+	}
+	verbs := map[string]string{"GET": "Get", "HEAD": "Get", "PUT": "Put", "DELETE": "Delete"}
+	verb, ok := verbs[req.Method]
+	target := "Bucket"
+	if strings.TrimPrefix(req.URL.Path, "/") != "" {
+		target = "Object"
+	}
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if name, found := ossSubResources[key]; found {
+			if !ok {
+				return ""
+			}
+			return verb + target + name
+		}
+	}
+	switch {
+	case req.URL.Hostname() == serviceHost:
+		if req.Method == "GET" {
+			return "ListBuckets"
+		}
+	case target == "Object":
+		switch req.Method {
+		case "GET", "HEAD":
+			return "GetObject"
+		case "PUT", "POST":
+			return "PutObject"
+		case "DELETE":
+			return "DeleteObject"
+		}
+	default:
+		switch req.Method {
+		case "GET":
+			return "ListObjects"
+		case "HEAD":
+			return "GetBucketInfo"
+		case "PUT":
+			return "PutBucket"
+		case "DELETE":
+			return "DeleteBucket"
+		}
+	}
+	return ""
+}
+
+// slsResources are the resources of the SLS requests, and their names and the APIs listing them.
+var slsResources = map[string]struct {
+	name    string
+	listApi string
+}{
+	"logstores":     {"LogStore", "ListLogStores"},
+	"machinegroups": {"MachineGroup", "ListMachineGroup"},
+	"configs":       {"Config", "ListConfig"},
+	"dashboards":    {"Dashboard", "ListDashboard"},
+	"savedsearches": {"SavedSearch", "ListSavedSearch"},
+	"jobs":          {"Job", "ListJobs"},
+	"shards":        {"Shard", "ListShards"},
+	"domains":       {"Domain", "ListDomains"},
+	"index":         {"Index", ""},
+}
+
+// slsApiName returns the API name of the SLS request, and the serviceHost is the host of the SLS endpoint, which the
+// requests without the project are sent to.
+func slsApiName(req *http.Request, serviceHost string) string {
+	path := strings.Trim(req.URL.Path, "/")
+	switch path {
+	case "":
+		if req.URL.Hostname() == serviceHost {
+			if req.Method == "GET" {
+				return "ListProject"
+			}
+			return ""
+		}
+		return map[string]string{"GET": "GetProject", "POST": "CreateProject", "PUT": "UpdateProject", "DELETE": "DeleteProject"}[req.Method]
+	case "tag":
+		return "TagResources"
+	case "untag":
+		return "UntagResources"
+	case "tags":
+		return "ListTagResources"
+	}
+	segments := strings.Split(path, "/")
+	if len(segments) >= 3 && segments[0] == "machinegroups" && segments[2] == "configs" {
+		switch {
+		case len(segments) == 3 && req.Method == "GET":
+			return "GetAppliedConfigs"
+		case len(segments) == 4 && req.Method == "PUT":
+			return "ApplyConfigToMachineGroup"
+		case len(segments) == 4 && req.Method == "DELETE":
+			return "RemoveConfigFromMachineGroup"
+		}
+		return ""
+	}
+	collection := segments[len(segments)-1]
+	named := len(segments)%2 == 0
+	if named {
+		collection = segments[len(segments)-2]
+	}
+	resource, ok := slsResources[collection]
+	if !ok {
+		return ""
+	}
+	switch {
+	case !named && resource.listApi != "" && req.Method == "GET":
+		return resource.listApi
+	case !named && req.Method == "POST":
+		return "Create" + resource.name
+	case named || resource.listApi == "":
+		if verb, ok := map[string]string{"GET": "Get", "PUT": "Update", "DELETE": "Delete"}[req.Method]; ok {
+			return verb + resource.name
+		}
+	}
+	return ""
+}
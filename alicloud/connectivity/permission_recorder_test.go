@@ -0,0 +1,141 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readPermissionReport(t *testing.T, path string) []interface{} {
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	document := &permissionPolicyDocument{}
+	assert.Nil(t, json.Unmarshal(data, document))
+	assert.Equal(t, "1", document.Version)
+	assert.Len(t, document.Statement, 1)
+	assert.Equal(t, "Allow", document.Statement[0].Effect)
+	assert.Equal(t, "*", document.Statement[0].Resource)
+	actions, _ := document.Statement[0].Action.([]interface{})
+	return actions
+}
+
+func TestRamAction(t *testing.T) {
+	assert.Equal(t, "ecs:RunInstances", RamAction("Ecs", "RunInstances"))
+	assert.Equal(t, "kvstore:CreateInstance", RamAction("R-kvstore", "CreateInstance"))
+	assert.Equal(t, "log:CreateProject", RamAction("Sls", "CreateProject"))
+	assert.Equal(t, "ram:CreateSAMLProvider", RamAction("Ims", "CreateSAMLProvider"))
+}
+
+func TestPermissionRecorderMerge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report", "policy.json")
+
+	plan := NewPermissionRecorder(path)
+	plan.Record("Vpc", "DescribeVpcAttribute")
+	plan.Record("Ecs", "DescribeSecurityGroupAttribute")
+	plan.Record("Vpc", "DescribeVpcAttribute")
+	plan.Record("Ecs", "")
+	assert.Equal(t, []interface{}{"ecs:DescribeSecurityGroupAttribute", "vpc:DescribeVpcAttribute"}, readPermissionReport(t, path))
+
+	apply := NewPermissionRecorder(path)
+	apply.Record("Ecs", "CreateSecurityGroup")
+	assert.Equal(t, []interface{}{"ecs:CreateSecurityGroup", "ecs:DescribeSecurityGroupAttribute", "vpc:DescribeVpcAttribute"}, readPermissionReport(t, path))
+
+	var recorder *PermissionRecorder
+	recorder.Record("Ecs", "RunInstances")
+	recorder.RecordUnknown("CS", "GET /clusters")
+}
+
+func TestPermissionRecorderUnknown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+
+	plan := NewPermissionRecorder(path)
+	plan.RecordUnknown("FC", "GET /2023-03-30/functions/foo")
+	plan.RecordUnknown("CS", "GET /clusters")
+	plan.RecordUnknown("CS", "GET /clusters")
+	apply := NewPermissionRecorder(path)
+	apply.RecordUnknown("CS", "POST /clusters")
+
+	data, err := ioutil.ReadFile(plan.UnknownPath())
+	assert.Nil(t, err)
+	assert.Equal(t, "CS GET /clusters\nCS POST /clusters\nFC GET /2023-03-30/functions/foo\n", string(data))
+}
+
+func TestOssApiName(t *testing.T) {
+	for request, apiName := range map[string]string{
+		"GET http://oss-cn-hangzhou.aliyuncs.com/":                           "ListBuckets",
+		"PUT http://bucket.oss-cn-hangzhou.aliyuncs.com/":                    "PutBucket",
+		"GET http://bucket.oss-cn-hangzhou.aliyuncs.com/?bucketInfo":         "GetBucketInfo",
+		"PUT http://bucket.oss-cn-hangzhou.aliyuncs.com/?lifecycle":          "PutBucketLifecycle",
+		"DELETE http://bucket.oss-cn-hangzhou.aliyuncs.com/?tagging":         "DeleteBucketTagging",
+		"GET http://bucket.oss-cn-hangzhou.aliyuncs.com/?prefix=a":           "ListObjects",
+		"HEAD http://bucket.oss-cn-hangzhou.aliyuncs.com/a.txt":              "GetObject",
+		"PUT http://bucket.oss-cn-hangzhou.aliyuncs.com/a.txt?tagging":       "PutObjectTagging",
+		"POST http://bucket.oss-cn-hangzhou.aliyuncs.com/a.txt?uploads":      "PutObject",
+		"PUT http://bucket.oss-cn-hangzhou.aliyuncs.com/a.txt?uploadId=1":    "PutObject",
+		"POST http://bucket.oss-cn-hangzhou.aliyuncs.com/?wormExtend&wormId": "ExtendBucketWorm",
+		"POST http://bucket.oss-cn-hangzhou.aliyuncs.com/?lifecycle":         "",
+	} {
+		parts := strings.SplitN(request, " ", 2)
+		req, err := http.NewRequest(parts[0], parts[1], nil)
+		assert.Nil(t, err)
+		assert.Equal(t, apiName, ossApiName(req, "oss-cn-hangzhou.aliyuncs.com"), request)
+	}
+}
+
+func TestSlsApiName(t *testing.T) {
+	for request, apiName := range map[string]string{
+		"GET https://cn-hangzhou.log.aliyuncs.com/":                                           "ListProject",
+		"POST https://project.cn-hangzhou.log.aliyuncs.com/":                                  "CreateProject",
+		"GET https://project.cn-hangzhou.log.aliyuncs.com/logstores":                          "ListLogStores",
+		"POST https://project.cn-hangzhou.log.aliyuncs.com/logstores":                         "CreateLogStore",
+		"PUT https://project.cn-hangzhou.log.aliyuncs.com/logstores/store":                    "UpdateLogStore",
+		"GET https://project.cn-hangzhou.log.aliyuncs.com/logstores/store/index":              "GetIndex",
+		"POST https://project.cn-hangzhou.log.aliyuncs.com/logstores/store/index":             "CreateIndex",
+		"PUT https://project.cn-hangzhou.log.aliyuncs.com/machinegroups/group/configs/config": "ApplyConfigToMachineGroup",
+		"POST https://project.cn-hangzhou.log.aliyuncs.com/tag":                               "TagResources",
+		"GET https://project.cn-hangzhou.log.aliyuncs.com/unknown/resource":                   "",
+	} {
+		parts := strings.SplitN(request, " ", 2)
+		req, err := http.NewRequest(parts[0], parts[1], nil)
+		assert.Nil(t, err)
+		assert.Equal(t, apiName, slsApiName(req, "cn-hangzhou.log.aliyuncs.com"), request)
+	}
+}
+
+func TestPermissionRecorderBrokenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("{broken"), 0644))
+
+	recorder := NewPermissionRecorder(path)
+	recorder.Record("Ecs", "RunInstances")
+	assert.Equal(t, []interface{}{"ecs:RunInstances"}, readPermissionReport(t, path))
+}
+
+func TestPermissionRecorderMockServer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	server := NewMockServer()
+	defer server.Close()
+	server.Register("Ecs", "CreateSecurityGroup", MockResponse{
+		Body: map[string]interface{}{"SecurityGroupId": "sg-mock"},
+	})
+	server.Register("Ecs", "DeleteSecurityGroup", MockResponse{
+		StatusCode: http.StatusForbidden,
+		Body:       map[string]interface{}{"Code": "Forbidden.RAM", "Message": "User not authorized to operate on the specified resource."},
+	})
+	client, err := server.ClientWithConfig("cn-hangzhou", func(config *Config) {
+		config.PermissionReportFile = path
+	})
+	assert.Nil(t, err)
+
+	_, err = client.RpcPost("Ecs", "2014-05-26", "CreateSecurityGroup", nil, map[string]interface{}{"VpcId": "vpc-mock"}, false)
+	assert.Nil(t, err)
+	_, err = client.RpcPost("Ecs", "2014-05-26", "DeleteSecurityGroup", nil, map[string]interface{}{"SecurityGroupId": "sg-mock"}, false)
+	assert.NotNil(t, err)
+
+	assert.Equal(t, []interface{}{"ecs:CreateSecurityGroup", "ecs:DeleteSecurityGroup"}, readPermissionReport(t, path))
+}
@@ -0,0 +1,285 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/credentials-go/credentials/providers"
+)
+
+// ProfileModesWithCredentialsProvider are the Alibaba Cloud CLI profile modes whose credentials are loaded by
+// NewProfileCredentialsProvider, instead of being read from the profile fields directly.
+var ProfileModesWithCredentialsProvider = map[string]bool{
+	"ChainableRamRoleArn": true,
+	"OIDC":                true,
+	"CloudSSO":            true,
+	"External":            true,
+	"CredentialsURI":      true,
+}
+
+// credentialsRefreshAhead is how long before the expiration the temporary credentials are refreshed.
+const credentialsRefreshAhead = 3 * time.Minute
+
+// externalProcessTimeout limits the running time of the commands printing the credentials, like the process_command of an External profile.
+const externalProcessTimeout = time.Minute
+
+type cliProfile struct {
+	Name                      string `json:"name"`
+	Mode                      string `json:"mode"`
+	CredentialsURI            string `json:"credentials_uri"`
+	ProcessCommand            string `json:"process_command"`
+	CloudSSOSignInUrl         string `json:"cloud_sso_sign_in_url"`
+	CloudSSOAccountId         string `json:"cloud_sso_account_id"`
+	CloudSSOAccessConfig      string `json:"cloud_sso_access_config"`
+	AccessToken               string `json:"access_token"`
+	CloudSSOAccessTokenExpire int64  `json:"cloud_sso_access_token_expire"`
+}
+
+type cliConfiguration struct {
+	Current  string        `json:"current"`
+	Profiles []*cliProfile `json:"profiles"`
+}
+
+// DefaultProfileFile returns the config.json written by the Alibaba Cloud CLI.
+func DefaultProfileFile() string {
+	home := os.Getenv("HOME")
+	if runtime.GOOS == "windows" {
+		home = os.Getenv("USERPROFILE")
+	}
+	return filepath.Join(home, ".aliyun", "config.json")
+}
+
+// NewProfileCredentialsProvider returns the credentials provider of the CLI profile, whose mode is one of the
+// ProfileModesWithCredentialsProvider.
+func NewProfileCredentialsProvider(profileFile, profileName string) (providers.CredentialsProvider, error) {
+	if profileFile == "" {
+		profileFile = DefaultProfileFile()
+	}
+	data, err := ioutil.ReadFile(profileFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading the profile file %s got an error: %#v", profileFile, err)
+	}
+	configuration := &cliConfiguration{}
+	if err := json.Unmarshal(data, configuration); err != nil {
+		return nil, fmt.Errorf("parsing the profile file %s got an error: %v", profileFile, err)
+	}
+	if profileName == "" {
+		profileName = configuration.Current
+	}
+	var profile *cliProfile
+	for _, p := range configuration.Profiles {
+		if p.Name == profileName {
+			profile = p
+			break
+		}
+	}
+	if profile == nil {
+		return nil, fmt.Errorf("the profile %s is not found in the profile file %s", profileName, profileFile)
+	}
+
+	switch profile.Mode {
+	case "ChainableRamRoleArn", "OIDC":
+		return providers.NewCLIProfileCredentialsProviderBuilder().WithProfileName(profileName).WithProfileFile(profileFile).Build()
+	case "CredentialsURI":
+		if profile.CredentialsURI == "" {
+			return nil, fmt.Errorf("the credentials_uri of the %s profile %s is empty", profile.Mode, profileName)
+		}
+		return providers.NewURLCredentialsProviderBuilder().WithUrl(profile.CredentialsURI).Build()
+	case "External":
+		if strings.TrimSpace(profile.ProcessCommand) == "" {
+			return nil, fmt.Errorf("the process_command of the %s profile %s is empty", profile.Mode, profileName)
+		}
+		return &externalCredentialsProvider{command: strings.Fields(profile.ProcessCommand)}, nil
+	case "CloudSSO":
+		signInUrl, err := url.Parse(profile.CloudSSOSignInUrl)
+		if err != nil || signInUrl.Host == "" {
+			return nil, fmt.Errorf("the cloud_sso_sign_in_url %q of the %s profile %s is invalid", profile.CloudSSOSignInUrl, profile.Mode, profileName)
+		}
+		if profile.AccessToken == "" || profile.CloudSSOAccountId == "" || profile.CloudSSOAccessConfig == "" {
+			return nil, fmt.Errorf("the %s profile %s is not logged in, please run `aliyun configure --profile %s --mode CloudSSO` first", profile.Mode, profileName, profileName)
+		}
+		return &cloudSSOCredentialsProvider{
+			profileName:       profileName,
+			signInUrl:         signInUrl,
+			accountId:         profile.CloudSSOAccountId,
+			accessConfig:      profile.CloudSSOAccessConfig,
+			accessToken:       profile.AccessToken,
+			accessTokenExpire: profile.CloudSSOAccessTokenExpire,
+			httpClient:        &http.Client{Timeout: 30 * time.Second},
+		}, nil
+	}
+	return nil, fmt.Errorf("the mode %s of the profile %s is not supported by the credentials provider", profile.Mode, profileName)
+}
+
+// sessionCredentials caches the temporary credentials until they are about to expire. A zero expiration never expires.
+type sessionCredentials struct {
+	mutex       sync.Mutex
+	credentials *providers.Credentials
+	expiration  time.Time
+}
+
+func (s *sessionCredentials) get(providerName string, refresh func() (*providers.Credentials, time.Time, error)) (*providers.Credentials, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.credentials == nil || (!s.expiration.IsZero() && time.Now().Add(credentialsRefreshAhead).After(s.expiration)) {
+		credentials, expiration, err := refresh()
+		if err != nil {
+			return nil, err
+		}
+		s.credentials, s.expiration = credentials, expiration
+	}
+	return &providers.Credentials{
+		AccessKeyId:     s.credentials.AccessKeyId,
+		AccessKeySecret: s.credentials.AccessKeySecret,
+		SecurityToken:   s.credentials.SecurityToken,
+		ProviderName:    providerName,
+	}, nil
+}
+
+// externalCredentialsProvider runs the process_command of an External profile, which prints the credentials like
+// {"mode": "StsToken", "access_key_id": "...", "access_key_secret": "...", "sts_token": "..."}.
+// The optional expiration in RFC 3339 makes the command run again before the credentials expire.
+type externalCredentialsProvider struct {
+	command []string
+	session sessionCredentials
+}
+
+type externalCredentials struct {
+	Mode            string `json:"mode"`
+	AccessKeyId     string `json:"access_key_id"`
+	AccessKeySecret string `json:"access_key_secret"`
+	StsToken        string `json:"sts_token"`
+	Expiration      string `json:"expiration"`
+}
+
+func (p *externalCredentialsProvider) GetCredentials() (*providers.Credentials, error) {
+	return p.session.get(p.GetProviderName(), p.run)
+}
+
+func (p *externalCredentialsProvider) GetProviderName() string {
+	return "external"
+}
+
+func (p *externalCredentialsProvider) run() (*providers.Credentials, time.Time, error) {
+	data, err := runCredentialCommand("process_command", p.command)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var output externalCredentials
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, time.Time{}, fmt.Errorf("parsing the output of the process_command %s got an error: %v", p.command[0], err)
+	}
+	if output.AccessKeyId == "" || output.AccessKeySecret == "" {
+		return nil, time.Time{}, fmt.Errorf("the output of the process_command %s misses the access_key_id or access_key_secret", p.command[0])
+	}
+	credentials := &providers.Credentials{AccessKeyId: output.AccessKeyId, AccessKeySecret: output.AccessKeySecret}
+	switch output.Mode {
+	case "AK":
+	case "StsToken":
+		if output.StsToken == "" {
+			return nil, time.Time{}, fmt.Errorf("the output of the process_command %s misses the sts_token", p.command[0])
+		}
+		credentials.SecurityToken = output.StsToken
+	default:
+		return nil, time.Time{}, fmt.Errorf("the mode %q in the output of the process_command %s is not supported, valid values: AK, StsToken", output.Mode, p.command[0])
+	}
+	var expiration time.Time
+	if output.Expiration != "" {
+		t, err := time.Parse(time.RFC3339, output.Expiration)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("parsing the expiration %q in the output of the process_command %s got an error: %v", output.Expiration, p.command[0], err)
+		}
+		expiration = t
+	}
+	return credentials, expiration, nil
+}
+
+// cloudSSOCredentialsProvider exchanges the access token saved by `aliyun configure --mode CloudSSO` for the STS
+// credentials of the account and access configuration.
+type cloudSSOCredentialsProvider struct {
+	profileName       string
+	signInUrl         *url.URL
+	accountId         string
+	accessConfig      string
+	accessToken       string
+	accessTokenExpire int64
+	httpClient        *http.Client
+	session           sessionCredentials
+}
+
+type cloudSSOCredentialResponse struct {
+	RequestId       string `json:"RequestId"`
+	Code            string `json:"Code"`
+	Message         string `json:"Message"`
+	CloudCredential struct {
+		AccessKeyId     string `json:"AccessKeyId"`
+		AccessKeySecret string `json:"AccessKeySecret"`
+		SecurityToken   string `json:"SecurityToken"`
+		Expiration      string `json:"Expiration"`
+	} `json:"CloudCredential"`
+}
+
+func (p *cloudSSOCredentialsProvider) GetCredentials() (*providers.Credentials, error) {
+	return p.session.get(p.GetProviderName(), p.createCloudCredential)
+}
+
+func (p *cloudSSOCredentialsProvider) GetProviderName() string {
+	return "cloud_sso"
+}
+
+func (p *cloudSSOCredentialsProvider) createCloudCredential() (*providers.Credentials, time.Time, error) {
+	if p.accessTokenExpire > 0 && time.Now().Unix() >= p.accessTokenExpire {
+		return nil, time.Time{}, fmt.Errorf("the CloudSSO access token of the profile %s has expired, please run `aliyun configure --profile %s --mode CloudSSO` to log in again", p.profileName, p.profileName)
+	}
+	body, err := json.Marshal(map[string]string{
+		"AccountId":             p.accountId,
+		"AccessConfigurationId": p.accessConfig,
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	endpoint := url.URL{Scheme: p.signInUrl.Scheme, Host: p.signInUrl.Host, Path: "/cloud-credentials"}
+	req, err := http.NewRequest(http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.accessToken)
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("creating the CloudSSO credential of the profile %s got an error: %v", p.profileName, err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	var response cloudSSOCredentialResponse
+	if err := json.Unmarshal(data, &response); err != nil || resp.StatusCode != http.StatusOK {
+		return nil, time.Time{}, fmt.Errorf("creating the CloudSSO credential of the profile %s got an error: status %d, response %s", p.profileName, resp.StatusCode, string(data))
+	}
+	credential := response.CloudCredential
+	if credential.AccessKeyId == "" || credential.AccessKeySecret == "" || credential.SecurityToken == "" {
+		return nil, time.Time{}, fmt.Errorf("the CloudSSO credential of the profile %s is incomplete, request id: %s", p.profileName, response.RequestId)
+	}
+	expiration, err := time.Parse(time.RFC3339, credential.Expiration)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("parsing the CloudSSO credential expiration %q got an error: %v", credential.Expiration, err)
+	}
+	return &providers.Credentials{
+		AccessKeyId:     credential.AccessKeyId,
+		AccessKeySecret: credential.AccessKeySecret,
+		SecurityToken:   credential.SecurityToken,
+	}, expiration, nil
+}
@@ -0,0 +1,122 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// profileFixture copies the testdata/profiles/config.json, pointing its URLs to the server.
+func profileFixture(t *testing.T, server *httptest.Server) string {
+	data, err := ioutil.ReadFile("testdata/profiles/config.json")
+	assert.Nil(t, err)
+	file := filepath.Join(t.TempDir(), "config.json")
+	assert.Nil(t, ioutil.WriteFile(file, []byte(strings.ReplaceAll(string(data), "{{server}}", server.URL)), 0600))
+	return file
+}
+
+func newProfileServer(t *testing.T) (*httptest.Server, *int) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch r.URL.Path {
+		case "/cloud-credentials":
+			var body map[string]string
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "Bearer mock-access-token", r.Header.Get("Authorization"))
+			assert.Equal(t, map[string]string{"AccountId": "1234567890", "AccessConfigurationId": "ac-mock"}, body)
+			w.Write([]byte(`{"RequestId": "mock", "CloudCredential": {"AccessKeyId": "STS.SSOAccessKeyId", "AccessKeySecret": "SSOAccessKeySecret", "SecurityToken": "SSOSecurityToken", "Expiration": "2100-01-01T00:00:00Z"}}`))
+		case "/credentials":
+			w.Write([]byte(`{"Code": "Success", "AccessKeyId": "STS.URIAccessKeyId", "AccessKeySecret": "URIAccessKeySecret", "SecurityToken": "URISecurityToken", "Expiration": "2100-01-01T00:00:00Z"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server, &calls
+}
+
+func TestProfileCredentialsProviderCloudSSO(t *testing.T) {
+	server, calls := newProfileServer(t)
+	defer server.Close()
+	file := profileFixture(t, server)
+
+	// The current profile is used when the profile name is empty
+	provider, err := NewProfileCredentialsProvider(file, "")
+	assert.Nil(t, err)
+	for i := 0; i < 2; i++ {
+		credentials, err := provider.GetCredentials()
+		assert.Nil(t, err)
+		assert.Equal(t, "STS.SSOAccessKeyId", credentials.AccessKeyId)
+		assert.Equal(t, "SSOAccessKeySecret", credentials.AccessKeySecret)
+		assert.Equal(t, "SSOSecurityToken", credentials.SecurityToken)
+		assert.Equal(t, "cloud_sso", credentials.ProviderName)
+	}
+	assert.Equal(t, 1, *calls)
+
+	provider, err = NewProfileCredentialsProvider(file, "sso-expired")
+	assert.Nil(t, err)
+	_, err = provider.GetCredentials()
+	assert.Contains(t, err.Error(), "the CloudSSO access token of the profile sso-expired has expired")
+	assert.Equal(t, 1, *calls)
+}
+
+func TestProfileCredentialsProviderExternal(t *testing.T) {
+	server, _ := newProfileServer(t)
+	defer server.Close()
+	file := profileFixture(t, server)
+
+	provider, err := NewProfileCredentialsProvider(file, "external-ak")
+	assert.Nil(t, err)
+	credentials, err := provider.GetCredentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "ExternalAccessKeyId", credentials.AccessKeyId)
+	assert.Equal(t, "", credentials.SecurityToken)
+
+	provider, err = NewProfileCredentialsProvider(file, "external-sts")
+	assert.Nil(t, err)
+	credentials, err = provider.GetCredentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "STS.ExternalAccessKeyId", credentials.AccessKeyId)
+	assert.Equal(t, "ExternalStsToken", credentials.SecurityToken)
+
+	provider, err = NewProfileCredentialsProvider(file, "external-invalid")
+	assert.Nil(t, err)
+	_, err = provider.GetCredentials()
+	assert.Contains(t, err.Error(), "unknown mode Invalid")
+}
+
+func TestProfileCredentialsProviderCredentialsURI(t *testing.T) {
+	server, _ := newProfileServer(t)
+	defer server.Close()
+	file := profileFixture(t, server)
+
+	provider, err := NewProfileCredentialsProvider(file, "uri")
+	assert.Nil(t, err)
+	credentials, err := provider.GetCredentials()
+	assert.Nil(t, err)
+	assert.Equal(t, "STS.URIAccessKeyId", credentials.AccessKeyId)
+	assert.Equal(t, "URISecurityToken", credentials.SecurityToken)
+}
+
+func TestProfileCredentialsProviderModes(t *testing.T) {
+	server, _ := newProfileServer(t)
+	defer server.Close()
+	file := profileFixture(t, server)
+
+	provider, err := NewProfileCredentialsProvider(file, "oidc")
+	assert.Nil(t, err)
+	assert.Equal(t, "cli_profile", provider.GetProviderName())
+
+	_, err = NewProfileCredentialsProvider(file, "ak")
+	assert.Contains(t, err.Error(), "the mode AK of the profile ak is not supported")
+
+	_, err = NewProfileCredentialsProvider(file, "missing")
+	assert.Contains(t, err.Error(), "the profile missing is not found")
+}
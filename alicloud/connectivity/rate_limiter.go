@@ -0,0 +1,232 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitPolicy is configured by the provider rate_limit block.
+type RateLimitPolicy struct {
+	// RequestsPerSecond is the default rate of each product. Zero means the products not in ProductRates are not limited.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests sent at once.
+	Burst int
+	// ProductRates overrides the rate of the products, keyed by the product code, like ecs.
+	ProductRates map[string]float64
+	// ApiRates limits the rate of the APIs in addition to their products, keyed by the product code and API name, like ecs:DescribeInstances.
+	ApiRates map[string]float64
+	// Adaptive halves the rate of a product after it returns a throttling error, and restores the rate gradually after the successful requests.
+	Adaptive bool
+}
+
+// minAdaptiveRateRatio limits how much the adaptive slowdown can reduce the configured rate.
+const minAdaptiveRateRatio = 1.0 / 16
+
+// RateLimiter limits the API requests of a client with the token buckets per product and API.
+type RateLimiter struct {
+	policy   RateLimitPolicy
+	mutex    sync.Mutex
+	limiters map[string]*adaptiveLimiter
+}
+
+type adaptiveLimiter struct {
+	*rate.Limiter
+	mutex sync.Mutex
+	base  rate.Limit
+}
+
+// NewRateLimiter returns a RateLimiter of the policy.
+func NewRateLimiter(policy RateLimitPolicy) *RateLimiter {
+	normalized := RateLimitPolicy{
+		RequestsPerSecond: policy.RequestsPerSecond,
+		Burst:             policy.Burst,
+		ProductRates:      make(map[string]float64, len(policy.ProductRates)),
+		ApiRates:          make(map[string]float64, len(policy.ApiRates)),
+		Adaptive:          policy.Adaptive,
+	}
+	if normalized.Burst < 1 {
+		normalized.Burst = 1
+	}
+	for product, value := range policy.ProductRates {
+		normalized.ProductRates[strings.ToLower(ConvertKebabToSnake(product))] = value
+	}
+	for key, value := range policy.ApiRates {
+		if parts := strings.SplitN(key, ":", 2); len(parts) == 2 {
+			key = rateLimitKey(parts[0], parts[1])
+		}
+		normalized.ApiRates[key] = value
+	}
+	return &RateLimiter{
+		policy:   normalized,
+		limiters: make(map[string]*adaptiveLimiter),
+	}
+}
+
+// Wait blocks until the request of the API is allowed by the product and API limiters.
+func (r *RateLimiter) Wait(productCode, apiName string) {
+	if r == nil {
+		return
+	}
+	productCode = strings.ToLower(ConvertKebabToSnake(productCode))
+	if limiter := r.productLimiter(productCode); limiter != nil {
+		limiter.Wait(context.Background())
+	}
+	if limiter := r.apiLimiter(productCode, apiName); limiter != nil {
+		limiter.Wait(context.Background())
+	}
+}
+
+// Observe adapts the product rate according to the result of the request.
+func (r *RateLimiter) Observe(productCode string, err error) {
+	if r == nil || !r.policy.Adaptive {
+		return
+	}
+	limiter := r.productLimiter(strings.ToLower(ConvertKebabToSnake(productCode)))
+	if limiter == nil {
+		return
+	}
+	if isThrottlingError(err) {
+		limiter.slowDown(productCode)
+	} else if err == nil {
+		limiter.recover()
+	}
+}
+
+func (r *RateLimiter) productLimiter(productCode string) *adaptiveLimiter {
+	value, ok := r.policy.ProductRates[productCode]
+	if !ok {
+		value = r.policy.RequestsPerSecond
+	}
+	return r.limiter(productCode, value)
+}
+
+func (r *RateLimiter) apiLimiter(productCode, apiName string) *adaptiveLimiter {
+	if apiName == "" {
+		return nil
+	}
+	key := rateLimitKey(productCode, apiName)
+	value, ok := r.policy.ApiRates[key]
+	if !ok {
+		return nil
+	}
+	return r.limiter(key, value)
+}
+
+func (r *RateLimiter) limiter(key string, value float64) *adaptiveLimiter {
+	if value <= 0 {
+		return nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = &adaptiveLimiter{
+			Limiter: rate.NewLimiter(rate.Limit(value), r.policy.Burst),
+			base:    rate.Limit(value),
+		}
+		r.limiters[key] = limiter
+	}
+	return limiter
+}
+
+func (l *adaptiveLimiter) slowDown(productCode string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	limit := l.Limit() / 2
+	if limit < l.base*minAdaptiveRateRatio {
+		limit = l.base * minAdaptiveRateRatio
+	}
+	if limit != l.Limit() {
+		log.Printf("[DEBUG] %s API requests are throttled, reducing the rate limit to %.2f requests per second.", productCode, float64(limit))
+		l.SetLimit(limit)
+	}
+}
+
+func (l *adaptiveLimiter) recover() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if limit := l.Limit(); limit < l.base {
+		limit += l.base / 10
+		if limit > l.base {
+			limit = l.base
+		}
+		l.SetLimit(limit)
+	}
+}
+
+func rateLimitKey(productCode, apiName string) string {
+	return strings.ToLower(ConvertKebabToSnake(productCode)) + ":" + apiName
+}
+
+func isThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if e, ok := err.(*tea.SDKError); ok {
+		return strings.Contains(tea.StringValue(e.Code), "Throttling")
+	}
+	if e, ok := err.(*errors.ServerError); ok {
+		return strings.Contains(e.ErrorCode(), "Throttling")
+	}
+	return false
+}
+
+// rateLimitTransport limits the HTTP requests sent by the SDK clients built with getSdkConfig.
+// Their products are looked up by the request host in the loaded endpoints.
+type rateLimitTransport struct {
+	client *AliyunClient
+	base   http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	productCode := t.client.productCodeByHost(req.URL.Hostname())
+	apiName := req.URL.Query().Get("Action")
+	if apiName == "" {
+		apiName = req.Header.Get("x-acs-action")
+	}
+	t.client.rateLimiter.Wait(productCode, apiName)
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil || !t.client.rateLimiter.policy.Adaptive {
+		return resp, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, e := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if e == nil && bytes.Contains(body, []byte("Throttling")) {
+			t.client.rateLimiter.Observe(productCode, &tea.SDKError{Code: tea.String("Throttling")})
+		}
+		return resp, nil
+	}
+	t.client.rateLimiter.Observe(productCode, nil)
+	return resp, nil
+}
+
+// productCodeByHost returns the product whose loaded endpoint is the host, or the first label of the host.
+func (client *AliyunClient) productCodeByHost(host string) string {
+	productCode := ""
+	client.config.Endpoints.Range(func(key, value interface{}) bool {
+		if endpoint, ok := value.(string); ok && endpoint == host {
+			productCode = key.(string)
+			return false
+		}
+		return true
+	})
+	if productCode == "" {
+		productCode = strings.Split(host, ".")[0]
+	}
+	return productCode
+}
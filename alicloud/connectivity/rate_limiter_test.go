@@ -0,0 +1,89 @@
+package connectivity
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitPolicy{
+		ProductRates: map[string]float64{"Ecs": 20},
+		ApiRates:     map[string]float64{"Vpc:DescribeVpcs": 20},
+	})
+	assert.Nil(t, limiter.productLimiter("vpc"))
+	assert.NotNil(t, limiter.productLimiter("ecs"))
+	assert.Nil(t, limiter.apiLimiter("vpc", "CreateVpc"))
+	assert.NotNil(t, limiter.apiLimiter("vpc", "DescribeVpcs"))
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		limiter.Wait("Ecs", "DescribeInstances")
+	}
+	assert.True(t, time.Since(start) >= 150*time.Millisecond)
+
+	start = time.Now()
+	for i := 0; i < 5; i++ {
+		limiter.Wait("Vpc", "CreateVpc")
+	}
+	assert.True(t, time.Since(start) < 50*time.Millisecond)
+
+	var nilLimiter *RateLimiter
+	nilLimiter.Wait("ecs", "DescribeInstances")
+	nilLimiter.Observe("ecs", nil)
+}
+
+func TestRateLimiterAdaptive(t *testing.T) {
+	limiter := NewRateLimiter(RateLimitPolicy{RequestsPerSecond: 16, Adaptive: true})
+	throttling := &tea.SDKError{Code: tea.String("Throttling.User"), Message: tea.String("Request was denied due to user flow control.")}
+
+	limiter.Observe("ecs", throttling)
+	assert.Equal(t, rate.Limit(8), limiter.productLimiter("ecs").Limit())
+	assert.Equal(t, rate.Limit(16), limiter.productLimiter("vpc").Limit())
+	for i := 0; i < 10; i++ {
+		limiter.Observe("ecs", throttling)
+	}
+	assert.Equal(t, rate.Limit(1), limiter.productLimiter("ecs").Limit())
+
+	limiter.Observe("ecs", &tea.SDKError{Code: tea.String("InvalidParameter"), Message: tea.String("invalid")})
+	assert.Equal(t, rate.Limit(1), limiter.productLimiter("ecs").Limit())
+	for i := 0; i < 20; i++ {
+		limiter.Observe("ecs", nil)
+	}
+	assert.Equal(t, rate.Limit(16), limiter.productLimiter("ecs").Limit())
+}
+
+func TestRateLimiterRpcRequest(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.Register("Ecs", "DescribeInstances",
+		MockResponse{StatusCode: http.StatusBadRequest, Body: map[string]interface{}{"Code": "Throttling.User", "Message": "Request was denied due to user flow control."}},
+		MockResponse{Body: map[string]interface{}{"TotalCount": 0}},
+	)
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+	client.rateLimiter = NewRateLimiter(RateLimitPolicy{RequestsPerSecond: 100, Burst: 10, Adaptive: true})
+
+	_, err = client.RpcPost("Ecs", "2014-05-26", "DescribeInstances", nil, nil, false)
+	assert.NotNil(t, err)
+	assert.Equal(t, rate.Limit(50), client.rateLimiter.productLimiter("ecs").Limit())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.RpcPost("Ecs", "2014-05-26", "DescribeInstances", nil, nil, false)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, rate.Limit(100), client.rateLimiter.productLimiter("ecs").Limit())
+	assert.Equal(t, "ecs", client.productCodeByHost("ecs."+MockEndpointSuffix))
+	assert.Equal(t, "vpc", client.productCodeByHost("vpc.cn-hangzhou.aliyuncs.com"))
+}
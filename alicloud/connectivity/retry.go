@@ -0,0 +1,82 @@
+package connectivity
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+)
+
+// RetryPolicy is configured by the provider retry block. It replaces the fixed retry counts and waits
+// of the API requests with the exponential backoff.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request, including the first one.
+	MaxAttempts int
+	// BaseDelay is the wait before the first retry, and it doubles after each retry.
+	BaseDelay time.Duration
+	// MaxDelay is the upper limit of the wait between two attempts.
+	MaxDelay time.Duration
+	// Jitter makes the wait a random value between the BaseDelay and the backoff, so the parallel requests do not retry at
+	// the same time.
+	Jitter bool
+	// ExtraRetryableCodes are the error codes retried in addition to the throttling, service unavailable and 5xx errors.
+	ExtraRetryableCodes []string
+}
+
+// RetryPolicy returns the retry policy configured by the provider, and nil means the default retry behaviour is used.
+func (client *AliyunClient) RetryPolicy() *RetryPolicy {
+	if client == nil || client.config == nil {
+		return nil
+	}
+	return client.config.Retry
+}
+
+// Backoff returns the wait before the attempt-th retry, starting from 1.
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := p.BaseDelay
+	for i := 1; i < attempt && i < 32 && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter && delay > p.BaseDelay {
+		delay = p.BaseDelay + time.Duration(rand.Int63n(int64(delay-p.BaseDelay)+1))
+	}
+	return delay
+}
+
+// IsExtraRetryable checks whether the error code is one of the ExtraRetryableCodes.
+func (p *RetryPolicy) IsExtraRetryable(err error) bool {
+	if p == nil || err == nil || len(p.ExtraRetryableCodes) == 0 {
+		return false
+	}
+	code := ""
+	if e, ok := err.(*tea.SDKError); ok {
+		code = tea.StringValue(e.Code)
+	} else if e, ok := err.(*errors.ServerError); ok {
+		code = e.ErrorCode()
+	}
+	for _, expected := range p.ExtraRetryableCodes {
+		if code == expected || (code == "" && strings.Contains(err.Error(), expected)) {
+			return true
+		}
+	}
+	return false
+}
+
+// retry invokes the request until it succeeds, returns an error which does not need retry or the attempts run out.
+func (p *RetryPolicy) retry(invoke func() (map[string]interface{}, error)) (response map[string]interface{}, err error) {
+	for attempt := 1; ; attempt++ {
+		response, err = invoke()
+		if err == nil || attempt >= p.MaxAttempts || !(needRetry(err) || p.IsExtraRetryable(err)) {
+			return response, err
+		}
+		time.Sleep(p.Backoff(attempt))
+	}
+}
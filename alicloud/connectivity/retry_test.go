@@ -0,0 +1,70 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	assert.Equal(t, 800*time.Millisecond, policy.Backoff(4))
+	assert.Equal(t, time.Second, policy.Backoff(5))
+	assert.Equal(t, time.Second, policy.Backoff(100))
+
+	policy.Jitter = true
+	for attempt := 1; attempt < 10; attempt++ {
+		delay := policy.Backoff(attempt)
+		assert.True(t, delay >= policy.BaseDelay && delay <= time.Second, fmt.Sprintf("the backoff %s is out of range", delay))
+	}
+}
+
+func TestRetryPolicyIsExtraRetryable(t *testing.T) {
+	var policy *RetryPolicy
+	assert.False(t, policy.IsExtraRetryable(fmt.Errorf("OperationConflict")))
+
+	policy = &RetryPolicy{ExtraRetryableCodes: []string{"OperationConflict"}}
+	assert.True(t, policy.IsExtraRetryable(&tea.SDKError{Code: tea.String("OperationConflict"), Message: tea.String("conflict")}))
+	assert.False(t, policy.IsExtraRetryable(&tea.SDKError{Code: tea.String("Forbidden"), Message: tea.String("OperationConflict")}))
+	assert.True(t, policy.IsExtraRetryable(fmt.Errorf("got an error OperationConflict")))
+	assert.False(t, policy.IsExtraRetryable(nil))
+}
+
+func TestRetryPolicyRpcRequest(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.Register("Ecs", "DescribeInstances",
+		MockResponse{StatusCode: http.StatusBadRequest, Body: map[string]interface{}{"Code": "Throttling.User", "Message": "Request was denied due to user flow control."}},
+		MockResponse{StatusCode: http.StatusBadRequest, Body: map[string]interface{}{"Code": "OperationConflict", "Message": "The operation conflicts."}},
+		MockResponse{Body: map[string]interface{}{"TotalCount": 0}},
+	)
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+	client.config.Retry = &RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond, ExtraRetryableCodes: []string{"OperationConflict"}}
+
+	_, err = client.RpcPost("Ecs", "2014-05-26", "DescribeInstances", nil, nil, true)
+	assert.Nil(t, err)
+	assert.Len(t, server.Requests(), 3)
+
+	// The request without auto retry is not retried
+	server.Register("Ecs", "RunInstances",
+		MockResponse{StatusCode: http.StatusBadRequest, Body: map[string]interface{}{"Code": "Throttling.User", "Message": "Request was denied due to user flow control."}},
+		MockResponse{Body: map[string]interface{}{"InstanceIdSets": map[string]interface{}{}}},
+	)
+	_, err = client.RpcPost("Ecs", "2014-05-26", "RunInstances", nil, nil, false)
+	assert.NotNil(t, err)
+
+	client.config.Retry.MaxAttempts = 2
+	server.Register("Ecs", "DescribeDisks",
+		MockResponse{StatusCode: http.StatusServiceUnavailable, Body: map[string]interface{}{"Code": "ServiceUnavailable", "Message": "The request has failed due to a temporary failure of the server."}},
+	)
+	_, err = client.RpcPost("Ecs", "2014-05-26", "DescribeDisks", nil, nil, true)
+	assert.NotNil(t, err)
+	assert.Len(t, server.Requests(), 6)
+}
@@ -0,0 +1,5 @@
+#!/bin/sh
+# Prints the credentials like a credential_process, counting its runs in the file $1. The expiration is $2.
+count=$(($(cat "$1" 2>/dev/null || echo 0) + 1))
+echo "$count" > "$1"
+echo "{\"AccessKeyId\": \"STS.ProcessAccessKeyId$count\", \"AccessKeySecret\": \"ProcessAccessKeySecret\", \"SecurityToken\": \"ProcessSecurityToken\", \"Expiration\": \"$2\"}"
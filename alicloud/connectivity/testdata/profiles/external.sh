@@ -0,0 +1,14 @@
+#!/bin/sh
+# Prints the credentials like a credential process of the External profile mode
+case "$1" in
+AK)
+  echo '{"mode": "AK", "access_key_id": "ExternalAccessKeyId", "access_key_secret": "ExternalAccessKeySecret"}'
+  ;;
+StsToken)
+  echo '{"mode": "StsToken", "access_key_id": "STS.ExternalAccessKeyId", "access_key_secret": "ExternalAccessKeySecret", "sts_token": "ExternalStsToken", "expiration": "2100-01-01T00:00:00Z"}'
+  ;;
+*)
+  echo "unknown mode $1" >&2
+  exit 1
+  ;;
+esac
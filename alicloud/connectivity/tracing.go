@@ -0,0 +1,443 @@
+package connectivity
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+)
+
+// The span status codes defined by OpenTelemetry.
+const (
+	spanStatusUnset = 0
+	spanStatusOk    = 1
+	spanStatusError = 2
+)
+
+// spanKindClient is the OpenTelemetry span kind of the outgoing requests.
+const spanKindClient = 3
+
+const tracerScopeName = "github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+
+// The spans are exported in batches by one goroutine of the tracer. The spans are dropped when the queue is full, so
+// that the slow collectors never block the API requests.
+const (
+	tracerQueueSize     = 4096
+	tracerBatchSize     = 256
+	tracerFlushInterval = 5 * time.Second
+)
+
+// tracers are the tracers which are not closed, and they are closed by CloseTracers before the provider exits.
+var tracers = struct {
+	sync.Mutex
+	set map[*Tracer]struct{}
+}{set: make(map[*Tracer]struct{})}
+
+// sensitiveParamPattern matches the sensitive parameters in a URL or form, like the ones in the network error messages.
+var sensitiveParamPattern = regexp.MustCompile(`(?i)((?:accesskeyid|accesskeysecret|securitytoken|signature|password)=)[^&\s"]+`)
+
+// Tracer exports one span per API request in the OTLP JSON format, to a file or an OTLP/HTTP collector, in batches.
+// All of the spans of a client belong to one trace, so that a terraform run can be viewed as a whole.
+type Tracer struct {
+	traceId    string
+	regionId   string
+	attributes []otlpAttribute
+	mutex      sync.RWMutex
+	closed     bool
+	spans      chan otlpSpan
+	flushes    chan chan struct{}
+	done       chan struct{}
+	file       *os.File
+	endpoint   string
+	httpClient *http.Client
+}
+
+// Span records an API request. Its methods do nothing when the tracing is disabled.
+type Span struct {
+	tracer         *Tracer
+	spanId         string
+	start          time.Time
+	apiProductCode string
+	apiVersion     string
+	apiName        string
+	attempts       int
+}
+
+// NewTracer returns a Tracer writing to the file and posting to the collector endpoint, like http://localhost:4318/v1/traces.
+// Either of them can be empty.
+func NewTracer(file, endpoint, regionId, terraformTraceId string) (*Tracer, error) {
+	t := &Tracer{
+		traceId:  randomHex(16),
+		regionId: regionId,
+		endpoint: endpoint,
+		attributes: []otlpAttribute{
+			stringAttribute("service.name", "terraform-provider-alicloud"),
+			stringAttribute("service.version", providerVersion),
+			stringAttribute("terraform.trace_id", terraformTraceId),
+		},
+	}
+	if file != "" {
+		f, err := os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("opening the trace file %s got an error: %#v", file, err)
+		}
+		t.file = f
+	}
+	if endpoint != "" {
+		t.httpClient = &http.Client{Timeout: 5 * time.Second}
+	}
+	t.spans = make(chan otlpSpan, tracerQueueSize)
+	t.flushes = make(chan chan struct{})
+	t.done = make(chan struct{})
+	go t.run()
+	tracers.Lock()
+	tracers.set[t] = struct{}{}
+	tracers.Unlock()
+	return t, nil
+}
+
+// Flush exports the spans which have ended.
+func (t *Tracer) Flush() {
+	if t == nil {
+		return
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if t.closed {
+		return
+	}
+	flushed := make(chan struct{})
+	t.flushes <- flushed
+	<-flushed
+}
+
+// Close exports the spans which have ended and closes the trace file. The spans ending after it are dropped.
+func (t *Tracer) Close() error {
+	if t == nil {
+		return nil
+	}
+	t.mutex.Lock()
+	if t.closed {
+		t.mutex.Unlock()
+		return nil
+	}
+	t.closed = true
+	close(t.spans)
+	t.mutex.Unlock()
+	<-t.done
+
+	tracers.Lock()
+	delete(tracers.set, t)
+	tracers.Unlock()
+	if t.file != nil {
+		return t.file.Close()
+	}
+	return nil
+}
+
+// CloseTracers closes all of the tracers, and it should be invoked before the provider process exits.
+func CloseTracers() {
+	tracers.Lock()
+	list := make([]*Tracer, 0, len(tracers.set))
+	for t := range tracers.set {
+		list = append(list, t)
+	}
+	tracers.Unlock()
+	for _, t := range list {
+		if err := t.Close(); err != nil {
+			log.Printf("[WARN] closing the trace file got an error: %v", err)
+		}
+	}
+}
+
+// StartSpan starts the span of an API request.
+func (t *Tracer) StartSpan(apiProductCode, apiVersion, apiName string) *Span {
+	if t == nil {
+		return nil
+	}
+	return &Span{
+		tracer:         t,
+		spanId:         randomHex(8),
+		start:          time.Now(),
+		apiProductCode: apiProductCode,
+		apiVersion:     apiVersion,
+		apiName:        apiName,
+	}
+}
+
+// Attempt counts an attempt of the request, and the retry count is the attempts minus one.
+func (s *Span) Attempt() {
+	if s != nil {
+		s.attempts++
+	}
+}
+
+// End finishes the span with the result of the request and exports it.
+func (s *Span) End(response map[string]interface{}, err error) {
+	if s == nil {
+		return
+	}
+	end := time.Now()
+	retryCount := s.attempts - 1
+	if retryCount < 0 {
+		retryCount = 0
+	}
+	attributes := []otlpAttribute{
+		stringAttribute("rpc.system", "alibaba_cloud_openapi"),
+		stringAttribute("rpc.service", s.apiProductCode),
+		stringAttribute("rpc.method", s.apiName),
+		stringAttribute("alicloud.api_version", s.apiVersion),
+		stringAttribute("cloud.region", s.tracer.regionId),
+		intAttribute("alicloud.retry_count", retryCount),
+		intAttribute("alicloud.latency_ms", int(end.Sub(s.start)/time.Millisecond)),
+	}
+	requestId := ""
+	for _, key := range []string{"RequestId", "requestId", "request_id"} {
+		if v, ok := response[key]; ok && v != nil {
+			requestId = fmt.Sprint(v)
+			break
+		}
+	}
+	status := otlpStatus{Code: spanStatusOk}
+	if err != nil {
+		code, message := "", err.Error()
+		if e, ok := err.(*tea.SDKError); ok {
+			code, message = tea.StringValue(e.Code), tea.StringValue(e.Message)
+			var data map[string]interface{}
+			if requestId == "" && json.Unmarshal([]byte(tea.StringValue(e.Data)), &data) == nil {
+				if v, ok := data["RequestId"]; ok {
+					requestId = fmt.Sprint(v)
+				}
+			}
+		} else if e, ok := err.(*errors.ServerError); ok {
+			code, message, requestId = e.ErrorCode(), e.Message(), e.RequestId()
+		}
+		attributes = append(attributes, stringAttribute("alicloud.error_code", code))
+		status = otlpStatus{Code: spanStatusError, Message: redactSecrets(message)}
+	}
+	if requestId != "" {
+		attributes = append(attributes, stringAttribute("alicloud.request_id", requestId))
+	}
+	s.tracer.export(otlpSpan{
+		TraceId:           s.tracer.traceId,
+		SpanId:            s.spanId,
+		Name:              fmt.Sprintf("%s/%s", s.apiProductCode, s.apiName),
+		Kind:              spanKindClient,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        attributes,
+		Status:            status,
+	})
+}
+
+// export queues the span, and it is dropped when the queue is full or the tracer is closed.
+func (t *Tracer) export(span otlpSpan) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if t.closed {
+		return
+	}
+	select {
+	case t.spans <- span:
+	default:
+		log.Printf("[WARN] the trace queue is full and the span %s is dropped", span.Name)
+	}
+}
+
+// run exports the queued spans in batches, when a batch is full, the flush interval elapses, the tracer is flushed or
+// closed.
+func (t *Tracer) run() {
+	defer close(t.done)
+	ticker := time.NewTicker(tracerFlushInterval)
+	defer ticker.Stop()
+	var batch []otlpSpan
+	for {
+		select {
+		case span, ok := <-t.spans:
+			if !ok {
+				t.write(batch)
+				return
+			}
+			if batch = append(batch, span); len(batch) >= tracerBatchSize {
+				t.write(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			t.write(batch)
+			batch = nil
+		case flushed := <-t.flushes:
+			for len(t.spans) > 0 {
+				if batch = append(batch, <-t.spans); len(batch) >= tracerBatchSize {
+					t.write(batch)
+					batch = nil
+				}
+			}
+			t.write(batch)
+			batch = nil
+			close(flushed)
+		}
+	}
+}
+
+// write exports a batch of spans as one OTLP request, which is a line of the trace file.
+func (t *Tracer) write(spans []otlpSpan) {
+	if len(spans) == 0 {
+		return
+	}
+	request := otlpExportRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{Attributes: t.attributes},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: tracerScopeName, Version: providerVersion},
+				Spans: spans,
+			}},
+		}},
+	}
+	data, err := json.Marshal(request)
+	if err != nil {
+		log.Printf("[WARN] encoding %d spans got an error: %v", len(spans), err)
+		return
+	}
+	if t.file != nil {
+		if _, err := t.file.Write(append(data, '\n')); err != nil {
+			log.Printf("[WARN] writing %d spans got an error: %v", len(spans), err)
+		}
+	}
+	if t.httpClient != nil {
+		resp, err := t.httpClient.Post(t.endpoint, "application/json", bytes.NewReader(data))
+		if err != nil {
+			log.Printf("[WARN] exporting %d spans to %s got an error: %v", len(spans), t.endpoint, err)
+			return
+		}
+		resp.Body.Close()
+	}
+}
+
+// tracingTransport traces the HTTP requests sent by the SDK clients built with getSdkConfig. The SDK retries are
+// sent as separate requests, so each of their spans has no retry.
+type tracingTransport struct {
+	client *AliyunClient
+	base   http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+	apiName, apiVersion := query.Get("Action"), query.Get("Version")
+	if apiName == "" {
+		apiName, apiVersion = req.Header.Get("x-acs-action"), req.Header.Get("x-acs-version")
+	}
+	span := t.client.tracer.StartSpan(t.client.productCodeByHost(req.URL.Hostname()), apiVersion, apiName)
+	span.Attempt()
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		span.End(nil, err)
+		return resp, err
+	}
+	response := map[string]interface{}{"RequestId": resp.Header.Get("x-acs-request-id")}
+	if resp.StatusCode < http.StatusBadRequest {
+		span.End(response, nil)
+		return resp, nil
+	}
+	body, e := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	var data map[string]interface{}
+	if e == nil && json.Unmarshal(body, &data) == nil {
+		if v, ok := data["RequestId"]; ok {
+			response["RequestId"] = v
+		}
+	}
+	code, message := fmt.Sprint(data["Code"]), fmt.Sprint(data["Message"])
+	if data["Code"] == nil {
+		code, message = strconv.Itoa(resp.StatusCode), string(body)
+	}
+	span.End(response, &tea.SDKError{Code: tea.String(code), Message: tea.String(message)})
+	return resp, nil
+}
+
+// redactSecrets replaces the sensitive parameters in the message.
+func redactSecrets(message string) string {
+	return sensitiveParamPattern.ReplaceAllString(message, "${1}"+scrubbedValue)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// The following types are the OTLP/JSON encoding of an ExportTraceServiceRequest.
+type otlpExportRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceId           string          `json:"traceId"`
+	SpanId            string          `json:"spanId"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string             `json:"key"`
+	Value otlpAttributeValue `json:"value"`
+}
+
+type otlpAttributeValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+func stringAttribute(key, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpAttributeValue{StringValue: &value}}
+}
+
+// intAttribute encodes the value as a string, as OTLP/JSON does for the 64-bit integers.
+func intAttribute(key string, value int) otlpAttribute {
+	v := strconv.Itoa(value)
+	return otlpAttribute{Key: key, Value: otlpAttributeValue{IntValue: &v}}
+}
@@ -0,0 +1,136 @@
+package connectivity
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracerSpans(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.Register("Vpc", "DescribeVpcAttribute",
+		MockResponse{
+			StatusCode: http.StatusBadRequest,
+			Body:       map[string]interface{}{"Code": "Throttling", "Message": "Request was denied due to request throttling.", "RequestId": "request-1"},
+		},
+		MockResponse{
+			Body: map[string]interface{}{"VpcId": "vpc-mock", "RequestId": "request-2"},
+		},
+	)
+	server.Register("Vpc", "DeleteVpc", MockResponse{
+		StatusCode: http.StatusBadRequest,
+		Body:       map[string]interface{}{"Code": "Forbidden", "Message": "invalid url ?AccessKeyId=MockAccessKeyId&Signature=abc"},
+	})
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+	file := filepath.Join(t.TempDir(), "trace.json")
+	client.tracer, err = NewTracer(file, "", "cn-hangzhou", "trace-mock")
+	assert.Nil(t, err)
+	client.config.Retry = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+	request := map[string]interface{}{"VpcId": "vpc-mock"}
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DescribeVpcAttribute", request, nil, true)
+	assert.Nil(t, err)
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DeleteVpc", request, nil, false)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, client.Close())
+	spans := readSpans(t, file)
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "Vpc/DescribeVpcAttribute", spans[0].Name)
+	assert.Equal(t, spans[0].TraceId, spans[1].TraceId)
+	assert.Len(t, spans[0].TraceId, 32)
+	assert.Len(t, spans[0].SpanId, 16)
+	assert.Equal(t, spanStatusOk, spans[0].Status.Code)
+	attributes := spanAttributes(spans[0])
+	assert.Equal(t, "Vpc", attributes["rpc.service"])
+	assert.Equal(t, "2016-04-28", attributes["alicloud.api_version"])
+	assert.Equal(t, "cn-hangzhou", attributes["cloud.region"])
+	assert.Equal(t, "1", attributes["alicloud.retry_count"])
+	assert.Equal(t, "request-2", attributes["alicloud.request_id"])
+
+	assert.Equal(t, spanStatusError, spans[1].Status.Code)
+	assert.Equal(t, "Forbidden", spanAttributes(spans[1])["alicloud.error_code"])
+	assert.Contains(t, spans[1].Status.Message, "invalid url ?AccessKeyId=******&Signature=******")
+	assert.NotContains(t, spans[1].Status.Message, "MockAccessKeyId")
+}
+
+func TestTracerBatch(t *testing.T) {
+	var mutex sync.Mutex
+	var posted []int
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request otlpExportRequest
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&request))
+		mutex.Lock()
+		posted = append(posted, len(request.ResourceSpans[0].ScopeSpans[0].Spans))
+		mutex.Unlock()
+	}))
+	defer collector.Close()
+	file := filepath.Join(t.TempDir(), "trace.json")
+	tracer, err := NewTracer(file, collector.URL, "cn-hangzhou", "")
+	assert.Nil(t, err)
+
+	for i := 0; i < tracerBatchSize+10; i++ {
+		tracer.StartSpan("Vpc", "2016-04-28", "DescribeVpcs").End(nil, nil)
+	}
+	tracer.Flush()
+	assert.Len(t, readSpans(t, file), tracerBatchSize+10)
+	mutex.Lock()
+	assert.Equal(t, []int{tracerBatchSize, 10}, posted)
+	mutex.Unlock()
+
+	tracer.StartSpan("Vpc", "2016-04-28", "DescribeVpcs").End(nil, nil)
+	CloseTracers()
+	assert.Len(t, readSpans(t, file), tracerBatchSize+11)
+	assert.NotNil(t, tracer.file.Close(), "the trace file should be closed")
+
+	// The spans ending after the tracer is closed are dropped.
+	tracer.StartSpan("Vpc", "2016-04-28", "DescribeVpcs").End(nil, nil)
+	tracer.Flush()
+	assert.Nil(t, tracer.Close())
+}
+
+func TestTracerDisabled(t *testing.T) {
+	var tracer *Tracer
+	span := tracer.StartSpan("Vpc", "2016-04-28", "DescribeVpcs")
+	assert.Nil(t, span)
+	span.Attempt()
+	span.End(nil, nil)
+}
+
+func readSpans(t *testing.T, file string) []otlpSpan {
+	f, err := os.Open(file)
+	assert.Nil(t, err)
+	defer f.Close()
+	var spans []otlpSpan
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		var request otlpExportRequest
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &request))
+		assert.Equal(t, "terraform-provider-alicloud", *request.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+		spans = append(spans, request.ResourceSpans[0].ScopeSpans[0].Spans...)
+	}
+	return spans
+}
+
+func spanAttributes(span otlpSpan) map[string]string {
+	attributes := make(map[string]string)
+	for _, attribute := range span.Attributes {
+		if attribute.Value.StringValue != nil {
+			attributes[attribute.Key] = *attribute.Value.StringValue
+		} else if attribute.Value.IntValue != nil {
+			attributes[attribute.Key] = *attribute.Value.IntValue
+		}
+	}
+	return attributes
+}
@@ -0,0 +1,127 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceAlicloudArn() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudArnRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"arn", "service"},
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"region": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"arn"},
+			},
+			"account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"arn"},
+			},
+			"resource": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"arn"},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Arn is an Alibaba Cloud Resource Name like acs:ram::123456789012****:role/terraform.
+type Arn struct {
+	Service   string
+	Region    string
+	AccountId string
+	Resource  string
+}
+
+// ParseArn parses the ARN in the format acs:<service>:<region>:<account id>:<resource>. The region and account id may be
+// empty, like the ones of the RAM roles, and the resource may contain colons.
+func ParseArn(arn string) (*Arn, error) {
+	parts := strings.SplitN(arn, ":", 5)
+	if len(parts) != 5 || parts[0] != "acs" {
+		return nil, fmt.Errorf("Invalid ARN %s. Expected ARN format is acs:<service>:<region>:<account id>:<resource>.", arn)
+	}
+	if parts[1] == "" || parts[4] == "" {
+		return nil, fmt.Errorf("Invalid ARN %s. The service and resource can not be empty.", arn)
+	}
+	return &Arn{Service: parts[1], Region: parts[2], AccountId: parts[3], Resource: parts[4]}, nil
+}
+
+func (a *Arn) String() string {
+	return strings.Join([]string{"acs", a.Service, a.Region, a.AccountId, a.Resource}, ":")
+}
+
+// ResourceType returns the part of the resource before the first slash, like role of role/terraform.
+func (a *Arn) ResourceType() string {
+	if i := strings.Index(a.Resource, "/"); i >= 0 {
+		return a.Resource[:i]
+	}
+	return ""
+}
+
+// ResourceName returns the part of the resource after the first slash, like terraform of role/terraform.
+func (a *Arn) ResourceName() string {
+	if i := strings.Index(a.Resource, "/"); i >= 0 {
+		return a.Resource[i+1:]
+	}
+	return a.Resource
+}
+
+func dataSourceAlicloudArnRead(d *schema.ResourceData, meta interface{}) error {
+	var arn *Arn
+	if v, ok := d.GetOk("arn"); ok && v.(string) != "" {
+		parsed, err := ParseArn(v.(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		arn = parsed
+	} else {
+		arn = &Arn{
+			Service:   d.Get("service").(string),
+			Region:    d.Get("region").(string),
+			AccountId: d.Get("account_id").(string),
+			Resource:  d.Get("resource").(string),
+		}
+		// The built ARN is parsed again, so that it is always valid
+		if _, err := ParseArn(arn.String()); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	d.SetId(arn.String())
+	d.Set("arn", arn.String())
+	d.Set("service", arn.Service)
+	d.Set("region", arn.Region)
+	d.Set("account_id", arn.AccountId)
+	d.Set("resource", arn.Resource)
+	d.Set("resource_type", arn.ResourceType())
+	d.Set("resource_name", arn.ResourceName())
+	return nil
+}
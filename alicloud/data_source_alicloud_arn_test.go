@@ -0,0 +1,93 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestParseArn(t *testing.T) {
+	arn, err := ParseArn("acs:ram::123456789012****:role/terraform")
+	if err != nil {
+		t.Fatalf("Parsing the ARN got an error: %v.", err)
+	}
+	if arn.Service != "ram" || arn.Region != "" || arn.AccountId != "123456789012****" || arn.Resource != "role/terraform" {
+		t.Fatalf("The ARN is parsed incorrectly: %#v.", arn)
+	}
+	if arn.ResourceType() != "role" || arn.ResourceName() != "terraform" {
+		t.Fatalf("The resource of the ARN is split incorrectly: %s, %s.", arn.ResourceType(), arn.ResourceName())
+	}
+
+	// The resource may contain colons
+	arn, err = ParseArn("acs:log:cn-hangzhou:123456789012****:project/tf/logstore/a:b")
+	if err != nil || arn.Resource != "project/tf/logstore/a:b" || arn.ResourceName() != "tf/logstore/a:b" {
+		t.Fatalf("The ARN is parsed incorrectly: %#v, %v.", arn, err)
+	}
+	if arn.String() != "acs:log:cn-hangzhou:123456789012****:project/tf/logstore/a:b" {
+		t.Fatalf("The ARN is built incorrectly: %s.", arn.String())
+	}
+
+	for _, invalid := range []string{"", "arn:aws:iam::123:role/x", "acs:ram::123", "acs::cn-hangzhou:123:role/x", "acs:ram::123:"} {
+		if _, err := ParseArn(invalid); err == nil {
+			t.Fatalf("The invalid ARN %q should not be parsed.", invalid)
+		}
+	}
+}
+
+func TestDataSourceAlicloudArnRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceAlicloudArn().Schema, map[string]interface{}{
+		"service":    "ram",
+		"account_id": "123456789012****",
+		"resource":   "role/terraform",
+	})
+	if err := dataSourceAlicloudArnRead(d, nil); err != nil {
+		t.Fatalf("Building the ARN got an error: %v.", err)
+	}
+	if d.Id() != "acs:ram::123456789012****:role/terraform" || d.Get("resource_name") != "terraform" {
+		t.Fatalf("The ARN is built incorrectly: %s.", d.Id())
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourceAlicloudArn().Schema, map[string]interface{}{
+		"service": "ram",
+	})
+	if err := dataSourceAlicloudArnRead(d, nil); err == nil {
+		t.Fatal("The ARN without the resource should not be built.")
+	}
+}
+
+func TestAccAlicloudArnDataSource(t *testing.T) {
+	resourceId := "data.alicloud_arn.default"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "alicloud_arn" "default" {
+  arn = "acs:ram::123456789012****:role/terraform"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceId, "service", "ram"),
+					resource.TestCheckResourceAttr(resourceId, "region", ""),
+					resource.TestCheckResourceAttr(resourceId, "account_id", "123456789012****"),
+					resource.TestCheckResourceAttr(resourceId, "resource", "role/terraform"),
+					resource.TestCheckResourceAttr(resourceId, "resource_type", "role"),
+					resource.TestCheckResourceAttr(resourceId, "resource_name", "terraform"),
+				),
+			},
+			{
+				Config: `
+data "alicloud_arn" "default" {
+  service    = "oss"
+  region     = "cn-hangzhou"
+  account_id = "123456789012****"
+  resource   = "bucket/tf-test"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceId, "arn", "acs:oss:cn-hangzhou:123456789012****:bucket/tf-test"),
+				),
+			},
+		},
+	})
+}
@@ -129,7 +129,7 @@ func dataSourceAlicloudCSEdgeKubernetesClustersRead(d *schema.ResourceData, meta
 	var allClusterTypes []cs.ClusterType
 
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	var response interface{}
 	if err := invoker.Run(func() error {
 		raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -237,8 +237,8 @@ func csEdgeKubernetesClusterDescriptionAttributes(d *schema.ResourceData, cluste
 
 		var workerNodes []map[string]interface{}
 
-		invoker := NewInvoker()
 		client := meta.(*connectivity.AliyunClient)
+		invoker := NewInvoker(client)
 		pageNumber := 1
 		for {
 			var result []cs.KubernetesNodeType
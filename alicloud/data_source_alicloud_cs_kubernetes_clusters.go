@@ -299,7 +299,7 @@ func dataSourceAlicloudCSKubernetesClustersRead(d *schema.ResourceData, meta int
 	var allClusterTypes []cs.ClusterType
 	var requestInfo *cs.Client
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	var response interface{}
 	if err := invoker.Run(func() error {
 		raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -391,7 +391,7 @@ func csKubernetesClusterDescriptionAttributes(d *schema.ResourceData, clusterTyp
 	}
 
 	csClient := CsClient{roaClient}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	detailEnabled := false
 	if v, ok := d.GetOk("enable_details"); ok {
@@ -287,7 +287,7 @@ func dataSourceAlicloudCSManagerKubernetesClustersRead(d *schema.ResourceData, m
 
 	var allClusterTypes []cs.ClusterType
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	var response interface{}
 	if err := invoker.Run(func() error {
 		raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -378,7 +378,7 @@ func csManagedKubernetesClusterDescriptionAttributes(d *schema.ResourceData, clu
 	}
 
 	csClient := CsClient{roaClient}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	detailEnabled := false
 	if v, ok := d.GetOk("enable_details"); ok {
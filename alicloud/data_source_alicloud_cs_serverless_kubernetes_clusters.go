@@ -128,7 +128,7 @@ func dataSourceAlicloudCSServerlessKubernetesClustersRead(d *schema.ResourceData
 	var allClusterTypes []*cs.ServerlessClusterResponse
 
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	var response interface{}
 	if err := invoker.Run(func() error {
 		raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -211,7 +211,7 @@ func csServerlessKubernetesClusterDescriptionAttributes(d *schema.ResourceData,
 
 	csService := CsService{client}
 	csClient := CsClient{roaClient}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	detailEnabled := false
 	if v, ok := d.GetOk("enable_details"); ok {
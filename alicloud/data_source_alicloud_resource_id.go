@@ -0,0 +1,200 @@
+package alicloud
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceIdFormat describes the composite ID of a resource, and its parse is the parser used by the resource itself.
+type resourceIdFormat struct {
+	// attributes names the parts of the ID, keyed by the number of parts
+	attributes map[int][]string
+	parse      func(id string) ([]string, error)
+	// escaped means the colons in the parts are escaped by EscapeColons
+	escaped bool
+}
+
+func parseResourceIdOf(length int) func(id string) ([]string, error) {
+	return func(id string) ([]string, error) {
+		return ParseResourceId(id, length)
+	}
+}
+
+func parseResourceIdWithEscapedOf(length int) func(id string) ([]string, error) {
+	return func(id string) ([]string, error) {
+		return ParseResourceIdWithEscaped(id, length)
+	}
+}
+
+// resourceIdFormats are the resources whose composite ID is supported. The IDs of the other resources are not parsed,
+// because the parsers of the resources are not registered anywhere, so a resource is added here with its parser and it
+// is listed in the resource_type of the documentation as well.
+var resourceIdFormats = map[string]resourceIdFormat{
+	"alicloud_cs_kubernetes_node_pool": {
+		attributes: map[int][]string{2: {"cluster_id", "node_pool_id"}},
+		parse:      parseResourceIdOf(2),
+	},
+	"alicloud_db_account_privilege": {
+		attributes: map[int][]string{3: {"instance_id", "account_name", "privilege"}},
+		parse:      parseResourceIdOf(3),
+	},
+	"alicloud_db_database": {
+		attributes: map[int][]string{2: {"instance_id", "name"}},
+		parse:      parseResourceIdOf(2),
+	},
+	"alicloud_ecs_disk_attachment": {
+		attributes: map[int][]string{2: {"disk_id", "instance_id"}},
+		parse:      parseResourceIdOf(2),
+	},
+	"alicloud_forward_entry": {
+		attributes: map[int][]string{2: {"forward_table_id", "forward_entry_id"}},
+		parse:      parseResourceIdOf(2),
+	},
+	"alicloud_kvstore_account": {
+		attributes: map[int][]string{2: {"instance_id", "account_name"}},
+		parse:      parseResourceIdOf(2),
+	},
+	"alicloud_mse_nacos_config": {
+		attributes: map[int][]string{4: {"instance_id", "namespace_id", "data_id", "group"}},
+		parse:      parseResourceIdWithEscapedOf(4),
+		escaped:    true,
+	},
+	"alicloud_ram_role_policy_attachment": {
+		attributes: map[int][]string{4: {"principal_type", "policy_name", "policy_type", "role_name"}},
+		parse:      parseResourceIdOf(4),
+	},
+	"alicloud_ram_user_policy_attachment": {
+		attributes: map[int][]string{4: {"principal_type", "policy_name", "policy_type", "user_name"}},
+		parse:      parseResourceIdOf(4),
+	},
+	"alicloud_rds_account": {
+		attributes: map[int][]string{2: {"db_instance_id", "account_name"}},
+		parse:      parseResourceIdOf(2),
+	},
+	"alicloud_route_entry": {
+		attributes: map[int][]string{5: {"route_table_id", "router_id", "destination_cidrblock", "nexthop_type", "nexthop_id"}},
+		parse:      parseResourceIdOf(5),
+	},
+	"alicloud_slb_listener": {
+		attributes: map[int][]string{
+			2: {"load_balancer_id", "frontend_port"},
+			3: {"load_balancer_id", "protocol", "frontend_port"},
+		},
+		parse: ParseSlbListenerId,
+	},
+	"alicloud_snat_entry": {
+		attributes: map[int][]string{2: {"snat_table_id", "snat_entry_id"}},
+		parse:      parseResourceIdOf(2),
+	},
+}
+
+func resourceIdFormatTypes() []string {
+	types := make([]string, 0, len(resourceIdFormats))
+	for resourceType := range resourceIdFormats {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func validateResourceIdType(v interface{}, k string) ([]string, []error) {
+	if _, ok := resourceIdFormats[v.(string)]; ok {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("%s %q is not supported, and the composite ID of only the following resources can be split or joined: %s",
+		k, v, strings.Join(resourceIdFormatTypes(), ", "))}
+}
+
+func dataSourceAlicloudResourceId() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlicloudResourceIdRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateResourceIdType,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "parts"},
+			},
+			"parts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// joinResourceId joins the parts into the ID of the resource type, and the ID is parsed again so that it can be used by the resource.
+func joinResourceId(resourceType string, parts []string) (string, error) {
+	format := resourceIdFormats[resourceType]
+	escaped := make([]string, len(parts))
+	for i, part := range parts {
+		if format.escaped {
+			part = EscapeColons(part)
+		}
+		escaped[i] = part
+	}
+	id := strings.Join(escaped, COLON_SEPARATED)
+	parsed, err := format.parse(id)
+	if err != nil {
+		return "", err
+	}
+	if len(parsed) != len(parts) {
+		return "", fmt.Errorf("the parts %q can not be joined into the ID of %s, because some of them contain colons", parts, resourceType)
+	}
+	return id, nil
+}
+
+// splitResourceId splits the ID of the resource type into the parts and the attributes named by them.
+func splitResourceId(resourceType, id string) ([]string, map[string]string, error) {
+	format := resourceIdFormats[resourceType]
+	parts, err := format.parse(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	names, ok := format.attributes[len(parts)]
+	if !ok {
+		return nil, nil, fmt.Errorf("Invalid Resource Id %s of %s. Got %d parts.", id, resourceType, len(parts))
+	}
+	attributes := make(map[string]string, len(parts))
+	for i, name := range names {
+		attributes[name] = parts[i]
+	}
+	return parts, attributes, nil
+}
+
+func dataSourceAlicloudResourceIdRead(d *schema.ResourceData, meta interface{}) error {
+	resourceType := d.Get("resource_type").(string)
+	id := d.Get("id").(string)
+	if v, ok := d.GetOk("parts"); ok && id == "" {
+		joined, err := joinResourceId(resourceType, expandStringList(v.([]interface{})))
+		if err != nil {
+			return WrapError(err)
+		}
+		id = joined
+	}
+	parts, attributes, err := splitResourceId(resourceType, id)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.SetId(id)
+	d.Set("parts", parts)
+	d.Set("attributes", attributes)
+	return nil
+}
@@ -0,0 +1,93 @@
+package alicloud
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestSplitResourceId(t *testing.T) {
+	parts, attributes, err := splitResourceId("alicloud_slb_listener", "lb-abc:https:443")
+	if err != nil {
+		t.Fatalf("Splitting the ID got an error: %v.", err)
+	}
+	if !reflect.DeepEqual(parts, []string{"lb-abc", "https", "443"}) || attributes["protocol"] != "https" || attributes["frontend_port"] != "443" {
+		t.Fatalf("The ID is split incorrectly: %v, %v.", parts, attributes)
+	}
+	// The legacy ID of the alicloud_slb_listener has no protocol
+	_, attributes, err = splitResourceId("alicloud_slb_listener", "lb-abc:80")
+	if err != nil || attributes["frontend_port"] != "80" {
+		t.Fatalf("The legacy ID is split incorrectly: %v, %v.", attributes, err)
+	}
+
+	if _, _, err := splitResourceId("alicloud_rds_account", "rm-abc:tf:extra"); err == nil {
+		t.Fatal("The ID with the extra part should not be split.")
+	}
+
+	_, attributes, err = splitResourceId("alicloud_mse_nacos_config", `mse-abc:public:tf\:data:DEFAULT_GROUP`)
+	if err != nil || attributes["data_id"] != "tf:data" {
+		t.Fatalf("The escaped ID is split incorrectly: %v, %v.", attributes, err)
+	}
+}
+
+func TestValidateResourceIdType(t *testing.T) {
+	if _, errs := validateResourceIdType("alicloud_slb_listener", "resource_type"); len(errs) != 0 {
+		t.Fatalf("The supported resource type got errors: %v.", errs)
+	}
+	_, errs := validateResourceIdType("alicloud_instance", "resource_type")
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "alicloud_cs_kubernetes_node_pool, alicloud_db_account_privilege") {
+		t.Fatalf("The unsupported resource type should list the supported ones: %v.", errs)
+	}
+}
+
+func TestJoinResourceId(t *testing.T) {
+	id, err := joinResourceId("alicloud_cs_kubernetes_node_pool", []string{"c-abc", "np-abc"})
+	if err != nil || id != "c-abc:np-abc" {
+		t.Fatalf("The parts are joined incorrectly: %s, %v.", id, err)
+	}
+	id, err = joinResourceId("alicloud_mse_nacos_config", []string{"mse-abc", "public", "tf:data", "DEFAULT_GROUP"})
+	if err != nil || id != `mse-abc:public:tf\:data:DEFAULT_GROUP` {
+		t.Fatalf("The parts are joined incorrectly: %s, %v.", id, err)
+	}
+	if _, err := joinResourceId("alicloud_rds_account", []string{"rm-abc"}); err == nil {
+		t.Fatal("The missing parts should not be joined.")
+	}
+	if _, err := joinResourceId("alicloud_slb_listener", []string{"lb-abc", "tcp:80"}); err == nil {
+		t.Fatal("The parts containing colons should not be joined.")
+	}
+}
+
+func TestAccAlicloudResourceIdDataSource(t *testing.T) {
+	resourceId := "data.alicloud_resource_id.default"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "alicloud_resource_id" "default" {
+  resource_type = "alicloud_cs_kubernetes_node_pool"
+  id            = "c-abc:np-abc"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceId, "parts.#", "2"),
+					resource.TestCheckResourceAttr(resourceId, "attributes.cluster_id", "c-abc"),
+					resource.TestCheckResourceAttr(resourceId, "attributes.node_pool_id", "np-abc"),
+				),
+			},
+			{
+				Config: `
+data "alicloud_resource_id" "default" {
+  resource_type = "alicloud_slb_listener"
+  parts         = ["lb-abc", "https", "443"]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceId, "id", "lb-abc:https:443"),
+					resource.TestCheckResourceAttr(resourceId, "attributes.load_balancer_id", "lb-abc"),
+				),
+			},
+		},
+	})
+}
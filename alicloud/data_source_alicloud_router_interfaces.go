@@ -190,7 +190,7 @@ func dataSourceAlicloudRouterInterfacesRead(d *schema.ResourceData, meta interfa
 	}
 
 	var allRouterInterfaces []vpc.RouterInterfaceType
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	for {
 		var response *vpc.DescribeRouterInterfacesResponse
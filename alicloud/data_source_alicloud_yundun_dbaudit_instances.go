@@ -172,10 +172,10 @@ func dataSourceAlicloudDbauditInstancesRead(d *schema.ResourceData, meta interfa
 		response, _ := raw.(*yundun_dbaudit.ListTagResourcesResponse)
 		instanceTags = append(instanceTags, yundun_dbaudit.TagResources{TagResource: response.TagResources})
 	}
-	return WrapError(extractDbauditInstance(d, instances, instanceTags))
+	return WrapError(extractDbauditInstance(d, client, instances, instanceTags))
 }
 
-func extractDbauditInstance(d *schema.ResourceData, specs []yundun_dbaudit.Instance, tags []yundun_dbaudit.TagResources) error {
+func extractDbauditInstance(d *schema.ResourceData, client *connectivity.AliyunClient, specs []yundun_dbaudit.Instance, tags []yundun_dbaudit.TagResources) error {
 
 	var instanceIds []string
 	var descriptions []string
@@ -190,7 +190,7 @@ func extractDbauditInstance(d *schema.ResourceData, specs []yundun_dbaudit.Insta
 			"instance_status":       specs[i].InstanceStatus,
 			"license_code":          specs[i].LicenseCode,
 			"public_network_access": specs[i].PublicNetworkAccess,
-			"tags":                  dbauditTagsToMap(tags[i].TagResource),
+			"tags":                  dbauditTagsToMap(client, tags[i].TagResource),
 		}
 		instanceIds = append(instanceIds, specs[i].InstanceId)
 		descriptions = append(descriptions, specs[i].Description)
@@ -217,10 +217,10 @@ func extractDbauditInstance(d *schema.ResourceData, specs []yundun_dbaudit.Insta
 	return nil
 }
 
-func dbauditTagsToMap(tags []yundun_dbaudit.TagResource) map[string]string {
+func dbauditTagsToMap(client *connectivity.AliyunClient, tags []yundun_dbaudit.TagResource) map[string]string {
 	result := make(map[string]string)
 	for _, t := range tags {
-		if !dbauditTagIgnored(t) {
+		if !dbauditTagIgnored(client, t) {
 			result[t.TagKey] = t.TagValue
 		}
 	}
@@ -228,7 +228,10 @@ func dbauditTagsToMap(tags []yundun_dbaudit.TagResource) map[string]string {
 	return result
 }
 
-func dbauditTagIgnored(t yundun_dbaudit.TagResource) bool {
+func dbauditTagIgnored(client *connectivity.AliyunClient, t yundun_dbaudit.TagResource) bool {
+	if providerTagIgnored(client, t.TagKey) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
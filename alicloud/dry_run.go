@@ -0,0 +1,84 @@
+package alicloud
+
+import (
+	"fmt"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// schemaGetter reads the arguments from the *schema.ResourceData in the Create and the *schema.ResourceDiff in the
+// CustomizeDiff, so that the create request can also be built at plan time.
+type schemaGetter interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+	GetOkExists(key string) (interface{}, bool)
+}
+
+// dryRunCreate is the create API of a resource which accepts the DryRun parameter. When the provider plan_dry_run is
+// enabled, it is called with DryRun in the CustomizeDiff, so that the RAM permission and parameter errors fail the plan
+// instead of the apply.
+type dryRunCreate struct {
+	resourceName string
+	product      string
+	version      string
+	// keys are the arguments referring to the other resources. The dry run is skipped when any of them, or any field
+	// of their nested blocks, is not known until apply, because the request would be incomplete.
+	keys    []string
+	request func(d schemaGetter, client *connectivity.AliyunClient) (action string, request map[string]interface{}, err error)
+}
+
+func (c dryRunCreate) customizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*connectivity.AliyunClient)
+	if !ok || client == nil || !client.PlanDryRun() || diff.Id() != "" {
+		return nil
+	}
+	for _, key := range c.keys {
+		if !dryRunValueKnown(diff, key) {
+			return nil
+		}
+	}
+	action, request, err := c.request(diff, client)
+	if err != nil {
+		return err
+	}
+	request["DryRun"] = true
+	delete(request, "ClientToken")
+	response, err := client.RpcPost(c.product, c.version, action, nil, request, true)
+	addDebug(action, response, request)
+	if err == nil || IsExpectedErrors(err, []string{"DryRunOperation"}) {
+		return nil
+	}
+	return WrapErrorf(err, DefaultErrorMsg, c.resourceName, action+" DryRun", AlibabaCloudSdkGoERROR)
+}
+
+// dryRunValueKnown reports whether the planned value of the key is known, including the fields of each nested block
+// when the key is a list of blocks.
+func dryRunValueKnown(diff *schema.ResourceDiff, key string) bool {
+	if !diff.NewValueKnown(key) {
+		return false
+	}
+	items, ok := diff.Get(key).([]interface{})
+	if !ok {
+		return true
+	}
+	for i, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for field := range fields {
+			if !dryRunValueKnown(diff, fmt.Sprintf("%s.%d.%s", key, i, field)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isPlanTime reports whether the request is built for the dry run in the CustomizeDiff. The builders skip the lookups
+// which call the other APIs at plan time, such as decrypting the KMS encrypted password.
+func isPlanTime(d schemaGetter) bool {
+	_, ok := d.(*schema.ResourceDiff)
+	return ok
+}
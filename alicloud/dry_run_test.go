@@ -0,0 +1,98 @@
+package alicloud
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitAliCloudDryRunCreateWithMockServer(t *testing.T) {
+	server := connectivity.NewMockServer()
+	defer server.Close()
+	server.Register("Ecs", "CreateSecurityGroup",
+		connectivity.MockResponse{
+			StatusCode: http.StatusBadRequest,
+			Body:       map[string]interface{}{"Code": "DryRunOperation", "Message": "Request validation has been passed with DryRun flag set."},
+		},
+		connectivity.MockResponse{
+			StatusCode: http.StatusForbidden,
+			Body:       map[string]interface{}{"Code": "Forbidden.RAM", "Message": "User not authorized to operate on the specified resource."},
+		},
+	)
+	client, err := server.ClientWithConfig("cn-hangzhou", func(config *connectivity.Config) {
+		config.PlanDryRun = true
+	})
+	assert.Nil(t, err)
+
+	r := resourceAliCloudEcsSecurityGroup()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"vpc_id":              "vpc-mock",
+		"security_group_name": "tf-test",
+	})
+	_, err = r.Diff(nil, config, client)
+	assert.Nil(t, err)
+	requests := server.Requests()
+	assert.Len(t, requests, 1)
+	assert.Contains(t, requests[0].Body, "DryRun=true")
+	assert.Contains(t, requests[0].Body, "VpcId=vpc-mock")
+
+	_, err = r.Diff(nil, config, client)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Forbidden.RAM")
+
+	// The dry run is skipped when the vpc is created in the same apply
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"vpc_id":              "74D93920-ED26-11E3-AC10-0800200C9A66",
+		"security_group_name": "tf-test",
+	})
+	_, err = r.Diff(nil, config, client)
+	assert.Nil(t, err)
+	assert.Len(t, server.Requests(), 2)
+}
+
+func TestUnitAliCloudInstanceDryRunCreateWithMockServer(t *testing.T) {
+	server := connectivity.NewMockServer()
+	defer server.Close()
+	server.Register("Ecs", "RunInstances", connectivity.MockResponse{
+		StatusCode: http.StatusBadRequest,
+		Body:       map[string]interface{}{"Code": "DryRunOperation", "Message": "Request validation has been passed with DryRun flag set."},
+	})
+	client, err := server.ClientWithConfig("cn-hangzhou", func(config *connectivity.Config) {
+		config.PlanDryRun = true
+	})
+	assert.Nil(t, err)
+
+	r := resourceAliCloudInstance()
+	raw := map[string]interface{}{
+		"image_id":               "ubuntu_18_04_64_20G_alibase_20190624.vhd",
+		"instance_type":          "ecs.g6.large",
+		"security_groups":        []interface{}{"sg-mock"},
+		"vswitch_id":             "vsw-mock",
+		"kms_encrypted_password": "mock-ciphertext",
+		"network_interfaces": []interface{}{
+			map[string]interface{}{"vswitch_id": "vsw-mock", "network_card_index": 1},
+		},
+	}
+	// The KMS password and the network card index are not looked up at plan time
+	_, err = r.Diff(nil, terraform.NewResourceConfigRaw(raw), client)
+	assert.Nil(t, err)
+	requests := server.Requests()
+	assert.Len(t, requests, 1)
+	assert.Contains(t, requests[0].Body, "DryRun=true")
+	assert.NotContains(t, requests[0].Body, "Password")
+
+	// The dry run is skipped when the key pair or a data disk snapshot is created in the same apply
+	raw["key_name"] = "74D93920-ED26-11E3-AC10-0800200C9A66"
+	_, err = r.Diff(nil, terraform.NewResourceConfigRaw(raw), client)
+	assert.Nil(t, err)
+	delete(raw, "key_name")
+	raw["data_disks"] = []interface{}{
+		map[string]interface{}{"size": 20, "snapshot_id": "74D93920-ED26-11E3-AC10-0800200C9A66"},
+	}
+	_, err = r.Diff(nil, terraform.NewResourceConfigRaw(raw), client)
+	assert.Nil(t, err)
+	assert.Len(t, server.Requests(), 1)
+}
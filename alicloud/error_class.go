@@ -0,0 +1,310 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/denverdino/aliyungo/common"
+)
+
+// ErrorClass is the normalized class of an error returned by the OpenAPI, the product SDKs or the provider itself.
+// Unlike IsExpectedErrors and NotFoundError, the classification matches the whole error code or its dot separated
+// segments instead of any part of the code or message, so that the errors like InvalidParameter are not taken as
+// NotFound because their messages mention a missing resource.
+type ErrorClass string
+
+const (
+	ErrorClassUnknown   = ErrorClass("")
+	ErrorClassNotFound  = ErrorClass("NotFound")
+	ErrorClassThrottled = ErrorClass("Throttled")
+	ErrorClassConflict  = ErrorClass("Conflict")
+	ErrorClassTransient = ErrorClass("Transient")
+	ErrorClassAuth      = ErrorClass("Auth")
+	ErrorClassQuota     = ErrorClass("Quota")
+)
+
+// commonErrorClasses are the error codes shared by the products, which are not classified by their segments.
+var commonErrorClasses = map[string]ErrorClass{
+	ServiceUnavailable:          ErrorClassTransient,
+	"InternalError":             ErrorClassTransient,
+	"UnknownError":              ErrorClassTransient,
+	"ServiceBusy":               ErrorClassTransient,
+	"IncompleteSignature":       ErrorClassAuth,
+	"InvalidOperation.Conflict": ErrorClassConflict,
+	"LastTokenProcessing":       ErrorClassConflict,
+	"IdempotenceProcessing":     ErrorClassConflict,
+}
+
+// productErrorClasses are the error codes of each product, keyed by the lowercase product code. They take precedence
+// over the commonErrorClasses when the product is known.
+var productErrorClasses = map[string]map[string]ErrorClass{
+	"ecs": {
+		"IncorrectInstanceStatus.Initializing": ErrorClassConflict,
+		"InvalidOperation.InvalidEcsState":     ErrorClassConflict,
+		"InvalidOperation.InvalidEniState":     ErrorClassConflict,
+		"DependencyViolation":                  ErrorClassConflict,
+		"QuotaExceed.ElasticQuota":             ErrorClassQuota,
+		"QuotaExceed.PortableCloudDisk":        ErrorClassQuota,
+	},
+	"vpc": {
+		"OperationFailed.LastTokenProcessing": ErrorClassConflict,
+		"SystemBusy":                          ErrorClassTransient,
+		"QuotaExceeded.Vpc":                   ErrorClassQuota,
+	},
+	"slb": {
+		"SystemBusy":                ErrorClassTransient,
+		"OperationBusy":             ErrorClassConflict,
+		"ServiceIsStopping":         ErrorClassConflict,
+		"ServiceIsConfiguring":      ErrorClassConflict,
+		"BackendServer.configuring": ErrorClassConflict,
+	},
+	"rds": {
+		"OperationDenied.DBInstanceStatus": ErrorClassConflict,
+		"OperationDenied.DBStatus":         ErrorClassConflict,
+		"IncorrectDBInstanceState":         ErrorClassConflict,
+		"ConcurrentTaskExceeded":           ErrorClassConflict,
+	},
+	"r-kvstore": {
+		"IncorrectDBInstanceState": ErrorClassConflict,
+		"Task.Conflict":            ErrorClassConflict,
+	},
+	"ess": {
+		"ScalingActivityInProgress": ErrorClassConflict,
+	},
+	"oss": {
+		"BucketNotEmpty":   ErrorClassConflict,
+		"OperationAborted": ErrorClassConflict,
+		"AccessDenied":     ErrorClassAuth,
+	},
+	"sls": {
+		"ProjectAlreadyExist":  ErrorClassConflict,
+		"LogStoreAlreadyExist": ErrorClassConflict,
+		"ExceedQuota":          ErrorClassQuota,
+		"ServerBusy":           ErrorClassTransient,
+		"InternalServerError":  ErrorClassTransient,
+	},
+	"ram": ramErrorClasses,
+	"ims": ramErrorClasses,
+}
+
+var ramErrorClasses = map[string]ErrorClass{
+	"EntityAlreadyExists.Role":   ErrorClassConflict,
+	"EntityAlreadyExists.User":   ErrorClassConflict,
+	"DeleteConflict.Role.Policy": ErrorClassConflict,
+	"DeleteConflict.User.Policy": ErrorClassConflict,
+}
+
+// sharedProductErrorClasses are the codes of productErrorClasses which all of the products defining them classify in
+// the same way, and they are used when the product of the error is unknown.
+var sharedProductErrorClasses = func() map[string]ErrorClass {
+	shared := make(map[string]ErrorClass)
+	ambiguous := make(map[string]bool)
+	for _, classes := range productErrorClasses {
+		for code, class := range classes {
+			if existing, ok := shared[code]; ok && existing != class {
+				ambiguous[code] = true
+			}
+			shared[code] = class
+		}
+	}
+	for code := range ambiguous {
+		delete(shared, code)
+	}
+	return shared
+}()
+
+var (
+	errorClassStatusRegex = regexp.MustCompile("^code: ([\\d]{3})")
+	errorClassPostRegex   = regexp.MustCompile("^Post [\"]*https://.*")
+	errorRequestIdRegex   = regexp.MustCompile("request id: ([\\w-]+)")
+)
+
+// errorDetail is the part of an error which is classified and diagnosed.
+type errorDetail struct {
+	code       string
+	message    string
+	statusCode int
+	requestId  string
+	recommend  string
+	authAction string
+}
+
+// ClassifyError returns the class of the error. The product is the product code passed to the AliyunClient, like Ecs,
+// and it can be empty when it is unknown. The error code is looked up in the table of the product, the common table and
+// the shared product table in order, then its dot separated segments are matched, like EntityNotExist.Role, and the
+// HTTP status code is the last resort. The class of a ComplexError is the one recorded by WrapError and WrapErrorf.
+func ClassifyError(product string, err error) ErrorClass {
+	if err == nil {
+		return ErrorClassUnknown
+	}
+	if e, ok := err.(*ComplexError); ok {
+		if e.Err != nil && strings.HasPrefix(e.Err.Error(), ResourceNotfound) {
+			return ErrorClassNotFound
+		}
+		if e.Class != ErrorClassUnknown && product == "" {
+			return e.Class
+		}
+		return ClassifyError(product, e.Cause)
+	}
+	detail, ok := errorDetailOf(err)
+	if !ok {
+		message := err.Error()
+		if strings.Contains(message, LogClientTimeout) || errorClassPostRegex.MatchString(message) {
+			return ErrorClassTransient
+		}
+		return ErrorClassUnknown
+	}
+	// The services which have been opened return InternalError with the status code 500, and they are not retried
+	if strings.Contains(detail.message, "code: 500, 您已开通过") {
+		return ErrorClassConflict
+	}
+	if class := classifyErrorCode(product, detail.code); class != ErrorClassUnknown {
+		return class
+	}
+	if class := classifyErrorMessage(detail.message); class != ErrorClassUnknown {
+		return class
+	}
+	return classifyErrorStatus(detail.statusCode)
+}
+
+// IsNotFoundError reports whether the error means the resource does not exist.
+func IsNotFoundError(err error) bool {
+	return ClassifyError("", err) == ErrorClassNotFound
+}
+
+// IsRetryableError reports whether the request of the product should be retried, that is the error is throttled or
+// transient, or it is one of the extra retryable codes of the provider retry policy.
+func IsRetryableError(product string, err error) bool {
+	if err == nil {
+		return false
+	}
+	class := ClassifyError(product, err)
+	return class == ErrorClassThrottled || class == ErrorClassTransient
+}
+
+func classifyErrorCode(product, code string) ErrorClass {
+	if code == "" {
+		return ErrorClassUnknown
+	}
+	if class, ok := productErrorClasses[strings.ToLower(product)][code]; ok {
+		return class
+	}
+	if class, ok := commonErrorClasses[code]; ok {
+		return class
+	}
+	if class, ok := sharedProductErrorClasses[code]; ok {
+		return class
+	}
+	segments := strings.Split(code, ".")
+	for _, segment := range segments {
+		switch segment {
+		case "NoPermission", "AccessDenied", "RAM", "Unauthorized", "NotAuthorized", "InvalidAccessKeyId", "InvalidSecurityToken", "SignatureDoesNotMatch":
+			return ErrorClassAuth
+		}
+	}
+	// The codes like InvalidVSwitchId.NotFound name a request parameter, which may refer to another resource than the
+	// one operated, so they are left to the NotFound codes mapped by the services and the HTTP status code.
+	for i, segment := range segments {
+		if i > 0 && strings.HasPrefix(segments[i-1], "Invalid") {
+			continue
+		}
+		if strings.HasSuffix(segment, "NotFound") || strings.HasSuffix(segment, "Notfound") || strings.HasSuffix(segment, "NotExist") ||
+			strings.HasSuffix(segment, "NotExists") || strings.HasPrefix(segment, "NoSuch") {
+			return ErrorClassNotFound
+		}
+	}
+	for _, segment := range segments {
+		switch {
+		case strings.Contains(segment, "Throttling"):
+			return ErrorClassThrottled
+		case strings.Contains(segment, "QuotaExceed"):
+			return ErrorClassQuota
+		case segment == "OperationConflict" || segment == "TaskConflict" || (strings.HasPrefix(segment, "Incorrect") && strings.HasSuffix(segment, "Status")):
+			return ErrorClassConflict
+		}
+	}
+	return ErrorClassUnknown
+}
+
+func classifyErrorMessage(message string) ErrorClass {
+	switch {
+	case strings.Contains(message, LogClientTimeout):
+		return ErrorClassTransient
+	case strings.Contains(strings.ToLower(message), MessageInstanceNotFound), strings.HasPrefix(message, "No Row found"):
+		return ErrorClassNotFound
+	}
+	return ErrorClassUnknown
+}
+
+func classifyErrorStatus(statusCode int) ErrorClass {
+	switch {
+	case statusCode == 404:
+		return ErrorClassNotFound
+	case statusCode == 409:
+		return ErrorClassConflict
+	case statusCode == 429:
+		return ErrorClassThrottled
+	case statusCode == 401 || statusCode == 403:
+		return ErrorClassAuth
+	case statusCode >= 500:
+		return ErrorClassTransient
+	}
+	return ErrorClassUnknown
+}
+
+// errorDetailOf returns the code, message, HTTP status code and RequestId of the errors of the supported SDKs.
+func errorDetailOf(err error) (errorDetail, bool) {
+	switch e := err.(type) {
+	case *tea.SDKError:
+		detail := errorDetail{code: tea.StringValue(e.Code), message: tea.StringValue(e.Message), statusCode: tea.IntValue(e.StatusCode)}
+		if detail.statusCode == 0 {
+			if match := errorClassStatusRegex.FindStringSubmatch(detail.message); len(match) > 1 {
+				detail.statusCode, _ = strconv.Atoi(match[1])
+			}
+		}
+		data := make(map[string]interface{})
+		if json.Unmarshal([]byte(tea.StringValue(e.Data)), &data) == nil {
+			detail.requestId = firstErrorDataString(data, "RequestId", "requestId")
+			detail.recommend = firstErrorDataString(data, "Recommend", "recommend")
+		}
+		if detail.requestId == "" {
+			if match := errorRequestIdRegex.FindStringSubmatch(detail.message); len(match) > 1 {
+				detail.requestId = match[1]
+			}
+		}
+		detail.authAction = firstErrorDataString(e.AccessDeniedDetail, "AuthAction")
+		return detail, true
+	case *errors.ServerError:
+		return errorDetail{code: e.ErrorCode(), message: e.Message(), statusCode: e.HttpStatus(), requestId: e.RequestId(), recommend: e.Recommend()}, true
+	case *ProviderError:
+		return errorDetail{code: e.ErrorCode(), message: e.Message()}, true
+	case *common.Error:
+		return errorDetail{code: e.Code, message: e.Message, statusCode: e.StatusCode, requestId: e.RequestId}, true
+	case *sls.Error:
+		return errorDetail{code: e.Code, message: e.Message, statusCode: int(e.HTTPCode), requestId: e.RequestID}, true
+	case oss.ServiceError:
+		return errorDetail{code: e.Code, message: e.Message, statusCode: e.StatusCode, requestId: e.RequestID}, true
+	case *fc.ServiceError:
+		return errorDetail{code: e.ErrorCode, message: e.ErrorMessage, statusCode: e.HTTPStatus, requestId: e.RequestID}, true
+	case *datahub.DatahubClientError:
+		return errorDetail{code: e.Code, message: e.Message, statusCode: e.StatusCode, requestId: e.RequestId}, true
+	}
+	return errorDetail{}, false
+}
+
+func firstErrorDataString(data map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if v, ok := data[key].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTeaSDKError(code string, statusCode int, message string) error {
+	return tea.NewSDKError(map[string]interface{}{
+		"code":    code,
+		"message": message,
+		"data":    map[string]interface{}{"statusCode": statusCode},
+	})
+}
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		product  string
+		err      error
+		expected ErrorClass
+	}{
+		{product: "Ecs", err: testTeaSDKError("InvalidInstanceId.NotFound", 404, "The specified InstanceId does not exist."), expected: ErrorClassNotFound},
+		{product: "Vpc", err: testTeaSDKError("InvalidVpcId.NotFound", 400, "code: 400, The specified VPC does not exist."), expected: ErrorClassUnknown},
+		{product: "Ram", err: testTeaSDKError("EntityNotExist.Role", 404, "The role does not exist."), expected: ErrorClassNotFound},
+		{product: "Ecs", err: testTeaSDKError("Forbidden.InstanceNotFound", 400, "The specified instance does not exist."), expected: ErrorClassNotFound},
+		{product: "Sls", err: testTeaSDKError("ProjectNotExist", 404, "The Project does not exist"), expected: ErrorClassNotFound},
+		{product: "Ecs", err: testTeaSDKError("InvalidParameter", 400, "code: 400, The parameter NotFoundPolicy is invalid."), expected: ErrorClassUnknown},
+		{product: "Ecs", err: testTeaSDKError("InvalidAccessKeyId.NotFound", 404, "Specified access key is not found."), expected: ErrorClassAuth},
+		{product: "Ecs", err: testTeaSDKError("Forbidden.RAM", 403, "User not authorized to operate on the specified resource."), expected: ErrorClassAuth},
+		{product: "Vpc", err: testTeaSDKError("Throttling.User", 400, "Request was denied due to user flow control."), expected: ErrorClassThrottled},
+		{product: "Ecs", err: testTeaSDKError("QuotaExceed.ElasticQuota", 403, "The instances exceed the quota."), expected: ErrorClassQuota},
+		{product: "Ecs", err: testTeaSDKError("IncorrectInstanceStatus", 403, "The current status of the resource does not support this operation."), expected: ErrorClassConflict},
+		{product: "Vpc", err: testTeaSDKError("OperationFailed.LastTokenProcessing", 400, "The last token request is processing."), expected: ErrorClassConflict},
+		{product: "Vpc", err: testTeaSDKError("SystemBusy", 400, "System is busy."), expected: ErrorClassTransient},
+		{product: "Slb", err: testTeaSDKError("OperationBusy", 400, "The operation is busy."), expected: ErrorClassConflict},
+		{product: "Ecs", err: testTeaSDKError("UnknownCode", 0, "code: 503, Service is unavailable."), expected: ErrorClassTransient},
+		{product: "Oss", err: oss.ServiceError{Code: "NoSuchBucket", StatusCode: 404}, expected: ErrorClassNotFound},
+		{err: GetNotFoundErrorFromString("The instance is gone."), expected: ErrorClassNotFound},
+		{err: fmt.Errorf("Post \"https://ecs.aliyuncs.com\": dial tcp: i/o timeout"), expected: ErrorClassTransient},
+		{err: fmt.Errorf("the instance NotFound"), expected: ErrorClassUnknown},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, ClassifyError(c.product, c.err), "%s %v", c.product, c.err)
+	}
+}
+
+func TestWrapErrorClass(t *testing.T) {
+	err := WrapErrorf(testTeaSDKError("InvalidVSwitchId.NotFound", 404, "The VSwitch does not exist."), DefaultErrorMsg, "vsw-mock", "DescribeVSwitchAttributes", AlibabaCloudSdkGoERROR)
+	assert.Equal(t, ErrorClassNotFound, err.(*ComplexError).Class)
+	assert.True(t, IsNotFoundError(WrapError(err)))
+
+	err = WrapErrorf(NotFoundErr("VSwitch", "vsw-mock"), NotFoundMsg, ProviderERROR)
+	assert.True(t, IsNotFoundError(err))
+
+	// The vswitch referred by a new instance does not exist, rather than the instance
+	err = WrapErrorf(testTeaSDKError("InvalidVSwitchId.NotFound", 400, "code: 400, The specified VSwitchId does not exist."), DefaultErrorMsg, "i-mock", "RunInstances", AlibabaCloudSdkGoERROR)
+	assert.False(t, IsNotFoundError(err))
+
+	err = WrapError(testTeaSDKError("InvalidParameter", 400, "code: 400, The VSwitch NotFound is invalid."))
+	assert.False(t, IsNotFoundError(err))
+	assert.True(t, NotFoundError(err))
+}
+
+func TestIsRetryableError(t *testing.T) {
+	assert.True(t, IsRetryableError("Ecs", testTeaSDKError("Throttling", 400, "Request was denied due to request throttling.")))
+	assert.True(t, IsRetryableError("Ecs", testTeaSDKError("ServiceUnavailable", 503, "The request has failed due to a temporary failure of the server.")))
+	assert.True(t, IsRetryableError("Slb", testTeaSDKError("SystemBusy", 400, "System is busy.")))
+	assert.False(t, IsRetryableError("Slb", testTeaSDKError("OperationBusy", 400, "The operation is busy.")))
+	assert.False(t, IsRetryableError("Ecs", testTeaSDKError("InternalError", 500, "code: 500, 您已开通过该服务")))
+	assert.False(t, IsRetryableError("Ecs", testTeaSDKError("IncorrectInstanceStatus", 403, "The current status of the resource does not support this operation.")))
+	assert.False(t, IsRetryableError("Ecs", nil))
+}
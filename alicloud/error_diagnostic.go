@@ -0,0 +1,93 @@
+package alicloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrorDiagnostic is the summary of a failed API call which is printed below a ComplexError, so that the error can be
+// looked up in the API troubleshooting or attached to a support ticket without reading the SDK error dump.
+type ErrorDiagnostic struct {
+	Action     string
+	Code       string
+	RequestId  string
+	Recommend  string
+	AuthAction string
+	Hint       string
+}
+
+// errorHints are the remediation hints of the common error codes.
+var errorHints = map[string]string{
+	"Forbidden.RAM":                         "The RAM user or role of the provider is not allowed to call the API. Grant the denied action to it, and the permission_report_file of the provider lists all of the actions the configuration needs.",
+	"Forbidden.NoPermission":                "The RAM user or role of the provider is not allowed to call the API. Grant the denied action to it, and the permission_report_file of the provider lists all of the actions the configuration needs.",
+	"NoPermission":                          "The RAM user or role of the provider is not allowed to call the API. Grant the denied action to it, and the permission_report_file of the provider lists all of the actions the configuration needs.",
+	"InvalidAccessKeyId.NotFound":           "The access key does not exist. Check the access_key of the provider, the ALICLOUD_ACCESS_KEY environment variable or the profile in use.",
+	"InvalidAccessKeyId.Inactive":           "The access key is disabled. Enable it or use another access key.",
+	"SignatureDoesNotMatch":                 "The secret key does not match the access key. Check the secret_key of the provider or the ALICLOUD_SECRET_KEY environment variable.",
+	"InvalidSecurityToken.Expired":          "The security token has expired. Use a longer session_expiration of the assume_role, or refresh the STS credentials.",
+	"InvalidVSwitchId.IpNotEnough":          "The vSwitch has no available IP address. Use a vSwitch with a larger CIDR block, or release the instances and network interfaces which are not used in it.",
+	"QuotaExceed.ElasticQuota":              "The vCPUs of the instance type family exceed the quota in the region. Apply for a higher quota in the ECS console or the Quota Center.",
+	"OperationDenied.NoStock":               "The requested resource is out of stock in the zone. Use another zone or instance type, and the plan_time_validation of the provider checks the stock at plan time.",
+	"InvalidInstanceType.NotSupported":      "The instance type is not available in the zone. Use another zone or instance type, and the plan_time_validation of the provider checks it at plan time.",
+	"Zone.NotOnSale":                        "The zone is not on sale for the resource. Use another zone.",
+	"InvalidAccountStatus.NotEnoughBalance": "The account balance is not enough for the resource. Top up the account, or use the PrePaid payment with a coupon.",
+	"IncorrectInstanceStatus":               "The instance is in a status which does not support the operation. Wait for the ongoing operation of the instance to finish and apply again.",
+	"Throttling.User":                       "The API is throttled. Reduce the parallelism of terraform, or configure the rate_limit and retry of the provider.",
+}
+
+// errorClassHints are the remediation hints of the error classes whose codes have no hint.
+var errorClassHints = map[ErrorClass]string{
+	ErrorClassThrottled: "The API is throttled. Reduce the parallelism of terraform, or configure the rate_limit and retry of the provider.",
+	ErrorClassQuota:     "The request exceeds a quota. Apply for a higher quota in the Quota Center.",
+	ErrorClassAuth:      "The credentials of the provider are not allowed to call the API. Check the credentials and their RAM permissions.",
+}
+
+// DiagnoseError returns the diagnostic of the error. The Action is the API name recorded by WrapErrorf, and the others
+// come from the SDK error.
+func DiagnoseError(err error) ErrorDiagnostic {
+	diagnostic := ErrorDiagnostic{}
+	for err != nil {
+		e, ok := err.(*ComplexError)
+		if !ok {
+			break
+		}
+		if e.Action != "" {
+			diagnostic.Action = e.Action
+		}
+		err = e.Cause
+	}
+	detail, ok := errorDetailOf(err)
+	if !ok {
+		return diagnostic
+	}
+	diagnostic.Code = detail.code
+	diagnostic.RequestId = detail.requestId
+	diagnostic.Recommend = detail.recommend
+	diagnostic.AuthAction = detail.authAction
+	diagnostic.Hint = errorHints[detail.code]
+	if diagnostic.Hint == "" {
+		diagnostic.Hint = errorClassHints[ClassifyError("", err)]
+	}
+	return diagnostic
+}
+
+// String returns the diagnostic lines, and it is empty when there is nothing but the Action.
+func (d ErrorDiagnostic) String() string {
+	if d.Code == "" && d.RequestId == "" && d.Recommend == "" {
+		return ""
+	}
+	var lines []string
+	for _, field := range [][2]string{
+		{"Action", d.Action},
+		{"Code", d.Code},
+		{"RequestId", d.RequestId},
+		{"Recommend", d.Recommend},
+		{"Denied Action", d.AuthAction},
+		{"Hint", d.Hint},
+	} {
+		if field[1] != "" {
+			lines = append(lines, fmt.Sprintf("  %s: %s", field[0], field[1]))
+		}
+	}
+	return "Diagnostics:\n" + strings.Join(lines, "\n")
+}
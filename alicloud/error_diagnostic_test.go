@@ -0,0 +1,54 @@
+package alicloud
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnoseError(t *testing.T) {
+	cause := tea.NewSDKError(map[string]interface{}{
+		"code":    "InvalidVSwitchId.IpNotEnough",
+		"message": "code: 400, The specified VSwitch has not enough IpAddress. request id: 1A2B3C4D-MOCK",
+		"data": map[string]interface{}{
+			"statusCode": 400,
+			"RequestId":  "1A2B3C4D-MOCK",
+			"Recommend":  "https://api.aliyun.com/troubleshoot?q=InvalidVSwitchId.IpNotEnough&product=Ecs&requestId=1A2B3C4D-MOCK",
+		},
+	})
+	err := WrapError(WrapErrorf(cause, DefaultErrorMsg, "alicloud_instance", "RunInstances", AlibabaCloudSdkGoERROR))
+
+	diagnostic := DiagnoseError(err)
+	assert.Equal(t, "RunInstances", diagnostic.Action)
+	assert.Equal(t, "InvalidVSwitchId.IpNotEnough", diagnostic.Code)
+	assert.Equal(t, "1A2B3C4D-MOCK", diagnostic.RequestId)
+	assert.Equal(t, "https://api.aliyun.com/troubleshoot?q=InvalidVSwitchId.IpNotEnough&product=Ecs&requestId=1A2B3C4D-MOCK", diagnostic.Recommend)
+	assert.Equal(t, errorHints["InvalidVSwitchId.IpNotEnough"], diagnostic.Hint)
+
+	message := err.Error()
+	assert.Equal(t, 1, strings.Count(message, "Diagnostics:"))
+	assert.True(t, strings.HasSuffix(message, "  Hint: "+errorHints["InvalidVSwitchId.IpNotEnough"]))
+	assert.Contains(t, message, "  Action: RunInstances\n  Code: InvalidVSwitchId.IpNotEnough\n  RequestId: 1A2B3C4D-MOCK\n")
+}
+
+func TestDiagnoseErrorAccessDenied(t *testing.T) {
+	cause := tea.NewSDKError(map[string]interface{}{
+		"code":               "Forbidden.RAM",
+		"message":            "code: 403, User not authorized to operate on the specified resource. request id: 5E6F-MOCK",
+		"data":               map[string]interface{}{"statusCode": 403},
+		"accessDeniedDetail": map[string]interface{}{"AuthAction": "vpc:CreateVSwitch"},
+	})
+	diagnostic := DiagnoseError(WrapErrorf(cause, DefaultErrorMsg, "alicloud_vswitch", "CreateVSwitch", AlibabaCloudSdkGoERROR))
+	assert.Equal(t, "5E6F-MOCK", diagnostic.RequestId)
+	assert.Equal(t, "vpc:CreateVSwitch", diagnostic.AuthAction)
+	assert.Equal(t, errorHints["Forbidden.RAM"], diagnostic.Hint)
+
+	diagnostic = DiagnoseError(WrapError(tea.NewSDKError(map[string]interface{}{"code": "Throttling.Api", "message": "code: 400, Request was denied due to api flow control."})))
+	assert.Equal(t, errorClassHints[ErrorClassThrottled], diagnostic.Hint)
+
+	err := WrapErrorf(Error("the instance is not ready"), DefaultErrorMsg, "i-mock", "DescribeInstances", ProviderERROR)
+	assert.Equal(t, "", DiagnoseError(err).String())
+	assert.NotContains(t, err.Error(), "Diagnostics:")
+}
@@ -334,21 +334,39 @@ const (
 // Err: a new error is built from extra message
 // Path: the file path of error occurred
 // Line: the file line of error occurred
+// Class: the class of the Cause, which is recorded when it is wrapped
+// Action: the API name of the Err built from DefaultErrorMsg and the like
 type ComplexError struct {
-	Cause error
-	Err   error
-	Path  string
-	Line  int
+	Cause  error
+	Err    error
+	Path   string
+	Line   int
+	Class  ErrorClass
+	Action string
 }
 
+// Error returns the error message followed by the Diagnostics of the failed API call, which are printed once at the
+// end even if the error is wrapped several times.
 func (e ComplexError) Error() string {
+	message := e.message()
+	if diagnostic := DiagnoseError(&e).String(); diagnostic != "" {
+		return message + "\n" + diagnostic
+	}
+	return message
+}
+
+func (e ComplexError) message() string {
 	if e.Cause == nil {
 		e.Cause = Error("<nil cause>")
 	}
+	cause := e.Cause.Error()
+	if c, ok := e.Cause.(*ComplexError); ok {
+		cause = c.message()
+	}
 	if e.Err == nil {
-		return fmt.Sprintf("\u001B[31m[ERROR]\u001B[0m %s:%d:\n%s", e.Path, e.Line, e.Cause.Error())
+		return fmt.Sprintf("\u001B[31m[ERROR]\u001B[0m %s:%d:\n%s", e.Path, e.Line, cause)
 	}
-	return fmt.Sprintf("\u001B[31m[ERROR]\u001B[0m %s:%d: %s:\n%s", e.Path, e.Line, e.Err.Error(), e.Cause.Error())
+	return fmt.Sprintf("\u001B[31m[ERROR]\u001B[0m %s:%d: %s:\n%s", e.Path, e.Line, e.Err.Error(), cause)
 }
 
 func Error(format string, args ...interface{}) error {
@@ -394,16 +412,25 @@ func WrapErrorf(cause error, msg string, args ...interface{}) error {
 	if msg == NotFoundMsg && len(args) == 2 {
 		msg += RequestIdMsg
 	}
-	return WrapComplexError(cause, fmt.Errorf(msg, args...), filepath, line)
+	complexError := WrapComplexError(cause, fmt.Errorf(msg, args...), filepath, line)
+	switch msg {
+	case DefaultErrorMsg, ResponseCodeMsg, DataDefaultErrorMsg:
+		if len(args) > 1 {
+			complexError.(*ComplexError).Action = fmt.Sprint(args[1])
+		}
+	}
+	return complexError
 }
 
 func WrapComplexError(cause, err error, filepath string, fileline int) error {
-	return &ComplexError{
+	complexError := &ComplexError{
 		Cause: cause,
 		Err:   err,
 		Path:  filepath,
 		Line:  fileline,
 	}
+	complexError.Class = ClassifyError("", complexError)
+	return complexError
 }
 
 // A default message of ComplexError's Err. It is format to Resource <resource-id> <operation> Failed!!! <error source>
@@ -0,0 +1,172 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// prepaidInstanceCountPerPurchaseQuota is the Quota Center quota of how many subscription instances can be purchased at once.
+const prepaidInstanceCountPerPurchaseQuota = "ecs:q_prepaid-instance-count-per-once-purchase"
+
+// planTimeInstanceRequest is the ECS capacity requested by a resource. When the provider plan_time_validation is enabled,
+// it is checked in the CustomizeDiff, so that the plan fails instead of the apply failing with QuotaExceeded or
+// InvalidInstanceType.NotSupported long after it started.
+type planTimeInstanceRequest struct {
+	instanceTypes      []string
+	zoneIds            []string
+	vswitchIds         []string
+	instanceChargeType string
+	spotStrategy       string
+	systemDiskCategory string
+	// count is the number of the instances created at once, and 0 means the count is not checked
+	count int
+}
+
+// planTimeValidationNeeded reports whether the plan_time_validation is enabled and the resource is created or any of
+// the keys is changed, so that the unchanged resources do not call the APIs on each plan.
+func planTimeValidationNeeded(diff *schema.ResourceDiff, meta interface{}, keys ...string) bool {
+	client, ok := meta.(*connectivity.AliyunClient)
+	if !ok || client == nil || !client.PlanTimeValidation() {
+		return false
+	}
+	if diff.Id() == "" {
+		return true
+	}
+	for _, key := range keys {
+		if diff.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// planTimeString returns the planned value of the key, and it is empty when the value is not known until apply.
+func planTimeString(diff *schema.ResourceDiff, key string) string {
+	if !diff.NewValueKnown(key) {
+		return ""
+	}
+	v, _ := diff.Get(key).(string)
+	return strings.TrimSpace(v)
+}
+
+// planTimeStringList returns the planned values of the key, and it is nil when any of them is not known until apply.
+func planTimeStringList(diff *schema.ResourceDiff, key string) []string {
+	if !diff.NewValueKnown(key) {
+		return nil
+	}
+	v, ok := diff.Get(key).([]interface{})
+	if !ok {
+		return nil
+	}
+	values := make([]string, 0, len(v))
+	for _, item := range v {
+		value, _ := item.(string)
+		if value == "" {
+			return nil
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+func validatePlanTimeInstanceRequest(client *connectivity.AliyunClient, request *planTimeInstanceRequest) error {
+	if len(request.instanceTypes) == 0 {
+		return nil
+	}
+	zoneIds := request.zoneIds
+	vpcService := VpcService{client}
+	for _, vswitchId := range request.vswitchIds {
+		vsw, err := vpcService.DescribeVSwitch(vswitchId)
+		if err != nil {
+			return WrapError(err)
+		}
+		zoneIds = append(zoneIds, vsw.ZoneId)
+	}
+
+	ecsService := EcsService{client}
+	describeRequest := ecs.CreateDescribeAvailableResourceRequest()
+	describeRequest.RegionId = client.RegionId
+	describeRequest.DestinationResource = string(InstanceTypeResource)
+	describeRequest.IoOptimized = string(IOOptimized)
+	describeRequest.InstanceChargeType = request.instanceChargeType
+	describeRequest.SpotStrategy = request.spotStrategy
+	describeRequest.SystemDiskCategory = request.systemDiskCategory
+	response, err := ecsService.describeAvailableResource(describeRequest, strings.Join(request.instanceTypes, ","))
+	if err != nil {
+		return err
+	}
+	if err := ecsService.validatePlanTimeInstanceTypes(request.instanceTypes, zoneIds, response.AvailableZones.AvailableZone); err != nil {
+		return err
+	}
+
+	// The vCPU quota of the instance family, which fails the pay-as-you-go instances with QuotaExceed.ElasticQuota, is
+	// not checked, because it is shared by all of the instances of the family in the region and the used vCPUs change
+	// with the instances created out of band, so that a check at plan time could not tell whether the apply fails.
+	if request.count <= 0 || request.instanceChargeType != string(PrePaid) {
+		return nil
+	}
+	quotasService := QuotasServiceV2{client}
+	quota, err := quotasService.DescribeQuotasQuota(prepaidInstanceCountPerPurchaseQuota, map[string]string{"regionId": client.RegionId})
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	return checkPlanTimeQuota(quota, request.count, client.RegionId)
+}
+
+// validatePlanTimeInstanceTypes checks that each of the instance types is available in at least one of the zones, or in
+// the region when there is no zone.
+func (s *EcsService) validatePlanTimeInstanceTypes(instanceTypes, zoneIds []string, zones []ecs.AvailableZone) error {
+	var availableZones []ecs.AvailableZone
+	for _, zone := range zones {
+		if zone.Status == string(SoldOut) {
+			continue
+		}
+		availableZones = append(availableZones, zone)
+	}
+	for _, instanceType := range instanceTypes {
+		if len(zoneIds) == 0 {
+			if err := s.InstanceTypeValidation(instanceType, "", availableZones); err != nil {
+				return err
+			}
+			continue
+		}
+		var err error
+		for _, zoneId := range zoneIds {
+			if err = s.InstanceTypeValidation(instanceType, zoneId, availableZones); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPlanTimeQuota checks that the count fits the quota described by the QuotasServiceV2. The usage is added to the
+// count when the quota is consumable.
+func checkPlanTimeQuota(quota map[string]interface{}, count int, regionId string) error {
+	total, err := strconv.ParseFloat(fmt.Sprint(quota["TotalQuota"]), 64)
+	if err != nil {
+		return nil
+	}
+	requested := float64(count)
+	if consumable, _ := quota["Consumable"].(bool); consumable {
+		if usage, err := strconv.ParseFloat(fmt.Sprint(quota["TotalUsage"]), 64); err == nil {
+			requested += usage
+		}
+	}
+	if requested > total {
+		return WrapError(Error("The %d instances requested exceed the quota %s %v of %s in the region %s. Please apply for a higher quota in the Quota Center.",
+			count, quota["QuotaActionCode"], quota["TotalQuota"], quota["ProductCode"], regionId))
+	}
+	return nil
+}
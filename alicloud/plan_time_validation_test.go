@@ -0,0 +1,71 @@
+package alicloud
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func testPlanTimeAvailableZone(zoneId, status string, instanceTypes map[string]string) ecs.AvailableZone {
+	zone := ecs.AvailableZone{ZoneId: zoneId, Status: status}
+	resource := ecs.AvailableResource{Type: string(InstanceTypeResource)}
+	for instanceType, instanceTypeStatus := range instanceTypes {
+		resource.SupportedResources.SupportedResource = append(resource.SupportedResources.SupportedResource, ecs.SupportedResource{Value: instanceType, Status: instanceTypeStatus})
+	}
+	zone.AvailableResources.AvailableResource = []ecs.AvailableResource{resource}
+	return zone
+}
+
+func TestValidatePlanTimeInstanceTypes(t *testing.T) {
+	ecsService := EcsService{&connectivity.AliyunClient{RegionId: "cn-hangzhou"}}
+	zones := []ecs.AvailableZone{
+		testPlanTimeAvailableZone("cn-hangzhou-h", "Available", map[string]string{"ecs.g7.large": "Available", "ecs.g6.large": "SoldOut"}),
+		testPlanTimeAvailableZone("cn-hangzhou-i", "Available", map[string]string{"ecs.g6.large": "Available"}),
+		testPlanTimeAvailableZone("cn-hangzhou-j", "SoldOut", map[string]string{"ecs.c7.large": "Available"}),
+	}
+	cases := []struct {
+		instanceTypes []string
+		zoneIds       []string
+		expected      string
+	}{
+		{instanceTypes: []string{"ecs.g7.large"}, zoneIds: []string{"cn-hangzhou-h"}},
+		{instanceTypes: []string{"ecs.g6.large"}, zoneIds: []string{"cn-hangzhou-h"}, expected: "The instance type ecs.g6.large is solded out or is not supported in the zone cn-hangzhou-h"},
+		{instanceTypes: []string{"ecs.g6.large", "ecs.g7.large"}, zoneIds: []string{"cn-hangzhou-h", "cn-hangzhou-i"}},
+		{instanceTypes: []string{"ecs.c7.large"}, zoneIds: []string{"cn-hangzhou-j"}, expected: "is not supported in the zone cn-hangzhou-j"},
+		{instanceTypes: []string{"ecs.g6.large"}},
+		{instanceTypes: []string{"ecs.c7.large"}, expected: "is not supported in the region cn-hangzhou"},
+	}
+	for _, c := range cases {
+		err := ecsService.validatePlanTimeInstanceTypes(c.instanceTypes, c.zoneIds, zones)
+		if c.expected == "" && err != nil {
+			t.Fatalf("validating %v in %v got an unexpected error: %v", c.instanceTypes, c.zoneIds, err)
+		}
+		if c.expected != "" && (err == nil || !strings.Contains(err.Error(), c.expected)) {
+			t.Fatalf("validating %v in %v expected the error %q, got %v", c.instanceTypes, c.zoneIds, c.expected, err)
+		}
+	}
+}
+
+func TestCheckPlanTimeQuota(t *testing.T) {
+	quota := map[string]interface{}{
+		"ProductCode":     "ecs",
+		"QuotaActionCode": "q_prepaid-instance-count-per-once-purchase",
+		"TotalQuota":      float64(100),
+		"TotalUsage":      float64(0),
+		"Consumable":      false,
+	}
+	if err := checkPlanTimeQuota(quota, 100, "cn-hangzhou"); err != nil {
+		t.Fatalf("checking 100 instances got an unexpected error: %v", err)
+	}
+	if err := checkPlanTimeQuota(quota, 101, "cn-hangzhou"); err == nil || !strings.Contains(err.Error(), "exceed the quota q_prepaid-instance-count-per-once-purchase 100") {
+		t.Fatalf("checking 101 instances expected the quota error, got %v", err)
+	}
+
+	quota["Consumable"] = true
+	quota["TotalUsage"] = float64(95)
+	if err := checkPlanTimeQuota(quota, 6, "cn-hangzhou"); err == nil {
+		t.Fatalf("checking 6 instances on top of the usage 95 expected the quota error")
+	}
+}
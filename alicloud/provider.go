@@ -13,8 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
-
-	"github.com/aliyun/credentials-go/credentials/providers"
+	"time"
 
 	"github.com/aliyun/credentials-go/credentials"
 
@@ -99,6 +98,12 @@ func Provider() terraform.ResourceProvider {
 				Deprecated: "Field 'fc' has been deprecated from provider version 1.28.0. New field 'fc' which in nested endpoints instead.",
 			},
 			"endpoints": endpointsSchema(),
+			"endpoints_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["endpoints_file"],
+				DefaultFunc: schema.EnvDefaultFunc("ALIBABA_CLOUD_ENDPOINTS_FILE", nil),
+			},
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -167,12 +172,42 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ALICLOUD_CREDENTIALS_URI", "ALIBABA_CLOUD_CREDENTIALS_URI"}, nil),
 				Description: descriptions["credentials_uri"],
 			},
+			"credential_process": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["credential_process"],
+			},
 			"max_retry_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRY_TIMEOUT", 0),
 				Description: descriptions["max_retry_timeout"],
 			},
+			"plan_time_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ALICLOUD_PLAN_TIME_VALIDATION", "ALIBABA_CLOUD_PLAN_TIME_VALIDATION"}, false),
+				Description: descriptions["plan_time_validation"],
+			},
+			"plan_dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ALICLOUD_PLAN_DRY_RUN", "ALIBABA_CLOUD_PLAN_DRY_RUN"}, false),
+				Description: descriptions["plan_dry_run"],
+			},
+			"permission_report_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ALICLOUD_PERMISSION_REPORT_FILE", "ALIBABA_CLOUD_PERMISSION_REPORT_FILE"}, nil),
+				Description: descriptions["permission_report_file"],
+			},
+			"default_tags":   defaultTagsSchema(),
+			"ignore_tags":    ignoreTagsSchema(),
+			"retry":          retrySchema(),
+			"rate_limit":     rateLimitSchema(),
+			"endpoint_cache": endpointCacheSchema(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"alicloud_sls_alerts":                          dataSourceAliCloudSlsAlerts(),
@@ -199,6 +234,8 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_vpn_gateway_zones":                   dataSourceAliCloudVPNGatewayZones(),
 			"alicloud_account":                             dataSourceAlicloudAccount(),
 			"alicloud_caller_identity":                     dataSourceAlicloudCallerIdentity(),
+			"alicloud_arn":                                 dataSourceAlicloudArn(),
+			"alicloud_resource_id":                         dataSourceAlicloudResourceId(),
 			"alicloud_images":                              dataSourceAlicloudImages(),
 			"alicloud_regions":                             dataSourceAlicloudRegions(),
 			"alicloud_zones":                               dataSourceAlicloudZones(),
@@ -1104,6 +1141,9 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_hbr_policy_binding":                                   resourceAliCloudHbrPolicyBinding(),
 			"alicloud_hbr_policy":                                           resourceAliCloudHbrPolicy(),
 			"alicloud_oss_bucket_acl":                                       resourceAliCloudOssBucketAcl(),
+			"alicloud_oss_bucket_lifecycle":                                 resourceAliCloudOssBucketLifecycle(),
+			"alicloud_oss_bucket_inventory":                                 resourceAliCloudOssBucketInventory(),
+			"alicloud_oss_bucket_object_lock_configuration":                 resourceAliCloudOssBucketObjectLockConfiguration(),
 			"alicloud_wafv3_defense_template":                               resourceAliCloudWafv3DefenseTemplate(),
 			"alicloud_dfs_vsc_mount_point":                                  resourceAliCloudDfsVscMountPoint(),
 			"alicloud_vpc_ipv6_address":                                     resourceAliCloudVpcIpv6Address(),
@@ -1229,6 +1269,7 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_ess_scalinggroup_vserver_groups":                      resourceAlicloudEssScalingGroupVserverGroups(),
 			"alicloud_ess_alb_server_group_attachment":                      resourceAlicloudEssAlbServerGroupAttachment(),
 			"alicloud_ess_server_group_attachment":                          resourceAliCloudEssServerGroupAttachment(),
+			"alicloud_ess_instance_refresh":                                 resourceAliCloudEssInstanceRefresh(),
 			"alicloud_vpc":                                                  resourceAliCloudVpcVpc(),
 			"alicloud_nat_gateway":                                          resourceAliCloudNatGateway(),
 			"alicloud_nas_file_system":                                      resourceAliCloudNasFileSystem(),
@@ -1941,6 +1982,10 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_cloud_monitor_service_group_monitoring_agent_process":  resourceAliCloudCloudMonitorServiceGroupMonitoringAgentProcess(),
 		},
 	}
+	for _, r := range provider.ResourcesMap {
+		resourceWithIgnoreTags(r)
+		resourceWithDefaultTags(r)
+	}
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		return providerConfigure(d, provider)
 	}
@@ -1978,13 +2023,13 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		profileName = v.(string)
 	}
 
-	// TODO: supports all of profile modes after credentials supporting setting timeout
-	if (accessKey == "" || secretKey == "") && profileName != "" && fmt.Sprint(providerConfig["mode"]) == "ChainableRamRoleArn" {
+	// The profile modes like CloudSSO and External load the credentials by the credentials provider, and refresh them before they expire
+	if (accessKey == "" || secretKey == "") && profileName != "" && connectivity.ProfileModesWithCredentialsProvider[fmt.Sprint(providerConfig["mode"])] {
 		var profileFile string
 		if v, ok := d.GetOk("shared_credentials_file"); ok && v.(string) != "" {
 			profileFile = absPath(v.(string))
 		}
-		provider, err := providers.NewCLIProfileCredentialsProviderBuilder().WithProfileName(profileName).WithProfileFile(profileFile).Build()
+		provider, err := connectivity.NewProfileCredentialsProvider(profileFile, profileName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create profile credentials provider: %v", err)
 		}
@@ -2023,6 +2068,9 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		SourceIp:             strings.TrimSpace(d.Get("source_ip").(string)),
 		SecureTransport:      strings.TrimSpace(d.Get("secure_transport").(string)),
 		MaxRetryTimeout:      d.Get("max_retry_timeout").(int),
+		PlanTimeValidation:   d.Get("plan_time_validation").(bool),
+		PlanDryRun:           d.Get("plan_dry_run").(bool),
+		PermissionReportFile: strings.TrimSpace(d.Get("permission_report_file").(string)),
 		TerraformTraceId:     strings.Trim(uuid.New().String(), "-"),
 		TerraformVersion:     p.TerraformVersion,
 	}
@@ -2051,6 +2099,9 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		}
 		config.Credential = credential
 	}
+	if v, ok := d.GetOk("credential_process"); ok && (accessKey == "" || secretKey == "") {
+		config.CredentialProcess = expandStringList(v.([]interface{}))
+	}
 	if account, ok := d.GetOk("account_id"); ok && account.(string) != "" {
 		config.AccountId = strings.TrimSpace(account.(string))
 	}
@@ -2060,6 +2111,63 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 	if v, ok := d.GetOk("security_transport"); config.SecureTransport == "" && ok && v.(string) != "" {
 		config.SecureTransport = v.(string)
 	}
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) == 1 && v.([]interface{})[0] != nil {
+		config.DefaultTags = make(map[string]string)
+		for key, value := range v.([]interface{})[0].(map[string]interface{})["tags"].(map[string]interface{}) {
+			config.DefaultTags[key] = value.(string)
+		}
+	}
+	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) == 1 && v.([]interface{})[0] != nil {
+		ignoreTags := v.([]interface{})[0].(map[string]interface{})
+		config.IgnoreTagKeys = expandStringList(ignoreTags["keys"].(*schema.Set).List())
+		config.IgnoreTagKeyPrefixes = expandStringList(ignoreTags["key_prefixes"].(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) == 1 && v.([]interface{})[0] != nil {
+		retry := v.([]interface{})[0].(map[string]interface{})
+		config.Retry = &connectivity.RetryPolicy{
+			MaxAttempts:         retry["max_attempts"].(int),
+			BaseDelay:           time.Duration(retry["base_delay"].(int)) * time.Millisecond,
+			MaxDelay:            time.Duration(retry["max_delay"].(int)) * time.Millisecond,
+			Jitter:              retry["jitter"].(bool),
+			ExtraRetryableCodes: expandStringList(retry["extra_retryable_codes"].(*schema.Set).List()),
+		}
+	}
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]interface{})) == 1 && v.([]interface{})[0] != nil {
+		rateLimit := v.([]interface{})[0].(map[string]interface{})
+		config.RateLimit = &connectivity.RateLimitPolicy{
+			RequestsPerSecond: rateLimit["requests_per_second"].(float64),
+			Burst:             rateLimit["burst"].(int),
+			ProductRates:      make(map[string]float64),
+			ApiRates:          make(map[string]float64),
+			Adaptive:          rateLimit["adaptive"].(bool),
+		}
+		for key, value := range rateLimit["product_rates"].(map[string]interface{}) {
+			config.RateLimit.ProductRates[key] = value.(float64)
+		}
+		for key, value := range rateLimit["api_rates"].(map[string]interface{}) {
+			config.RateLimit.ApiRates[key] = value.(float64)
+		}
+	}
+	if v, ok := d.GetOk("endpoint_cache"); ok && len(v.([]interface{})) == 1 && v.([]interface{})[0] != nil {
+		endpointCache := v.([]interface{})[0].(map[string]interface{})
+		path, err := homedir.Expand(endpointCache["path"].(string))
+		if err != nil {
+			return nil, err
+		}
+		config.EndpointCache = &connectivity.EndpointCachePolicy{
+			Path: path,
+			TTL:  time.Duration(endpointCache["ttl"].(int)) * time.Second,
+		}
+	}
+	// The cassette is used by the acceptance tests to record the API interactions and replay them without network
+	config.CassetteMode = strings.TrimSpace(os.Getenv("ALIBABA_CLOUD_CASSETTE_MODE"))
+	config.CassettePath = strings.TrimSpace(os.Getenv("ALIBABA_CLOUD_CASSETTE_PATH"))
+	// The API requests are traced as the OTLP JSON spans, written to a file or posted to a collector like http://localhost:4318/v1/traces
+	config.TraceFile = strings.TrimSpace(os.Getenv("ALIBABA_CLOUD_TRACE_FILE"))
+	config.TraceEndpoint = strings.TrimSpace(os.Getenv("ALIBABA_CLOUD_TRACE_ENDPOINT"))
+	if config.TraceEndpoint == "" {
+		config.TraceEndpoint = strings.TrimSpace(os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"))
+	}
 
 	config.RamRoleArn = getProviderConfig("", "ram_role_arn")
 	config.RamRoleSessionName = getProviderConfig("", "ram_session_name")
@@ -2068,37 +2176,49 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		config.RamRoleSessionExpiration = (int)(expiredSeconds.(float64))
 	}
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		if assumeRole["role_arn"].(string) != "" {
-			config.RamRoleArn = assumeRole["role_arn"].(string)
+	// The assume_role blocks are an ordered chain, and each of them is assumed by the credentials of the previous one
+	defaultSessionExpiration := config.RamRoleSessionExpiration
+	if v := os.Getenv("ALICLOUD_ASSUME_ROLE_SESSION_EXPIRATION"); v != "" {
+		if expiredSeconds, err := strconv.Atoi(v); err == nil {
+			defaultSessionExpiration = expiredSeconds
 		}
-		if assumeRole["session_name"].(string) != "" {
-			config.RamRoleSessionName = assumeRole["session_name"].(string)
+	}
+	if defaultSessionExpiration == 0 {
+		defaultSessionExpiration = 3600
+	}
+	for i, v := range d.Get("assume_role").([]interface{}) {
+		assumeRole, ok := v.(map[string]interface{})
+		if !ok || assumeRole["role_arn"].(string) == "" {
+			continue
 		}
-		if config.RamRoleSessionName == "" {
-			config.RamRoleSessionName = "terraform"
+		hop := &connectivity.AssumeRole{
+			RoleArn:           assumeRole["role_arn"].(string),
+			SessionName:       assumeRole["session_name"].(string),
+			Policy:            assumeRole["policy"].(string),
+			ExternalId:        assumeRole["external_id"].(string),
+			SessionExpiration: assumeRole["session_expiration"].(int),
 		}
-		config.RamRolePolicy = assumeRole["policy"].(string)
-		if assumeRole["session_expiration"].(int) == 0 {
-			if v := os.Getenv("ALICLOUD_ASSUME_ROLE_SESSION_EXPIRATION"); v != "" {
-				if expiredSeconds, err := strconv.Atoi(v); err == nil {
-					config.RamRoleSessionExpiration = expiredSeconds
-				}
-			}
-			if config.RamRoleSessionExpiration == 0 {
-				config.RamRoleSessionExpiration = 3600
-			}
-		} else {
-			config.RamRoleSessionExpiration = assumeRole["session_expiration"].(int)
+		if hop.SessionName == "" {
+			hop.SessionName = config.RamRoleSessionName
 		}
-		if v := assumeRole["external_id"].(string); v != "" {
-			config.RamRoleExternalId = v
+		if hop.SessionName == "" {
+			hop.SessionName = "terraform"
 		}
+		if hop.SessionExpiration == 0 {
+			hop.SessionExpiration = defaultSessionExpiration
+		}
+		config.AssumeRoleChain = append(config.AssumeRoleChain, hop)
 
-		log.Printf("[INFO] assume_role configuration set: (RamRoleArn: %q, RamRoleSessionName: %q, RamRolePolicy: %q, RamRoleSessionExpiration: %d, RamRoleExternalId: %s)",
-			config.RamRoleArn, config.RamRoleSessionName, config.RamRolePolicy, config.RamRoleSessionExpiration, config.RamRoleExternalId)
+		log.Printf("[INFO] assume_role %d configuration set: (RamRoleArn: %q, RamRoleSessionName: %q, RamRolePolicy: %q, RamRoleSessionExpiration: %d, RamRoleExternalId: %s)",
+			i, hop.RoleArn, hop.SessionName, hop.Policy, hop.SessionExpiration, hop.ExternalId)
+	}
+	if len(config.AssumeRoleChain) > 0 {
+		first := config.AssumeRoleChain[0]
+		config.RamRoleArn = first.RoleArn
+		config.RamRoleSessionName = first.SessionName
+		config.RamRolePolicy = first.Policy
+		config.RamRoleSessionExpiration = first.SessionExpiration
+		config.RamRoleExternalId = first.ExternalId
 	}
 
 	if v, ok := d.GetOk("assume_role_with_oidc"); ok && len(v.([]interface{})) == 1 {
@@ -2110,6 +2230,16 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 			config.AssumeRoleWithOidc.RoleARN, config.AssumeRoleWithOidc.RoleSessionName, config.AssumeRoleWithOidc.DurationSeconds, config.AssumeRoleWithOidc.OIDCProviderArn)
 	}
 
+	if v, ok := d.GetOk("endpoints_file"); ok && v.(string) != "" {
+		endpointsFile, err := homedir.Expand(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		config.EndpointsFile = endpointsFile
+	} else if v := os.Getenv("TF_ENDPOINT_PATH"); connectivity.IsEndpointFile(v) {
+		config.EndpointsFile = v
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 	var endpointInit sync.Map
 	config.Endpoints = &endpointInit
@@ -2329,6 +2459,11 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 	if err != nil {
 		return nil, err
 	}
+	// The spans which have ended are exported when the provider is stopped, like being interrupted.
+	go func() {
+		<-p.StopContext().Done()
+		client.Close()
+	}()
 
 	return client, nil
 }
@@ -2356,6 +2491,8 @@ func init() {
 
 		"shared_credentials_file": "The path to the shared credentials file. If not set this defaults to ~/.aliyun/config.json",
 
+		"endpoints_file": "The path to a JSON or YAML file which configures the product endpoints by region, with wildcard regions and the protocol and sign version of each product.",
+
 		"assume_role_role_arn": "The ARN of a RAM role to assume prior to making API calls.",
 
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted, `terraform` is passed to the AssumeRole call as session name.",
@@ -2373,7 +2510,26 @@ func init() {
 		"source_ip":              "The source ip for the assume role invoking.",
 		"secure_transport":       "The security transport for the assume role invoking.",
 		"credentials_uri":        "The URI of sidecar credentials service.",
+		"credential_process":     "The command and its arguments which print the credentials in JSON, with the AccessKeyId, AccessKeySecret, SecurityToken and Expiration. The command runs again before the credentials expire.",
 		"max_retry_timeout":      "The maximum retry timeout of the request.",
+		"plan_time_validation":   "Whether to check the instance types, zones and quotas requested by the alicloud_instance, alicloud_ecs_instance_set, alicloud_cs_kubernetes_node_pool and alicloud_ess_scaling_configuration at plan time, so that the plan fails instead of the apply.",
+		"plan_dry_run":           "Whether to call the create API with DryRun at plan time for the resources whose create API accepts it, like the RunInstances of alicloud_instance, so that the RAM permission and parameter errors fail the plan instead of the apply.",
+		"permission_report_file": "The path to the file which the RAM actions of the APIs invoked by the provider are written into, as a RAM policy document. The actions invoked by the following plan and apply are merged into it.",
+		"default_tags_tags":      "The tags which are applied to all of the resources that support tags. The tags configured in the resource take precedence over them.",
+		"ignore_tags_keys":       "The tag keys which are ignored by all of the resources. The tags are not read into the state and are never removed from the resources.",
+		"ignore_tags_prefixes":   "The tag key prefixes which are ignored by all of the resources. The tags are not read into the state and are never removed from the resources.",
+		"retry_max_attempts":     "The maximum number of attempts of an API request, including the first one.",
+		"retry_base_delay":       "The wait in millisecond before the first retry. It doubles after each retry.",
+		"retry_max_delay":        "The maximum wait in millisecond between two attempts.",
+		"retry_jitter":           "Whether to wait a random time between the base delay and the backoff, so that the parallel requests do not retry at the same time.",
+		"retry_extra_codes":      "The error codes which are retried in addition to the throttling, service unavailable and 5xx errors.",
+		"rate_limit_rps":         "The maximum number of API requests per second of each product. Default to 0, and it means the products not in the product_rates are not limited.",
+		"rate_limit_burst":       "The maximum number of API requests which can be sent at once.",
+		"rate_limit_products":    "The maximum number of API requests per second of the specified products, keyed by the product code, like ecs.",
+		"rate_limit_apis":        "The maximum number of requests per second of the specified APIs, keyed by the product code and API name, like ecs:DescribeInstances.",
+		"rate_limit_adaptive":    "Whether to halve the rate of a product after it returns a throttling error, and restore the rate gradually after the successful requests.",
+		"endpoint_cache_path":    "The path to the file caching the endpoints described from the Location service, keyed by the region and product code.",
+		"endpoint_cache_ttl":     "The time in second that a cached endpoint is used before it is described from the Location service again.",
 
 		"ecs_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom ECS endpoints.",
 
@@ -2644,9 +2800,8 @@ func init() {
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
+		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -2736,6 +2891,161 @@ func assumeRoleWithOidcSchema() *schema.Schema {
 	}
 }
 
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["ignore_tags_prefixes"],
+				},
+			},
+		},
+	}
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      10,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["retry_max_attempts"],
+				},
+				"base_delay": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1000,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["retry_base_delay"],
+				},
+				"max_delay": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      30000,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["retry_max_delay"],
+				},
+				"jitter": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: descriptions["retry_jitter"],
+				},
+				"extra_retryable_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["retry_extra_codes"],
+				},
+			},
+		},
+	}
+}
+
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  descriptions["rate_limit_rps"],
+				},
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["rate_limit_burst"],
+				},
+				"product_rates": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeFloat},
+					Description: descriptions["rate_limit_products"],
+				},
+				"api_rates": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeFloat},
+					Description: descriptions["rate_limit_apis"],
+				},
+				"adaptive": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: descriptions["rate_limit_adaptive"],
+				},
+			},
+		},
+	}
+}
+
+func endpointCacheSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"path": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["endpoint_cache_path"],
+				},
+				"ttl": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      86400,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  descriptions["endpoint_cache_ttl"],
+				},
+			},
+		},
+	}
+}
+
 func signVersionSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -4007,7 +4317,7 @@ func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{
 	if ProfileKey == "region_id" {
 		return providerConfig["region_id"], nil
 	}
-	if mode == "ChainableRamRoleArn" {
+	if connectivity.ProfileModesWithCredentialsProvider[mode] {
 		return nil, nil
 	}
 	switch ProfileKey {
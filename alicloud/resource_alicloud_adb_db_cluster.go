@@ -446,7 +446,7 @@ func resourceAliCloudAdbDbClusterUpdate(d *schema.ResourceData, meta interface{}
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := adbService.SetResourceTags(d, "ALIYUN::ADB::CLUSTER"); err != nil {
 			return WrapError(err)
 		}
@@ -159,7 +159,7 @@ func resourceAlicloudAlbAclUpdate(d *schema.ResourceData, meta interface{}) erro
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := albService.SetResourceTags(d, "acl"); err != nil {
 			return WrapError(err)
 		}
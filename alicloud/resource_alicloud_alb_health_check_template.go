@@ -325,7 +325,7 @@ func resourceAliCloudAlbHealthCheckTemplateUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		albServiceV2 := AlbServiceV2{client}
 		if err := albServiceV2.SetResourceTags(d, "healthchecktemplate"); err != nil {
 			return WrapError(err)
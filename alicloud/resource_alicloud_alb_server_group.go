@@ -1022,7 +1022,7 @@ func resourceAliCloudAlbServerGroupUpdate(d *schema.ResourceData, meta interface
 		}
 
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		albServiceV2 := AlbServiceV2{client}
 		if err := albServiceV2.SetResourceTags(d, "servergroup"); err != nil {
 			return WrapError(err)
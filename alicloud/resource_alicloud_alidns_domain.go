@@ -139,7 +139,7 @@ func resourceAlicloudAlidnsDomainUpdate(d *schema.ResourceData, meta interface{}
 	alidnsService := AlidnsService{client}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := alidnsService.SetResourceTags(d, "DOMAIN"); err != nil {
 			return WrapError(err)
 		}
@@ -108,7 +108,7 @@ func resourceAlicloudAlikafkaConsumerGroupRead(d *schema.ResourceData, meta inte
 func resourceAlicloudAlikafkaConsumerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	alikafkaService := AlikafkaService{client}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := alikafkaService.SetResourceTags(d, "CONSUMERGROUP"); err != nil {
 			return WrapError(err)
 		}
@@ -171,7 +171,7 @@ func resourceAliCloudApiGatewayPluginUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		apiGatewayServiceV2 := ApiGatewayServiceV2{client}
 		if err := apiGatewayServiceV2.SetResourceTags(d, "plugin"); err != nil {
 			return WrapError(err)
@@ -399,7 +399,7 @@ func resourceAliCloudApigGatewayUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		apigServiceV2 := ApigServiceV2{client}
 		if err := apigServiceV2.SetResourceTags(d, "gateway"); err != nil {
 			return WrapError(err)
@@ -282,7 +282,7 @@ func resourceAliCloudArmsEnvironmentUpdate(d *schema.ResourceData, meta interfac
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		armsServiceV2 := ArmsServiceV2{client}
 		if err := armsServiceV2.SetResourceTags(d, "environment"); err != nil {
 			return WrapError(err)
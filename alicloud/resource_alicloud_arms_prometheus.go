@@ -179,7 +179,7 @@ func resourceAliCloudArmsPrometheusUpdate(d *schema.ResourceData, meta interface
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := armsService.SetResourceTags(d, "PROMETHEUS"); err != nil {
 			return WrapError(err)
 		}
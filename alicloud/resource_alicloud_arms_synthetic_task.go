@@ -1897,7 +1897,7 @@ func resourceAliCloudArmsSyntheticTaskUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		armsServiceV2 := ArmsServiceV2{client}
 		if err := armsServiceV2.SetResourceTags(d, "SYNTHETICTASK"); err != nil {
 			return WrapError(err)
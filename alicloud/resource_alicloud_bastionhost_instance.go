@@ -460,7 +460,7 @@ func resourceAliCloudBastionhostInstanceUpdate(d *schema.ResourceData, meta inte
 
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := bastionhostService.setInstanceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
@@ -196,7 +196,7 @@ func resourceAlicloudCddcDedicatedHostUpdate(d *schema.ResourceData, meta interf
 	if err != nil {
 		return WrapError(err)
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := cddcService.SetResourceTags(d, "DEDICATEDHOST"); err != nil {
 			return WrapError(err)
 		}
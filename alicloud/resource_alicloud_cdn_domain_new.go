@@ -576,7 +576,7 @@ func resourceAliCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		cdnServiceV2 := CdnServiceV2{client}
 		if err := cdnServiceV2.SetResourceTags(d, "DOMAIN"); err != nil {
 			return WrapError(err)
@@ -217,7 +217,7 @@ func resourceAliCloudCenTransitRouterUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		cbnService := CbnService{client}
 		if err := cbnService.SetResourceTags(d, "TransitRouter"); err != nil {
 			return WrapError(err)
@@ -228,7 +228,7 @@ func resourceAliCloudCenTransitRouterEcrAttachmentUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		cenServiceV2 := CenServiceV2{client}
 		if err := cenServiceV2.SetResourceTags(d, "TRANSITROUTERECRATTACHMENT"); err != nil {
 			return WrapError(err)
@@ -283,7 +283,7 @@ func resourceAliCloudCenTransitRouterVbrAttachmentUpdate(d *schema.ResourceData,
 		d.SetPartial("transit_router_attachment_description")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := cbnService.SetResourceTags(d, "TransitRouterVbrAttachment"); err != nil {
 			return WrapError(err)
 		}
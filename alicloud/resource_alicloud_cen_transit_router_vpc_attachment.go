@@ -450,7 +450,7 @@ func resourceAliCloudCenTransitRouterVpcAttachmentUpdate(d *schema.ResourceData,
 		}
 
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		cbnService := CbnService{client}
 		if err := cbnService.SetResourceTags(d, "TransitRouterVpcAttachment"); err != nil {
 			return WrapError(err)
@@ -275,7 +275,7 @@ func resourceAliCloudCenTransitRouterVpnAttachmentUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		cenServiceV2 := CenServiceV2{client}
 		if err := cenServiceV2.SetResourceTags(d, "TRANSITROUTERVPNATTACHMENT"); err != nil {
 			return WrapError(err)
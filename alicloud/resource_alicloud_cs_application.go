@@ -149,7 +149,7 @@ func resourceAlicloudCSApplicationCreate(d *schema.ResourceData, meta interface{
 		}
 		args.Environment = env
 	}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	if err := invoker.Run(func() error {
 		cluster, certs, err := csService.GetContainerClusterAndCertsByName(clusterName)
 		if err == nil {
@@ -238,7 +238,7 @@ func resourceAlicloudCSApplicationUpdate(d *schema.ResourceData, meta interface{
 	csService := CsService{client}
 	parts := strings.Split(d.Id(), COLON_SEPARATED)
 	clusterName := parts[0]
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	args := &cs.ProjectUpdationArgs{
 		Name:        parts[1],
 		Description: d.Get("description").(string),
@@ -368,7 +368,7 @@ func resourceAlicloudCSApplicationDelete(d *schema.ResourceData, meta interface{
 	clusterName := parts[0]
 
 	appName := parts[1]
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	return resource.Retry(3*time.Minute, func() *resource.RetryError {
 		err := invoker.Run(func() error {
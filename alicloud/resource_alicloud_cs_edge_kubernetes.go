@@ -449,7 +449,7 @@ func resourceAlicloudCSEdgeKubernetes() *schema.Resource {
 
 func resourceAlicloudCSEdgeKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	csService := CsService{client}
 	args, err := buildKubernetesArgs(d, meta)
 	if err != nil {
@@ -494,7 +494,7 @@ func resourceAlicloudCSEdgeKubernetesUpdate(d *schema.ResourceData, meta interfa
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
 	d.Partial(true)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	//scale up cloud worker nodes
 	var resp interface{}
 	if d.HasChanges("worker_number") {
@@ -577,7 +577,7 @@ func resourceAlicloudCSEdgeKubernetesUpdate(d *schema.ResourceData, meta interfa
 
 			}
 
-			if d.HasChange("tags") && !d.IsNewResource() {
+			if d.HasChanges("tags", "tags_all") && !d.IsNewResource() {
 				if tags, err := ConvertCsTags(d); err == nil {
 					args.Tags = tags
 				}
@@ -671,7 +671,7 @@ func resourceAlicloudCSEdgeKubernetesUpdate(d *schema.ResourceData, meta interfa
 	}
 
 	// modify cluster tag
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		err := updateKubernetesClusterTag(d, meta)
 		if err != nil {
 			return WrapErrorf(err, ResponseCodeMsg, d.Id(), "ModifyClusterTags", AlibabaCloudSdkGoERROR)
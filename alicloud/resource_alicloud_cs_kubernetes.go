@@ -835,7 +835,7 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	var requestInfo *cs.Client
 	var raw interface{}
@@ -891,7 +891,7 @@ func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}
 
 func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(true)
-	invoker := NewInvoker()
+	invoker := NewInvoker(meta.(*connectivity.AliyunClient))
 	// modifyCluster
 	if !d.IsNewResource() && d.HasChanges("resource_group_id", "name", "name_prefix", "deletion_protection", "custom_san", "maintenance_window", "operation_policy", "enable_rrsa") {
 		if err := modifyCluster(d, meta, &invoker); err != nil {
@@ -900,7 +900,7 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	// modify cluster tag
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		err := updateKubernetesClusterTag(d, meta)
 		if err != nil {
 			return WrapErrorf(err, ResponseCodeMsg, d.Id(), "ModifyClusterTags", AlibabaCloudSdkGoERROR)
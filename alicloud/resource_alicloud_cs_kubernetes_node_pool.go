@@ -34,6 +34,7 @@ func resourceAliCloudAckNodepool() *schema.Resource {
 			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
+		CustomizeDiff: resourceAliCloudAckNodepoolCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"auto_renew": {
 				Type:             schema.TypeBool,
@@ -2257,7 +2258,7 @@ func resourceAliCloudAckNodepoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 		if v := d.Get("tags"); v != nil {
 			tagsMap := ConvertTags(v.(map[string]interface{}))
@@ -2905,7 +2906,7 @@ func resourceAliCloudAckNodepoolDelete(d *schema.ResourceData, meta interface{})
 func removeNodePoolNodes(d *schema.ResourceData, meta interface{}, parseId []string, oldNodes []interface{}, newNodes []interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	csService := CsService{client}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	var response interface{}
 	// list all nodes of the nodepool
@@ -3122,3 +3123,33 @@ func diffInstances(old []string, new []string) (attach []string, remove []string
 
 	return
 }
+
+// resourceAliCloudAckNodepoolCustomizeDiff checks that each of the instance types is available in one of the zones of
+// the vswitches, and the desired size against the quota, at plan time when the provider plan_time_validation is enabled.
+func resourceAliCloudAckNodepoolCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !planTimeValidationNeeded(diff, meta, "instance_types", "vswitch_ids", "desired_size", "node_count") {
+		return nil
+	}
+	request := &planTimeInstanceRequest{
+		instanceTypes:      planTimeStringList(diff, "instance_types"),
+		vswitchIds:         planTimeStringList(diff, "vswitch_ids"),
+		instanceChargeType: planTimeString(diff, "instance_charge_type"),
+		spotStrategy:       planTimeString(diff, "spot_strategy"),
+		systemDiskCategory: planTimeString(diff, "system_disk_category"),
+	}
+	if desiredSize := planTimeString(diff, "desired_size"); desiredSize != "" {
+		request.count, _ = strconv.Atoi(desiredSize)
+	} else if diff.NewValueKnown("node_count") {
+		request.count = diff.Get("node_count").(int)
+	}
+	// Only the new nodes are purchased
+	if diff.Id() != "" {
+		if o, _ := diff.GetChange("desired_size"); o.(string) != "" {
+			current, _ := strconv.Atoi(o.(string))
+			request.count -= current
+		} else if o, _ := diff.GetChange("node_count"); o.(int) > 0 {
+			request.count -= o.(int)
+		}
+	}
+	return validatePlanTimeInstanceRequest(meta.(*connectivity.AliyunClient), request)
+}
@@ -93,7 +93,7 @@ func testSweepCSKubernetes(region string) error {
 			}
 		}
 		log.Printf("[INFO] Close CS Clusters: %s (%s) deletion protection", name, id)
-		invoker := NewInvoker()
+		invoker := NewInvoker(client)
 
 		var requestInfo cs.ModifyClusterArgs
 		requestInfo.DeletionProtection = false
@@ -1296,7 +1296,7 @@ func resourceAlicloudCSManagedKubernetesRead(d *schema.ResourceData, meta interf
 
 func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(true)
-	invoker := NewInvoker()
+	invoker := NewInvoker(meta.(*connectivity.AliyunClient))
 	// modifyCluster
 	if !d.IsNewResource() && d.HasChanges("resource_group_id", "name", "name_prefix", "deletion_protection", "maintenance_window", "operation_policy",
 		"custom_san", "vswitch_ids", "timezone", "security_group_id", "enable_rrsa") {
@@ -1306,7 +1306,7 @@ func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta inte
 	}
 
 	// modify cluster tag
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		err := updateKubernetesClusterTag(d, meta)
 		if err != nil {
 			return WrapErrorf(err, ResponseCodeMsg, d.Id(), "ModifyClusterTags", AlibabaCloudSdkGoERROR)
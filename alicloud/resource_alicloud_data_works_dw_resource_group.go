@@ -244,7 +244,7 @@ func resourceAliCloudDataWorksDwResourceGroupUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		dataWorksServiceV2 := DataWorksServiceV2{client}
 		if err := dataWorksServiceV2.SetResourceTags(d, "dwresourcegroup"); err != nil {
 			return WrapError(err)
@@ -414,8 +414,8 @@ func resourceAliCloudDbfsDbfsInstanceUpdate(d *schema.ResourceData, meta interfa
 		d.SetPartial("performance_level")
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oraw, nraw := getTagsChange(d)
 		remove := oraw.(map[string]interface{})
 		create := nraw.(map[string]interface{})
 
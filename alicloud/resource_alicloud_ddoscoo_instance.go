@@ -364,7 +364,7 @@ func resourceAliCloudDdoscooInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := ddosCooServiceV2.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
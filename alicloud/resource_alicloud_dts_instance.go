@@ -328,7 +328,7 @@ func resourceAlicloudDtsInstanceUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := dtsService.SetResourceTags(d, "ALIYUN::DTS::INSTANCE"); err != nil {
 			return WrapError(err)
 		}
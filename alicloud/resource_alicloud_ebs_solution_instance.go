@@ -252,7 +252,7 @@ func resourceAliCloudEbsSolutionInstanceUpdate(d *schema.ResourceData, meta inte
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		ebsServiceV2 := EbsServiceV2{client}
 		if err := ebsServiceV2.SetResourceTags(d, "solutioninstance"); err != nil {
 			return WrapError(err)
@@ -1833,7 +1833,7 @@ func resourceAlicloudEciContainerGroupUpdate(d *schema.ResourceData, meta interf
 		update = true
 		request["RestartPolicy"] = d.Get("restart_policy")
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 		count := 1
 		for key, value := range d.Get("tags").(map[string]interface{}) {
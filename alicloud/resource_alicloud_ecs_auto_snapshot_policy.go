@@ -420,7 +420,7 @@ func resourceAliCloudEcsAutoSnapshotPolicyUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		ecsServiceV2 := EcsServiceV2{client}
 		if err := ecsServiceV2.SetResourceTags(d, "snapshotpolicy"); err != nil {
 			return WrapError(err)
@@ -253,7 +253,7 @@ func resourceAlicloudEcsCapacityReservationUpdate(d *schema.ResourceData, meta i
 	var err error
 	update := false
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := ecsService.SetResourceTags(d, "capacityreservation"); err != nil {
 			return WrapError(err)
 		}
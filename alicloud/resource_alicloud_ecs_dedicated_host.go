@@ -299,7 +299,7 @@ func resourceAlicloudEcsDedicatedHostUpdate(d *schema.ResourceData, meta interfa
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && d.HasChanges("tags", "tags_all") {
 		if err := ecsService.SetResourceTags(d, "ddh"); err != nil {
 			return WrapError(err)
 		}
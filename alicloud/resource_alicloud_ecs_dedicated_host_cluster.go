@@ -123,7 +123,7 @@ func resourceAlicloudEcsDedicatedHostClusterUpdate(d *schema.ResourceData, meta
 		"DedicatedHostClusterId": d.Id(),
 	}
 	request["RegionId"] = client.RegionId
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := ecsService.SetResourceTags(d, "ddhcluster"); err != nil {
 			return WrapError(err)
 		}
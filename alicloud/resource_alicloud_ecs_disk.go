@@ -617,7 +617,7 @@ func resourceAliCloudEcsDiskUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && d.HasChanges("tags", "tags_all") {
 		if err := ecsServiceV2.SetResourceTags(d, "disk"); err != nil {
 			return WrapError(err)
 		}
@@ -263,7 +263,7 @@ func resourceAlicloudEcsElasticityAssuranceUpdate(d *schema.ResourceData, meta i
 		"PrivatePoolOptions.Id": d.Id(),
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := ecsService.SetResourceTags(d, "elasticityassurance"); err != nil {
 			return WrapError(err)
 		}
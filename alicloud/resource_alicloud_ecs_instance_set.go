@@ -32,6 +32,7 @@ func resourceAlicloudEcsInstanceSet() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+		CustomizeDiff: resourceAlicloudEcsInstanceSetCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"amount": {
 				Type:         schema.TypeInt,
@@ -738,7 +739,7 @@ func resourceAlicloudEcsInstanceSetUpdate(d *schema.ResourceData, meta interface
 	ecsService := EcsService{client}
 	d.Partial(false)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		instanceIds := make([]string, 0)
 		if err := ecsService.SetInstanceSetResourceTags(d, "instance", instanceIds); err != nil {
 			return WrapError(err)
@@ -973,3 +974,30 @@ func checkEcsInstanceSystemFailureDeleteEvent(d *schema.ResourceData, meta inter
 
 	return instanceIds, nil
 }
+
+// resourceAlicloudEcsInstanceSetCustomizeDiff checks the instance type in the zone and the amount against the quota at plan
+// time when the provider plan_time_validation is enabled.
+func resourceAlicloudEcsInstanceSetCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !planTimeValidationNeeded(diff, meta, "instance_type", "zone_id", "vswitch_id", "amount") {
+		return nil
+	}
+	instanceType := planTimeString(diff, "instance_type")
+	if instanceType == "" {
+		return nil
+	}
+	request := &planTimeInstanceRequest{
+		instanceTypes:      []string{instanceType},
+		instanceChargeType: planTimeString(diff, "instance_charge_type"),
+		spotStrategy:       planTimeString(diff, "spot_strategy"),
+		systemDiskCategory: planTimeString(diff, "system_disk_category"),
+	}
+	if diff.NewValueKnown("amount") {
+		request.count = diff.Get("amount").(int)
+	}
+	if zoneId := planTimeString(diff, "zone_id"); zoneId != "" {
+		request.zoneIds = []string{zoneId}
+	} else if vswitchId := planTimeString(diff, "vswitch_id"); vswitchId != "" {
+		request.vswitchIds = []string{vswitchId}
+	}
+	return validatePlanTimeInstanceRequest(meta.(*connectivity.AliyunClient), request)
+}
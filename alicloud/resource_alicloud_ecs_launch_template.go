@@ -1199,7 +1199,7 @@ func resourceAliCloudEcsLaunchTemplateUpdate(d *schema.ResourceData, meta interf
 		systemDiskMap["PerformanceLevel"] = diskMap["performance_level"]
 		request["SystemDisk"] = systemDiskMap
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 	}
 	if v, ok := d.GetOk("tags"); ok {
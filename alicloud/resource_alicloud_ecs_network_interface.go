@@ -364,7 +364,7 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := ecsService.SetResourceTags(d, "eni"); err != nil {
 			return WrapError(err)
 		}
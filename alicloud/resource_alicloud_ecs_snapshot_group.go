@@ -212,7 +212,7 @@ func resourceAlicloudEcsSnapshotGroupUpdate(d *schema.ResourceData, meta interfa
 		d.SetPartial("description")
 		d.SetPartial("snapshot_group_name")
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := ecsService.SetResourceTags(d, "snapshotgroup"); err != nil {
 			return WrapError(err)
 		}
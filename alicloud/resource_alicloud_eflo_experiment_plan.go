@@ -213,7 +213,7 @@ func resourceAliCloudEfloExperimentPlanUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		efloServiceV2 := EfloServiceV2{client}
 		if err := efloServiceV2.SetResourceTags(d, "ExperimentPlan"); err != nil {
 			return WrapError(err)
@@ -182,7 +182,7 @@ func resourceAliCloudEfloVscUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		efloServiceV2 := EfloServiceV2{client}
 		if err := efloServiceV2.SetResourceTags(d, "Vsc"); err != nil {
 			return WrapError(err)
@@ -284,7 +284,7 @@ func resourceAliCloudEipanycastAnycastEipAddressUpdate(d *schema.ResourceData, m
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		eipanycastServiceV2 := EipanycastServiceV2{client}
 		if err := eipanycastServiceV2.SetResourceTags(d, "ANYCASTEIPADDRESS"); err != nil {
 			return WrapError(err)
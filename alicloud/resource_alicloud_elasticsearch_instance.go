@@ -673,7 +673,7 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 		d.SetPartial("kibana_private_whitelist")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := updateInstanceTags(d, meta); err != nil {
 			return WrapError(err)
 		}
@@ -257,7 +257,7 @@ func resourceAliCloudEnsDiskUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		ensServiceV2 := EnsServiceV2{client}
 		if err := ensServiceV2.SetResourceTags(d, "disk"); err != nil {
 			return WrapError(err)
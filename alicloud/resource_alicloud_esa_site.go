@@ -740,7 +740,7 @@ func resourceAliCloudEsaSiteUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		esaServiceV2 := EsaServiceV2{client}
 		if err := esaServiceV2.SetResourceTags(d, "Site"); err != nil {
 			return WrapError(err)
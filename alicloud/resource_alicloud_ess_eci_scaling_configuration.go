@@ -1600,7 +1600,7 @@ func resourceAliyunEssEciScalingConfigurationUpdate(d *schema.ResourceData, meta
 		update = true
 		request["LoadBalancerWeight"] = d.Get("load_balancer_weight")
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 		count := 1
 		for key, value := range d.Get("tags").(map[string]interface{}) {
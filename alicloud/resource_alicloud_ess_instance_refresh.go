@@ -0,0 +1,378 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// The statuses of an instance refresh task.
+const (
+	EssInstanceRefreshPending            = "Pending"
+	EssInstanceRefreshInProgress         = "InProgress"
+	EssInstanceRefreshPaused             = "Paused"
+	EssInstanceRefreshSuccessful         = "Successful"
+	EssInstanceRefreshFailed             = "Failed"
+	EssInstanceRefreshCancelling         = "Cancelling"
+	EssInstanceRefreshCancelled          = "Cancelled"
+	EssInstanceRefreshRollbackInProgress = "RollbackInProgress"
+	EssInstanceRefreshRollbackSuccessful = "RollbackSuccessful"
+	EssInstanceRefreshRollbackFailed     = "RollbackFailed"
+)
+
+func resourceAliCloudEssInstanceRefresh() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliCloudEssInstanceRefreshCreate,
+		Read:   resourceAliCloudEssInstanceRefreshRead,
+		Update: resourceAliCloudEssInstanceRefreshUpdate,
+		Delete: resourceAliCloudEssInstanceRefreshDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"desired_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scaling_configuration_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"image_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"launch_template_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"launch_template_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"min_healthy_percentage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: IntBetween(0, 100),
+			},
+			"max_healthy_percentage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: IntBetween(100, 200),
+			},
+			"skip_matching": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"checkpoints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: IntBetween(1, 100),
+				},
+			},
+			"checkpoint_pause_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: IntBetween(1, 2880),
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rollback_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"instance_refresh_task_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliCloudEssInstanceRefreshCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	var response map[string]interface{}
+	var err error
+	action := "StartInstanceRefresh"
+	request := make(map[string]interface{})
+	request["RegionId"] = client.RegionId
+	request["ScalingGroupId"] = d.Get("scaling_group_id")
+	request["ClientToken"] = buildClientToken(action)
+	if v, ok := d.GetOk("desired_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		desiredConfiguration := v.([]interface{})[0].(map[string]interface{})
+		for key, field := range map[string]string{
+			"scaling_configuration_id": "ScalingConfigurationId",
+			"image_id":                 "ImageId",
+			"launch_template_id":       "LaunchTemplateId",
+			"launch_template_version":  "LaunchTemplateVersion",
+		} {
+			if value := fmt.Sprint(desiredConfiguration[key]); value != "" {
+				request["DesiredConfiguration."+field] = value
+			}
+		}
+	}
+	if v, ok := d.GetOkExists("min_healthy_percentage"); ok {
+		request["MinHealthyPercentage"] = v
+	}
+	if v, ok := d.GetOk("max_healthy_percentage"); ok {
+		request["MaxHealthyPercentage"] = v
+	}
+	if v, ok := d.GetOk("skip_matching"); ok {
+		request["SkipMatching"] = v
+	}
+	if v, ok := d.GetOk("checkpoints"); ok {
+		for i, percentage := range v.([]interface{}) {
+			request[fmt.Sprintf("Checkpoints.%d.Percentage", i+1)] = percentage
+		}
+	}
+	if v, ok := d.GetOk("checkpoint_pause_time"); ok {
+		request["CheckpointPauseTime"] = v
+	}
+
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, err = client.RpcPost("Ess", "2014-08-28", action, nil, request, true)
+		if err != nil {
+			if IsExpectedErrors(err, []string{"IncorrectScalingGroupStatus"}) || IsRetryableError("Ess", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_ess_instance_refresh", action, AlibabaCloudSdkGoERROR)
+	}
+
+	d.SetId(fmt.Sprint(request["ScalingGroupId"], ":", response["InstanceRefreshTaskId"]))
+
+	// The task is suspended by the update when the paused is set to true.
+	if !d.Get("paused").(bool) {
+		if err := waitForEssInstanceRefresh(d, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	return resourceAliCloudEssInstanceRefreshUpdate(d, meta)
+}
+
+func resourceAliCloudEssInstanceRefreshRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	essService := EssService{client}
+
+	object, err := essService.DescribeEssInstanceRefresh(d.Id())
+	if err != nil {
+		if !d.IsNewResource() && IsNotFoundError(err) {
+			log.Printf("[DEBUG] Resource alicloud_ess_instance_refresh DescribeEssInstanceRefresh Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("scaling_group_id", parts[0])
+	d.Set("instance_refresh_task_id", parts[1])
+	if v, ok := object["MinHealthyPercentage"]; ok && v != nil {
+		d.Set("min_healthy_percentage", formatInt(v))
+	}
+	if v, ok := object["MaxHealthyPercentage"]; ok && v != nil {
+		d.Set("max_healthy_percentage", formatInt(v))
+	}
+	d.Set("status", object["Status"])
+	d.Set("paused", fmt.Sprint(object["Status"]) == EssInstanceRefreshPaused)
+
+	return nil
+}
+
+func resourceAliCloudEssInstanceRefreshUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	essService := EssService{client}
+
+	object, err := essService.DescribeEssInstanceRefresh(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	status := fmt.Sprint(object["Status"])
+
+	// The paused is only applied to the task which is not finished, and a task paused at a checkpoint is resumed
+	// unless the paused is set to true.
+	action := ""
+	paused := d.Get("paused").(bool)
+	if paused && (status == EssInstanceRefreshPending || status == EssInstanceRefreshInProgress) {
+		action = "SuspendInstanceRefresh"
+	}
+	if !paused && status == EssInstanceRefreshPaused {
+		action = "ResumeInstanceRefresh"
+	}
+	if action != "" {
+		if err := invokeEssInstanceRefreshAction(d, client, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+		if action == "ResumeInstanceRefresh" {
+			if err := waitForEssInstanceRefresh(d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return WrapError(err)
+			}
+		}
+	}
+
+	return resourceAliCloudEssInstanceRefreshRead(d, meta)
+}
+
+func resourceAliCloudEssInstanceRefreshDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	essService := EssService{client}
+
+	object, err := essService.DescribeEssInstanceRefresh(d.Id())
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	// The finished task can not be deleted, and it is only removed from the state.
+	switch fmt.Sprint(object["Status"]) {
+	case EssInstanceRefreshPending, EssInstanceRefreshInProgress, EssInstanceRefreshPaused:
+	default:
+		return nil
+	}
+	if err := invokeEssInstanceRefreshAction(d, client, "CancelInstanceRefresh", d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	stateConf := BuildStateConf([]string{EssInstanceRefreshPending, EssInstanceRefreshInProgress, EssInstanceRefreshPaused, EssInstanceRefreshCancelling},
+		[]string{EssInstanceRefreshCancelled, EssInstanceRefreshSuccessful, EssInstanceRefreshFailed}, d.Timeout(schema.TimeoutDelete), 5*time.Second,
+		essService.EssInstanceRefreshStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
+
+func invokeEssInstanceRefreshAction(d *schema.ResourceData, client *connectivity.AliyunClient, action string, timeout time.Duration) error {
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	var response map[string]interface{}
+	request := map[string]interface{}{
+		"RegionId":              client.RegionId,
+		"ScalingGroupId":        parts[0],
+		"InstanceRefreshTaskId": parts[1],
+	}
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		response, err = client.RpcPost("Ess", "2014-08-28", action, nil, request, true)
+		if err != nil {
+			if IsRetryableError("Ess", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
+
+// waitForEssInstanceRefresh waits until the task is successful, and the task paused at a checkpoint is resumed unless the
+// paused is set to true, in which case it stops waiting. When the task fails and the rollback_on_failure is set, the
+// task is rolled back, and the failure is still returned after the rollback finishes.
+func waitForEssInstanceRefresh(d *schema.ResourceData, client *connectivity.AliyunClient, timeout time.Duration) error {
+	essService := EssService{client}
+	deadline := time.Now().Add(timeout)
+	pending := []string{EssInstanceRefreshPending, EssInstanceRefreshInProgress}
+	target := []string{EssInstanceRefreshSuccessful, EssInstanceRefreshPaused}
+	if _, ok := d.GetOk("checkpoint_pause_time"); ok && !d.Get("paused").(bool) {
+		pending = append(pending, EssInstanceRefreshPaused)
+		target = []string{EssInstanceRefreshSuccessful}
+	}
+	failStates := []string{EssInstanceRefreshFailed, EssInstanceRefreshCancelled, EssInstanceRefreshRollbackSuccessful, EssInstanceRefreshRollbackFailed}
+	for {
+		stateConf := BuildStateConf(pending, target, time.Until(deadline), 5*time.Second, essService.EssInstanceRefreshStateRefreshFunc(d.Id(), failStates))
+		object, err := stateConf.WaitForState()
+		if err == nil {
+			if fmt.Sprint(object.(map[string]interface{})["Status"]) != EssInstanceRefreshPaused || d.Get("paused").(bool) {
+				return nil
+			}
+			log.Printf("[DEBUG] The instance refresh %s is paused at a checkpoint and it is resumed.", d.Id())
+			if err := invokeEssInstanceRefreshAction(d, client, "ResumeInstanceRefresh", time.Until(deadline)); err != nil {
+				return WrapError(err)
+			}
+			continue
+		}
+		failed, ok := object.(map[string]interface{})
+		if !ok || fmt.Sprint(failed["Status"]) != EssInstanceRefreshFailed || !d.Get("rollback_on_failure").(bool) {
+			return WrapErrorf(err, IdMsg, d.Id())
+		}
+
+		log.Printf("[WARN] The instance refresh %s failed and it is rolled back: %s", d.Id(), err)
+		if err := invokeEssInstanceRefreshAction(d, client, "RollbackInstanceRefresh", time.Until(deadline)); err != nil {
+			return WrapError(err)
+		}
+		stateConf = BuildStateConf([]string{EssInstanceRefreshFailed, EssInstanceRefreshRollbackInProgress}, []string{EssInstanceRefreshRollbackSuccessful}, time.Until(deadline), 5*time.Second,
+			essService.EssInstanceRefreshStateRefreshFunc(d.Id(), []string{EssInstanceRefreshRollbackFailed}))
+		if _, rollbackErr := stateConf.WaitForState(); rollbackErr != nil {
+			return WrapErrorf(rollbackErr, IdMsg, d.Id())
+		}
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+}
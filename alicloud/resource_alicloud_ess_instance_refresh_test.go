@@ -0,0 +1,101 @@
+package alicloud
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitAliCloudEssInstanceRefreshWithMockServer(t *testing.T) {
+	server := connectivity.NewMockServer()
+	defer server.Close()
+	server.Register("Ess", "StartInstanceRefresh", connectivity.MockResponse{
+		Body: map[string]interface{}{"InstanceRefreshTaskId": "ir-mock"},
+	})
+	server.Register("Ess", "DescribeInstanceRefreshes", connectivity.MockResponse{
+		Body: map[string]interface{}{
+			"InstanceRefreshTasks": []interface{}{
+				map[string]interface{}{"InstanceRefreshTaskId": "ir-mock", "ScalingGroupId": "asg-mock", "Status": "Successful", "MinHealthyPercentage": 50, "MaxHealthyPercentage": 120},
+			},
+		},
+	})
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+
+	d := schema.TestResourceDataRaw(t, resourceAliCloudEssInstanceRefresh().Schema, map[string]interface{}{
+		"scaling_group_id":       "asg-mock",
+		"min_healthy_percentage": 50,
+		"checkpoints":            []interface{}{30, 60},
+		"desired_configuration": []interface{}{
+			map[string]interface{}{"image_id": "m-mock"},
+		},
+	})
+	err = resourceAliCloudEssInstanceRefreshCreate(d, client)
+	assert.Nil(t, err)
+	assert.Equal(t, "asg-mock:ir-mock", d.Id())
+	assert.Equal(t, "Successful", d.Get("status"))
+	assert.Equal(t, 120, d.Get("max_healthy_percentage"))
+	assert.False(t, d.Get("paused").(bool))
+
+	requests := server.Requests()
+	assert.Equal(t, "StartInstanceRefresh", requests[0].ApiName)
+	body, _ := url.ParseQuery(requests[0].Body)
+	assert.Equal(t, "asg-mock", body.Get("ScalingGroupId"))
+	assert.Equal(t, "m-mock", body.Get("DesiredConfiguration.ImageId"))
+	assert.Equal(t, "", body.Get("DesiredConfiguration.ScalingConfigurationId"))
+	assert.Equal(t, "50", body.Get("MinHealthyPercentage"))
+	assert.Equal(t, "30", body.Get("Checkpoints.1.Percentage"))
+	assert.Equal(t, "60", body.Get("Checkpoints.2.Percentage"))
+	for _, request := range requests[1:] {
+		assert.Equal(t, "DescribeInstanceRefreshes", request.ApiName)
+	}
+
+	// The finished task is only removed from the state.
+	err = resourceAliCloudEssInstanceRefreshDelete(d, client)
+	assert.Nil(t, err)
+	assert.Equal(t, "DescribeInstanceRefreshes", server.Requests()[len(server.Requests())-1].ApiName)
+}
+
+func TestUnitAliCloudEssInstanceRefreshResumeCheckpointWithMockServer(t *testing.T) {
+	server := connectivity.NewMockServer()
+	defer server.Close()
+	server.Register("Ess", "StartInstanceRefresh", connectivity.MockResponse{
+		Body: map[string]interface{}{"InstanceRefreshTaskId": "ir-mock"},
+	})
+	server.Register("Ess", "ResumeInstanceRefresh", connectivity.MockResponse{
+		Body: map[string]interface{}{"RequestId": "mock"},
+	})
+	task := func(status string) connectivity.MockResponse {
+		return connectivity.MockResponse{
+			Body: map[string]interface{}{
+				"InstanceRefreshTasks": []interface{}{
+					map[string]interface{}{"InstanceRefreshTaskId": "ir-mock", "ScalingGroupId": "asg-mock", "Status": status},
+				},
+			},
+		}
+	}
+	server.Register("Ess", "DescribeInstanceRefreshes", task("Paused"), task("Successful"))
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+
+	// The task paused at a checkpoint is resumed, because the paused is not set.
+	d := schema.TestResourceDataRaw(t, resourceAliCloudEssInstanceRefresh().Schema, map[string]interface{}{
+		"scaling_group_id": "asg-mock",
+		"checkpoints":      []interface{}{50},
+	})
+	err = resourceAliCloudEssInstanceRefreshCreate(d, client)
+	assert.Nil(t, err)
+	assert.Equal(t, "Successful", d.Get("status"))
+	assert.False(t, d.Get("paused").(bool))
+
+	resumed := 0
+	for _, request := range server.Requests() {
+		if request.ApiName == "ResumeInstanceRefresh" {
+			resumed++
+		}
+	}
+	assert.Equal(t, 1, resumed)
+}
@@ -33,6 +33,7 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceAlicloudEssScalingConfigurationCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"active": {
 				Type:     schema.TypeBool,
@@ -1124,7 +1125,7 @@ func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 		}
 		update = true
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if v, ok := d.GetOk("tags"); ok {
 			tags := "{"
 			for key, value := range v.(map[string]interface{}) {
@@ -1638,3 +1639,20 @@ func toStringArray(a interface{}) []string {
 	}
 	return paramSlice
 }
+
+// resourceAlicloudEssScalingConfigurationCustomizeDiff checks the instance types in the region at plan time when the provider
+// plan_time_validation is enabled. The zones are the ones of the scaling group, and they are not checked.
+func resourceAlicloudEssScalingConfigurationCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !planTimeValidationNeeded(diff, meta, "instance_type", "instance_types") {
+		return nil
+	}
+	request := &planTimeInstanceRequest{
+		instanceTypes:      planTimeStringList(diff, "instance_types"),
+		spotStrategy:       planTimeString(diff, "spot_strategy"),
+		systemDiskCategory: planTimeString(diff, "system_disk_category"),
+	}
+	if instanceType := planTimeString(diff, "instance_type"); instanceType != "" {
+		request.instanceTypes = []string{instanceType}
+	}
+	return validatePlanTimeInstanceRequest(meta.(*connectivity.AliyunClient), request)
+}
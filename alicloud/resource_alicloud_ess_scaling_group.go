@@ -513,7 +513,7 @@ func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{
 	//开启 允许部分属性修改
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := essService.SetResourceTags(d, d.Id(), client); err != nil {
 			return WrapError(err)
 		}
@@ -484,7 +484,7 @@ func resourceAliCloudExpressConnectRouterExpressConnectRouterUpdate(d *schema.Re
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		expressConnectRouterServiceV2 := ExpressConnectRouterServiceV2{client}
 		if err := expressConnectRouterServiceV2.SetResourceTags(d, "EXPRESSCONNECTROUTER"); err != nil {
 			return WrapError(err)
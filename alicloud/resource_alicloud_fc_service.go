@@ -400,7 +400,7 @@ func resourceAlicloudFCServiceUpdate(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*connectivity.AliyunClient)
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		fcService := FcService{client}
 		resourceArn, err := parseResourceArn(d, meta)
 		if err != nil {
@@ -185,7 +185,7 @@ func resourceAliCloudGaAclUpdate(d *schema.ResourceData, meta interface{}) error
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := gaService.SetResourceTags(d, "acl"); err != nil {
 			return WrapError(err)
 		}
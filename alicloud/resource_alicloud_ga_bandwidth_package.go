@@ -275,7 +275,7 @@ func resourceAliCloudGaBandwidthPackageUpdate(d *schema.ResourceData, meta inter
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := gaService.SetResourceTags(d, "bandwidthpackage"); err != nil {
 			return WrapError(err)
 		}
@@ -410,7 +410,7 @@ func resourceAliCloudGaEndpointGroupUpdate(d *schema.ResourceData, meta interfac
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := gaService.SetResourceTags(d, "endpointgroup"); err != nil {
 			return WrapError(err)
 		}
@@ -608,7 +608,7 @@ func resourceAliCloudHologramInstanceUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		hologramServiceV2 := HologramServiceV2{client}
 		if err := hologramServiceV2.SetResourceTags(d, ""); err != nil {
 			return WrapError(err)
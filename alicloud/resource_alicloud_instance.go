@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -34,6 +35,7 @@ func resourceAliCloudInstance() *schema.Resource {
 			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+		CustomizeDiff: resourceAliCloudInstanceCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -435,6 +437,15 @@ func resourceAliCloudInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"update_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: StringInSlice([]string{InstanceUpdateStrategyStopRequiredAllowed, InstanceUpdateStrategyOnlineOnly, InstanceUpdateStrategyRecreate}, false),
+			},
+			"update_impact": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"public_ip": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -722,9 +733,43 @@ func resourceAliCloudInstanceCreate(d *schema.ResourceData, meta interface{}) er
 	client := meta.(*connectivity.AliyunClient)
 	ecsService := EcsService{client}
 	var response map[string]interface{}
+	action, request, err := buildAliCloudInstanceRunInstancesRequest(d, client)
+	if err != nil {
+		return err
+	}
+
+	wait := incrementalWait(1*time.Second, 1*time.Second)
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, err = client.RpcPost("Ecs", "2014-05-26", action, nil, request, false)
+		if err != nil {
+			if NeedRetry(err) || IsExpectedErrors(err, []string{"IncorrectVSwitchStatus"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_instance", action, AlibabaCloudSdkGoERROR)
+	}
+
+	d.SetId(fmt.Sprint(response["InstanceIdSets"].(map[string]interface{})["InstanceIdSet"].([]interface{})[0]))
+
+	stateConf := BuildStateConf([]string{"Pending", "Starting", "Stopped"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{"Stopping"}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAliCloudInstanceUpdate(d, meta)
+}
+
+func buildAliCloudInstanceRunInstancesRequest(d schemaGetter, client *connectivity.AliyunClient) (string, map[string]interface{}, error) {
+	ecsService := EcsService{client}
 	action := "RunInstances"
 	request := make(map[string]interface{})
-	var err error
 
 	request["RegionId"] = client.RegionId
 	request["ClientToken"] = buildClientToken(action)
@@ -835,11 +880,11 @@ func resourceAliCloudInstanceCreate(d *schema.ResourceData, meta interface{}) er
 		request["Password"] = v
 	}
 
-	if v, ok := d.GetOk("kms_encrypted_password"); ok {
+	if v, ok := d.GetOk("kms_encrypted_password"); ok && !isPlanTime(d) {
 		kmsService := KmsService{client}
 		decryptResp, err := kmsService.Decrypt(v.(string), d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
-			return WrapError(err)
+			return "", nil, WrapError(err)
 		}
 		request["Password"] = decryptResp
 	}
@@ -1077,13 +1122,14 @@ func resourceAliCloudInstanceCreate(d *schema.ResourceData, meta interface{}) er
 					secondaryNetworkInterfacesMap["NetworkInterfaceTrafficMode"] = networkInterfaceTrafficMode
 				}
 
-				isSupported, err := ecsService.isSupportedNetworkCardIndex(fmt.Sprint(request["InstanceType"]))
-				if err != nil {
-					return WrapError(err)
-				}
-
-				if networkCardIndex, ok := secondaryNetworkInterfacesArg["network_card_index"]; ok && isSupported {
-					secondaryNetworkInterfacesMap["NetworkCardIndex"] = networkCardIndex
+				if networkCardIndex, ok := secondaryNetworkInterfacesArg["network_card_index"]; ok && !isPlanTime(d) {
+					isSupported, err := ecsService.isSupportedNetworkCardIndex(fmt.Sprint(request["InstanceType"]))
+					if err != nil {
+						return "", nil, WrapError(err)
+					}
+					if isSupported {
+						secondaryNetworkInterfacesMap["NetworkCardIndex"] = networkCardIndex
+					}
 				}
 
 				if queuePairNumber, ok := secondaryNetworkInterfacesArg["queue_pair_number"]; ok && fmt.Sprint(queuePairNumber) != "0" {
@@ -1154,32 +1200,7 @@ func resourceAliCloudInstanceCreate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = client.RpcPost("Ecs", "2014-05-26", action, nil, request, false)
-		if err != nil {
-			if NeedRetry(err) || IsExpectedErrors(err, []string{"IncorrectVSwitchStatus"}) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-	addDebug(action, response, request)
-
-	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alicloud_instance", action, AlibabaCloudSdkGoERROR)
-	}
-
-	d.SetId(fmt.Sprint(response["InstanceIdSets"].(map[string]interface{})["InstanceIdSet"].([]interface{})[0]))
-
-	stateConf := BuildStateConf([]string{"Pending", "Starting", "Stopped"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{"Stopping"}))
-	if _, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id())
-	}
-
-	return resourceAliCloudInstanceUpdate(d, meta)
+	return action, request, nil
 }
 
 func resourceAliCloudInstanceRead(d *schema.ResourceData, meta interface{}) error {
@@ -1226,6 +1247,11 @@ func resourceAliCloudInstanceRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("resource_group_id", instance.ResourceGroupId)
 	d.Set("description", instance.Description)
 	d.Set("status", instance.Status)
+	// The update_impact keeps the impact of the last applied update, which is the one planned, so that the applied
+	// result is consistent with the plan.
+	if d.Get("update_impact").(string) == "" {
+		d.Set("update_impact", InstanceUpdateImpactNone)
+	}
 	d.Set("availability_zone", instance.ZoneId)
 	d.Set("host_name", instance.HostName)
 	d.Set("image_id", instance.ImageId)
@@ -2821,3 +2847,161 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	}
 	return nil
 }
+
+// resourceAliCloudInstanceCustomizeDiff annotates the impact of the update and checks it against the update_strategy, checks
+// the instance type in the zone when the provider plan_time_validation is enabled, and dry runs the RunInstances when the
+// provider plan_dry_run is enabled.
+func resourceAliCloudInstanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeAliCloudInstanceUpdateStrategy(diff); err != nil {
+		return err
+	}
+	if err := validateAliCloudInstancePlanTime(diff, meta); err != nil {
+		return err
+	}
+	return dryRunCreate{
+		resourceName: "alicloud_instance",
+		product:      "Ecs",
+		version:      "2014-05-26",
+		keys:         []string{"image_id", "instance_type", "security_groups", "vswitch_id", "key_name", "role_name", "data_disks", "network_interfaces"},
+		request:      buildAliCloudInstanceRunInstancesRequest,
+	}.customizeDiff(diff, meta)
+}
+
+func validateAliCloudInstancePlanTime(diff *schema.ResourceDiff, meta interface{}) error {
+	if !planTimeValidationNeeded(diff, meta, "instance_type", "availability_zone", "vswitch_id") {
+		return nil
+	}
+	instanceType := planTimeString(diff, "instance_type")
+	if instanceType == "" {
+		return nil
+	}
+	request := &planTimeInstanceRequest{
+		instanceTypes:      []string{instanceType},
+		instanceChargeType: planTimeString(diff, "instance_charge_type"),
+		spotStrategy:       planTimeString(diff, "spot_strategy"),
+		systemDiskCategory: planTimeString(diff, "system_disk_category"),
+		count:              1,
+	}
+	if zoneId := planTimeString(diff, "availability_zone"); zoneId != "" {
+		request.zoneIds = []string{zoneId}
+	} else if vswitchId := planTimeString(diff, "vswitch_id"); vswitchId != "" {
+		request.vswitchIds = []string{vswitchId}
+	}
+	return validatePlanTimeInstanceRequest(meta.(*connectivity.AliyunClient), request)
+}
+
+// The update strategies of the instance, which decide the disruptive changes allowed to be applied.
+const (
+	InstanceUpdateStrategyStopRequiredAllowed = "stop_required_allowed"
+	InstanceUpdateStrategyOnlineOnly          = "online_only"
+	InstanceUpdateStrategyRecreate            = "recreate"
+)
+
+// The impacts of an update of the instance, from the least to the most disruptive.
+const (
+	InstanceUpdateImpactNone              = "none"
+	InstanceUpdateImpactOnline            = "online"
+	InstanceUpdateImpactReboot            = "reboot"
+	InstanceUpdateImpactReplaceSystemDisk = "replace_system_disk"
+	InstanceUpdateImpactRecreate          = "recreate"
+)
+
+// instanceStopRequiredKeys are the arguments which are modified in place after stopping the running instance.
+var instanceStopRequiredKeys = []string{"instance_type", "vpc_id", "vswitch_id", "private_ip", "user_data", "host_name", "password", "kms_encrypted_password"}
+
+// customizeAliCloudInstanceUpdateStrategy sets the update_impact to the most disruptive impact of the planned update, and
+// refuses the update when the update_strategy does not allow it. The changes which stop the instance are planned as a
+// replacement when the update_strategy is recreate.
+func customizeAliCloudInstanceUpdateStrategy(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	strategy := diff.Get("update_strategy").(string)
+	oldStatus, newStatus := diff.GetChange("status")
+	running := oldStatus.(string) != string(Stopped) && newStatus.(string) != string(Stopped)
+
+	var disruptive []string
+	if diff.HasChange("image_id") {
+		disruptive = append(disruptive, "image_id")
+	}
+	for _, key := range instanceStopRequiredKeys {
+		if diff.HasChange(key) && (running || strategy == InstanceUpdateStrategyRecreate) {
+			disruptive = append(disruptive, key)
+		}
+	}
+	if strategy == InstanceUpdateStrategyRecreate {
+		for _, key := range disruptive {
+			if err := diff.ForceNew(key); err != nil {
+				return WrapError(err)
+			}
+		}
+	}
+
+	impact, keys := "", []string{}
+	if recreated := changedForceNewKeys(diff, "", resourceAliCloudInstance().Schema); len(recreated) > 0 || strategy == InstanceUpdateStrategyRecreate && len(disruptive) > 0 {
+		impact, keys = InstanceUpdateImpactRecreate, append(recreated, disruptive...)
+	} else if diff.HasChange("image_id") {
+		impact, keys = InstanceUpdateImpactReplaceSystemDisk, disruptive
+	} else if len(disruptive) > 0 {
+		impact, keys = InstanceUpdateImpactReboot, disruptive
+	} else {
+		for _, key := range diff.GetChangedKeysPrefix("") {
+			key = strings.Split(key, ".")[0]
+			if key != "update_impact" && key != "update_strategy" && key != "dry_run" {
+				impact = InstanceUpdateImpactOnline
+				break
+			}
+		}
+	}
+	// The update_impact of the last applied update is kept when there is no change, otherwise it would always be planned
+	// to be none after an update.
+	if impact == "" {
+		return nil
+	}
+	if impact != diff.Get("update_impact").(string) {
+		if err := diff.SetNew("update_impact", impact); err != nil {
+			return WrapError(err)
+		}
+	}
+	if len(keys) > 0 {
+		log.Printf("[INFO] The update of the instance %s has the impact %s because of the changes of %s.", diff.Id(), impact, strings.Join(keys, ", "))
+	}
+
+	refused := false
+	switch strategy {
+	case InstanceUpdateStrategyOnlineOnly:
+		refused = impact == InstanceUpdateImpactReboot || impact == InstanceUpdateImpactReplaceSystemDisk || impact == InstanceUpdateImpactRecreate
+	case InstanceUpdateStrategyStopRequiredAllowed:
+		refused = impact == InstanceUpdateImpactRecreate
+	}
+	if refused {
+		return WrapError(Error("The update_strategy %s of the instance %s does not allow the update whose impact is %s because of the changes of %s. "+
+			"Please revert the changes or change the update_strategy.", strategy, diff.Id(), impact, strings.Join(keys, ", ")))
+	}
+	return nil
+}
+
+// changedForceNewKeys returns the changed ForceNew arguments, including the ForceNew arguments in the list blocks.
+func changedForceNewKeys(diff *schema.ResourceDiff, prefix string, schemas map[string]*schema.Schema) []string {
+	var keys []string
+	for name, s := range schemas {
+		key := prefix + name
+		if s.Computed && !s.Optional || !diff.HasChange(key) {
+			continue
+		}
+		if s.ForceNew {
+			keys = append(keys, key)
+			continue
+		}
+		elem, ok := s.Elem.(*schema.Resource)
+		if !ok || s.Type != schema.TypeList {
+			continue
+		}
+		oldValue, newValue := diff.GetChange(key)
+		for i := 0; i < len(oldValue.([]interface{})) || i < len(newValue.([]interface{})); i++ {
+			keys = append(keys, changedForceNewKeys(diff, fmt.Sprintf("%s.%d.", key, i), elem.Schema)...)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
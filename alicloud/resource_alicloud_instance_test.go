@@ -14,6 +14,9 @@ import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -4480,3 +4483,72 @@ var tags0 = map[string]string{
 	"tagY":   "valueY",
 	"tagZ":   "valueZ",
 }
+
+func TestUnitAliCloudInstanceUpdateStrategy(t *testing.T) {
+	state := func(status string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "i-mock",
+			Attributes: map[string]string{
+				"id":                "i-mock",
+				"availability_zone": "cn-hangzhou-i",
+				"image_id":          "m-old",
+				"instance_type":     "ecs.g6.large",
+				"vswitch_id":        "vsw-mock",
+				"status":            status,
+				"update_impact":     InstanceUpdateImpactNone,
+			},
+		}
+	}
+	config := func(changes map[string]interface{}) *terraform.ResourceConfig {
+		raw := map[string]interface{}{
+			"availability_zone": "cn-hangzhou-i",
+			"image_id":          "m-old",
+			"instance_type":     "ecs.g6.large",
+			"vswitch_id":        "vsw-mock",
+		}
+		for k, v := range changes {
+			raw[k] = v
+		}
+		return terraform.NewResourceConfigRaw(raw)
+	}
+	r := resourceAliCloudInstance()
+
+	diff, err := r.Diff(state("Running"), config(map[string]interface{}{"image_id": "m-new"}), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, InstanceUpdateImpactReplaceSystemDisk, diff.Attributes["update_impact"].New)
+	assert.False(t, diff.RequiresNew())
+
+	diff, err = r.Diff(state("Running"), config(map[string]interface{}{"instance_type": "ecs.g6.xlarge", "update_strategy": "stop_required_allowed"}), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, InstanceUpdateImpactReboot, diff.Attributes["update_impact"].New)
+
+	// The stopped instance is modified without stopping it.
+	diff, err = r.Diff(state("Stopped"), config(map[string]interface{}{"instance_type": "ecs.g6.xlarge", "update_strategy": "online_only"}), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, InstanceUpdateImpactOnline, diff.Attributes["update_impact"].New)
+
+	_, err = r.Diff(state("Running"), config(map[string]interface{}{"instance_type": "ecs.g6.xlarge", "update_strategy": "online_only"}), nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "impact is reboot because of the changes of instance_type")
+
+	_, err = r.Diff(state("Running"), config(map[string]interface{}{"availability_zone": "cn-hangzhou-j", "update_strategy": "stop_required_allowed"}), nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "impact is recreate because of the changes of availability_zone")
+
+	diff, err = r.Diff(state("Running"), config(map[string]interface{}{"image_id": "m-new", "update_strategy": "recreate"}), nil)
+	assert.Nil(t, err)
+	assert.True(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["image_id"].RequiresNew)
+
+	// The impact of the last applied update is kept when there is no change.
+	diff, err = r.Diff(nil, config(nil), nil)
+	assert.Nil(t, err)
+	d, err := schema.InternalMap(r.Schema).Data(nil, diff)
+	assert.Nil(t, err)
+	d.SetId("i-mock")
+	d.Set("status", "Running")
+	d.Set("update_impact", InstanceUpdateImpactReboot)
+	diff, err = r.Diff(d.State(), config(nil), nil)
+	assert.Nil(t, err)
+	assert.True(t, diff == nil || diff.Attributes["update_impact"] == nil)
+}
@@ -573,7 +573,7 @@ func resourceAliCloudKmsKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && d.HasChanges("tags", "tags_all") {
 		if err := kmsServiceV2.SetResourceTags(d, "key"); err != nil {
 			return WrapError(err)
 		}
@@ -461,7 +461,7 @@ func resourceAliCloudKmsSecretUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("description")
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && d.HasChanges("tags", "tags_all") {
 		if err := kmsService.SetResourceTags(d, "secret"); err != nil {
 			return WrapError(err)
 		}
@@ -619,7 +619,7 @@ func resourceAliCloudLindormInstanceUpdate(d *schema.ResourceData, meta interfac
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := hitsdbService.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
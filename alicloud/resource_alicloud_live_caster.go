@@ -323,7 +323,7 @@ func resourceAliCloudLiveCasterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		liveServiceV2 := LiveServiceV2{client}
 		if err := liveServiceV2.SetResourceTags(d, ""); err != nil {
 			return WrapError(err)
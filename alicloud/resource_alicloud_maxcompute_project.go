@@ -767,7 +767,7 @@ func resourceAliCloudMaxComputeProjectUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		maxComputeServiceV2 := MaxComputeServiceV2{client}
 		if err := maxComputeServiceV2.SetResourceTags(d, "project"); err != nil {
 			return WrapError(err)
@@ -197,7 +197,7 @@ func resourceAliCloudMessageServiceTopicUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		messageServiceServiceV2 := MessageServiceServiceV2{client}
 		if err := messageServiceServiceV2.SetResourceTags(d, "topic"); err != nil {
 			return WrapError(err)
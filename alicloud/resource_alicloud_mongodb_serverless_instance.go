@@ -262,7 +262,7 @@ func resourceAlicloudMongodbServerlessInstanceUpdate(d *schema.ResourceData, met
 	MongoDBService := MongoDBService{client}
 	var response map[string]interface{}
 	d.Partial(true)
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := MongoDBService.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
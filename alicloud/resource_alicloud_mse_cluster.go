@@ -414,7 +414,7 @@ func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	update = false
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 		mseServiceV2 := MseService{client}
 		if err := mseServiceV2.SetResourceTags(d, "CLUSTER"); err != nil {
@@ -608,7 +608,7 @@ func resourceAliCloudVpcNetworkAclUpdate(d *schema.ResourceData, meta interface{
 		}
 
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "NETWORKACL"); err != nil {
 			return WrapError(err)
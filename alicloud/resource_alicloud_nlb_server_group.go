@@ -553,7 +553,7 @@ func resourceAliCloudNlbServerGroupUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		nlbServiceV2 := NlbServiceV2{client}
 		if err := nlbServiceV2.SetResourceTags(d, "servergroup"); err != nil {
 			return WrapError(err)
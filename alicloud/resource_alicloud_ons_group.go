@@ -148,7 +148,7 @@ func resourceAlicloudOnsGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := onsService.SetResourceTags(d, "GROUP"); err != nil {
 			return WrapError(err)
 		}
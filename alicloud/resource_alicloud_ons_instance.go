@@ -139,7 +139,7 @@ func resourceAlicloudOnsInstanceUpdate(d *schema.ResourceData, meta interface{})
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := onsService.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
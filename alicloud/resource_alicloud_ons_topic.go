@@ -151,7 +151,7 @@ func resourceAlicloudOnsTopicRead(d *schema.ResourceData, meta interface{}) erro
 func resourceAlicloudOnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	onsService := OnsService{client}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := onsService.SetResourceTags(d, "TOPIC"); err != nil {
 			return WrapError(err)
 		}
@@ -159,7 +159,7 @@ func resourceAlicloudOosParameterUpdate(d *schema.ResourceData, meta interface{}
 			request["ResourceGroupId"] = v
 		}
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 		if v, ok := d.GetOk("tags"); ok {
 			respJson, err := convertMaptoJsonString(v.(map[string]interface{}))
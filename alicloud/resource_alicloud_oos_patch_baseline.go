@@ -315,7 +315,7 @@ func resourceAliCloudOosPatchBaselineUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		oosServiceV2 := OosServiceV2{client}
 		if err := oosServiceV2.SetResourceTags(d, "patchbaseline"); err != nil {
 			return WrapError(err)
@@ -223,7 +223,7 @@ func resourceAliCloudOosSecretParameterUpdate(d *schema.ResourceData, meta inter
 		request["Description"] = d.Get("description")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 		if v, ok := d.GetOk("tags"); ok {
 			if v, err := convertMaptoJsonString(v.(map[string]interface{})); err == nil {
@@ -182,7 +182,7 @@ func resourceAlicloudOosTemplateUpdate(d *schema.ResourceData, meta interface{})
 	}
 	request["Content"] = d.Get("content")
 	request["RegionId"] = client.RegionId
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 		respJson, err := convertMaptoJsonString(d.Get("tags").(map[string]interface{}))
 		if err != nil {
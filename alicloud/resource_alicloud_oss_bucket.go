@@ -23,6 +23,7 @@ func resourceAlicloudOssBucket() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAlicloudOssBucketCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -593,6 +594,21 @@ func resourceAlicloudOssBucketCreate(d *schema.ResourceData, meta interface{}) e
 	return resourceAlicloudOssBucketUpdate(d, meta)
 }
 
+// resourceAlicloudOssBucketCustomizeDiff refuses to delete the lifecycle rules of the bucket when the lifecycle_rule is
+// not configured, because the rules are usually managed by the alicloud_oss_bucket_lifecycle, and removing them from the
+// bucket would delete the rules of the lifecycle resource.
+func resourceAlicloudOssBucketCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("lifecycle_rule") {
+		return nil
+	}
+	o, n := diff.GetChange("lifecycle_rule")
+	if len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0 {
+		return fmt.Errorf("the bucket %s has %d lifecycle rules which are not in the lifecycle_rule, and they may be managed by the alicloud_oss_bucket_lifecycle. "+
+			"Please add the lifecycle_rule to the ignore_changes of the bucket, or delete the rules by the alicloud_oss_bucket_lifecycle", diff.Id(), len(o.([]interface{})))
+	}
+	return nil
+}
+
 func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	ossService := OssService{client}
@@ -1073,7 +1089,7 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("server_side_encryption_rule")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := resourceAlicloudOssBucketTaggingUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
@@ -0,0 +1,365 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceAliCloudOssBucketInventory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliCloudOssBucketInventoryCreate,
+		Read:   resourceAliCloudOssBucketInventoryRead,
+		Update: resourceAliCloudOssBucketInventoryUpdate,
+		Delete: resourceAliCloudOssBucketInventoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"inventory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"included_object_versions": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: StringInSlice([]string{"All", "Current"}, false),
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: StringInSlice([]string{"Daily", "Weekly"}, false),
+			},
+			"optional_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: StringInSlice([]string{"Size", "LastModifiedDate", "ETag", "StorageClass", "IsMultipartUploaded", "EncryptionStatus", "ObjectAcl", "TaggingCount", "ObjectType", "Crc64"}, false),
+				},
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"last_modify_begin_time_stamp": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"last_modify_end_time_stamp": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"lower_size_bound": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"upper_size_bound": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "CSV",
+							ValidateFunc: StringInSlice([]string{"CSV"}, false),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sse_oss": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ConflictsWith: []string{"destination.0.sse_kms_key_id"},
+						},
+						"sse_kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAliCloudOssBucketInventoryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ossServiceV2 := OssServiceV2{client}
+	id := fmt.Sprintf("%v:%v", d.Get("bucket"), d.Get("inventory_id"))
+
+	// Putting the inventory overwrites the one with the same ID, which is managed by another alicloud_oss_bucket_inventory.
+	if _, err := ossServiceV2.DescribeOssBucketInventory(id); err == nil {
+		return WrapError(Error("The inventory %s already exists in the bucket %s. Please import it by terraform import.", d.Get("inventory_id"), d.Get("bucket")))
+	} else if !IsNotFoundError(err) {
+		return WrapError(err)
+	}
+
+	if err := putOssBucketInventory(d, client, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_oss_bucket_inventory", "PutBucketInventory", AlibabaCloudSdkGoERROR)
+	}
+
+	d.SetId(id)
+
+	return resourceAliCloudOssBucketInventoryRead(d, meta)
+}
+
+func resourceAliCloudOssBucketInventoryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ossServiceV2 := OssServiceV2{client}
+
+	objectRaw, err := ossServiceV2.DescribeOssBucketInventory(d.Id())
+	if err != nil {
+		if !d.IsNewResource() && IsNotFoundError(err) {
+			log.Printf("[DEBUG] Resource alicloud_oss_bucket_inventory DescribeOssBucketInventory Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("is_enabled", objectRaw["IsEnabled"])
+	d.Set("included_object_versions", objectRaw["IncludedObjectVersions"])
+	if schedule, ok := objectRaw["Schedule"].(map[string]interface{}); ok {
+		d.Set("frequency", schedule["Frequency"])
+	}
+
+	optionalFields := make([]interface{}, 0)
+	if fields, ok := objectRaw["OptionalFields"].(map[string]interface{}); ok {
+		optionalFields = ossXmlList(fields["Field"])
+	}
+	d.Set("optional_fields", optionalFields)
+
+	filterMaps := make([]map[string]interface{}, 0)
+	if filter, ok := objectRaw["Filter"].(map[string]interface{}); ok && len(filter) > 0 {
+		filterMaps = append(filterMaps, map[string]interface{}{
+			"prefix":                       filter["Prefix"],
+			"last_modify_begin_time_stamp": filter["LastModifyBeginTimeStamp"],
+			"last_modify_end_time_stamp":   filter["LastModifyEndTimeStamp"],
+			"lower_size_bound":             filter["LowerSizeBound"],
+			"upper_size_bound":             filter["UpperSizeBound"],
+			"storage_class":                filter["StorageClass"],
+		})
+	}
+	if err := d.Set("filter", filterMaps); err != nil {
+		return err
+	}
+
+	destinationMaps := make([]map[string]interface{}, 0)
+	if destination, ok := objectRaw["Destination"].(map[string]interface{}); ok {
+		if ossBucketDestination, ok := destination["OSSBucketDestination"].(map[string]interface{}); ok {
+			destinationMap := map[string]interface{}{
+				"bucket":     ossBucketDestination["Bucket"],
+				"account_id": ossBucketDestination["AccountId"],
+				"role_arn":   ossBucketDestination["RoleArn"],
+				"format":     ossBucketDestination["Format"],
+				"prefix":     ossBucketDestination["Prefix"],
+			}
+			if encryption, ok := ossBucketDestination["Encryption"].(map[string]interface{}); ok {
+				if _, ok := encryption["SSE-OSS"]; ok {
+					destinationMap["sse_oss"] = true
+				}
+				if kms, ok := encryption["SSE-KMS"].(map[string]interface{}); ok {
+					destinationMap["sse_kms_key_id"] = kms["KeyId"]
+				}
+			}
+			destinationMaps = append(destinationMaps, destinationMap)
+		}
+	}
+	if err := d.Set("destination", destinationMaps); err != nil {
+		return err
+	}
+
+	parts := strings.Split(d.Id(), ":")
+	d.Set("bucket", parts[0])
+	d.Set("inventory_id", parts[1])
+
+	return nil
+}
+
+func resourceAliCloudOssBucketInventoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	if d.HasChanges("is_enabled", "included_object_versions", "frequency", "optional_fields", "filter", "destination") {
+		if err := putOssBucketInventory(d, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutBucketInventory", AlibabaCloudSdkGoERROR)
+		}
+	}
+
+	return resourceAliCloudOssBucketInventoryRead(d, meta)
+}
+
+func resourceAliCloudOssBucketInventoryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	parts := strings.Split(d.Id(), ":")
+	action := fmt.Sprintf("/?inventory")
+	var request map[string]interface{}
+	var response map[string]interface{}
+	query := make(map[string]*string)
+	hostMap := make(map[string]*string)
+	var err error
+	request = make(map[string]interface{})
+	hostMap["bucket"] = StringPointer(parts[0])
+	query["inventoryId"] = StringPointer(parts[1])
+
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		response, err = client.Do("Oss", xmlParam("DELETE", "2019-05-17", "DeleteBucketInventory", action), query, nil, nil, hostMap, false)
+		if err != nil {
+			if IsRetryableError("Oss", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
+	}
+
+	return nil
+}
+
+func putOssBucketInventory(d *schema.ResourceData, client *connectivity.AliyunClient, id string, timeout time.Duration) error {
+	parts := strings.Split(id, ":")
+	action := fmt.Sprintf("/?inventory")
+	var response map[string]interface{}
+	query := make(map[string]*string)
+	hostMap := make(map[string]*string)
+	hostMap["bucket"] = StringPointer(parts[0])
+	query["inventoryId"] = StringPointer(parts[1])
+
+	configuration := map[string]interface{}{
+		"Id":                     parts[1],
+		"IsEnabled":              d.Get("is_enabled"),
+		"IncludedObjectVersions": d.Get("included_object_versions"),
+		"Schedule": map[string]interface{}{
+			"Frequency": d.Get("frequency"),
+		},
+	}
+	if v := d.Get("optional_fields").(*schema.Set).List(); len(v) > 0 {
+		configuration["OptionalFields"] = map[string]interface{}{
+			"Field": v,
+		}
+	}
+	if v, ok := firstOssLifecycleBlock(d.Get("filter")); ok {
+		filter := make(map[string]interface{})
+		if prefix := v["prefix"].(string); prefix != "" {
+			filter["Prefix"] = prefix
+		}
+		for key, field := range map[string]string{
+			"last_modify_begin_time_stamp": "LastModifyBeginTimeStamp",
+			"last_modify_end_time_stamp":   "LastModifyEndTimeStamp",
+			"lower_size_bound":             "LowerSizeBound",
+			"upper_size_bound":             "UpperSizeBound",
+		} {
+			if value := v[key].(int); value > 0 {
+				filter[field] = value
+			}
+		}
+		if storageClass := v["storage_class"].(string); storageClass != "" {
+			filter["StorageClass"] = storageClass
+		}
+		configuration["Filter"] = filter
+	}
+	if v, ok := firstOssLifecycleBlock(d.Get("destination")); ok {
+		ossBucketDestination := map[string]interface{}{
+			"Format":    v["format"],
+			"AccountId": v["account_id"],
+			"RoleArn":   v["role_arn"],
+			"Bucket":    v["bucket"],
+		}
+		if prefix := v["prefix"].(string); prefix != "" {
+			ossBucketDestination["Prefix"] = prefix
+		}
+		if keyId := v["sse_kms_key_id"].(string); keyId != "" {
+			ossBucketDestination["Encryption"] = map[string]interface{}{
+				"SSE-KMS": map[string]interface{}{"KeyId": keyId},
+			}
+		} else if v["sse_oss"].(bool) {
+			ossBucketDestination["Encryption"] = map[string]interface{}{
+				"SSE-OSS": "",
+			}
+		}
+		configuration["Destination"] = map[string]interface{}{
+			"OSSBucketDestination": ossBucketDestination,
+		}
+	}
+
+	request := map[string]interface{}{
+		"InventoryConfiguration": configuration,
+	}
+	var err error
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		response, err = client.Do("Oss", xmlParam("PUT", "2019-05-17", "PutBucketInventory", action), query, request, nil, hostMap, false)
+		if err != nil {
+			if IsRetryableError("Oss", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	return err
+}
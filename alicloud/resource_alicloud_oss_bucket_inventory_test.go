@@ -0,0 +1,142 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccAliCloudOssBucketInventory_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alicloud_oss_bucket_inventory.default"
+	ra := resourceAttrInit(resourceId, AlicloudOssBucketInventoryMap)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &OssServiceV2{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}, "DescribeOssBucketInventory")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%sossbucketinventory%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlicloudOssBucketInventoryBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket":                   "${alicloud_oss_bucket.default.bucket}",
+					"inventory_id":             "report",
+					"is_enabled":               "true",
+					"included_object_versions": "All",
+					"frequency":                "Daily",
+					"destination": []map[string]interface{}{
+						{
+							"bucket":     "acs:oss:::${alicloud_oss_bucket.destination.bucket}",
+							"account_id": "${data.alicloud_account.current.id}",
+							"role_arn":   "${alicloud_ram_role.default.arn}",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"bucket":                   CHECKSET,
+						"inventory_id":             "report",
+						"is_enabled":               "true",
+						"included_object_versions": "All",
+						"frequency":                "Daily",
+						"destination.0.format":     "CSV",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"is_enabled":               "false",
+					"included_object_versions": "Current",
+					"frequency":                "Weekly",
+					"optional_fields":          []string{"Size", "LastModifiedDate", "StorageClass"},
+					"filter": []map[string]interface{}{
+						{
+							"prefix":           "data/",
+							"lower_size_bound": "1024",
+						},
+					},
+					"destination": []map[string]interface{}{
+						{
+							"bucket":     "acs:oss:::${alicloud_oss_bucket.destination.bucket}",
+							"account_id": "${data.alicloud_account.current.id}",
+							"role_arn":   "${alicloud_ram_role.default.arn}",
+							"prefix":     "inventory/",
+							"sse_oss":    "true",
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"is_enabled":                "false",
+						"included_object_versions":  "Current",
+						"frequency":                 "Weekly",
+						"optional_fields.#":         "3",
+						"filter.0.prefix":           "data/",
+						"filter.0.lower_size_bound": "1024",
+						"destination.0.prefix":      "inventory/",
+						"destination.0.sse_oss":     "true",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+var AlicloudOssBucketInventoryMap = map[string]string{}
+
+func AlicloudOssBucketInventoryBasicDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+    default = "%s"
+}
+
+data "alicloud_account" "current" {
+}
+
+resource "alicloud_oss_bucket" "default" {
+  bucket = var.name
+}
+
+resource "alicloud_oss_bucket" "destination" {
+  bucket = "${var.name}-inventory"
+}
+
+resource "alicloud_ram_role" "default" {
+  name     = var.name
+  document = <<EOF
+  {
+    "Statement": [
+      {
+        "Action": "sts:AssumeRole",
+        "Effect": "Allow",
+        "Principal": {
+          "Service": [
+            "oss.aliyuncs.com"
+          ]
+        }
+      }
+    ],
+    "Version": "1"
+  }
+  EOF
+  force    = true
+}
+`, name)
+}
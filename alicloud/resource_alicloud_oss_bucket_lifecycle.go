@@ -0,0 +1,584 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceAliCloudOssBucketLifecycle() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliCloudOssBucketLifecycleCreate,
+		Read:   resourceAliCloudOssBucketLifecycleRead,
+		Update: resourceAliCloudOssBucketLifecycleUpdate,
+		Delete: resourceAliCloudOssBucketLifecycleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"allow_same_action_overlap": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: StringInSlice([]string{"Enabled", "Disabled"}, false),
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"created_before_date": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"transition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"created_before_date": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: StringInSlice([]string{"IA", "Archive", "ColdArchive", "DeepColdArchive"}, false),
+									},
+									"is_access_time": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"return_to_std_when_visit": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"abort_multipart_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"created_before_date": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: StringInSlice([]string{"IA", "Archive", "ColdArchive", "DeepColdArchive"}, false),
+									},
+									"is_access_time": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"return_to_std_when_visit": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"object_size_greater_than": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"object_size_less_than": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"not": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"tag": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"key": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"value": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAliCloudOssBucketLifecycleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ossServiceV2 := OssServiceV2{client}
+	bucket := d.Get("bucket").(string)
+
+	// The lifecycle of a bucket is a single configuration, and putting it overwrites the rules managed by the lifecycle_rule
+	// of the alicloud_oss_bucket or another alicloud_oss_bucket_lifecycle.
+	object, err := ossServiceV2.DescribeOssBucketLifecycle(bucket)
+	if err != nil && !IsNotFoundError(err) {
+		return WrapError(err)
+	}
+	if err == nil && len(ossXmlList(object["Rule"])) > 0 {
+		return WrapError(Error("The bucket %s already has %d lifecycle rules, which may be managed by the lifecycle_rule of the alicloud_oss_bucket or another alicloud_oss_bucket_lifecycle. "+
+			"Please remove the lifecycle_rule from the alicloud_oss_bucket and ignore its changes with the lifecycle ignore_changes, or import the rules by terraform import.", bucket, len(ossXmlList(object["Rule"]))))
+	}
+
+	if err := putOssBucketLifecycle(d, client, bucket, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_oss_bucket_lifecycle", "PutBucketLifecycle", AlibabaCloudSdkGoERROR)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAliCloudOssBucketLifecycleRead(d, meta)
+}
+
+func resourceAliCloudOssBucketLifecycleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ossServiceV2 := OssServiceV2{client}
+
+	objectRaw, err := ossServiceV2.DescribeOssBucketLifecycle(d.Id())
+	if err != nil {
+		if !d.IsNewResource() && IsNotFoundError(err) {
+			log.Printf("[DEBUG] Resource alicloud_oss_bucket_lifecycle DescribeOssBucketLifecycle Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	ruleMaps := make([]map[string]interface{}, 0)
+	for _, ruleChildRaw := range ossXmlList(objectRaw["Rule"]) {
+		ruleChild, ok := ruleChildRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ruleMap := make(map[string]interface{})
+		ruleMap["id"] = ruleChild["ID"]
+		ruleMap["prefix"] = ruleChild["Prefix"]
+		ruleMap["status"] = ruleChild["Status"]
+
+		tags := make(map[string]interface{})
+		for _, tagRaw := range ossXmlList(ruleChild["Tag"]) {
+			if tag, ok := tagRaw.(map[string]interface{}); ok {
+				tags[fmt.Sprint(tag["Key"])] = tag["Value"]
+			}
+		}
+		ruleMap["tags"] = tags
+
+		expirationMaps := make([]map[string]interface{}, 0)
+		if expiration, ok := ruleChild["Expiration"].(map[string]interface{}); ok {
+			expirationMaps = append(expirationMaps, map[string]interface{}{
+				"days":                         expiration["Days"],
+				"created_before_date":          expiration["CreatedBeforeDate"],
+				"expired_object_delete_marker": expiration["ExpiredObjectDeleteMarker"],
+			})
+		}
+		ruleMap["expiration"] = expirationMaps
+
+		transitionMaps := make([]map[string]interface{}, 0)
+		for _, transitionRaw := range ossXmlList(ruleChild["Transition"]) {
+			if transition, ok := transitionRaw.(map[string]interface{}); ok {
+				transitionMaps = append(transitionMaps, map[string]interface{}{
+					"days":                     transition["Days"],
+					"created_before_date":      transition["CreatedBeforeDate"],
+					"storage_class":            transition["StorageClass"],
+					"is_access_time":           transition["IsAccessTime"],
+					"return_to_std_when_visit": transition["ReturnToStdWhenVisit"],
+				})
+			}
+		}
+		ruleMap["transition"] = transitionMaps
+
+		abortMultipartUploadMaps := make([]map[string]interface{}, 0)
+		if abortMultipartUpload, ok := ruleChild["AbortMultipartUpload"].(map[string]interface{}); ok {
+			abortMultipartUploadMaps = append(abortMultipartUploadMaps, map[string]interface{}{
+				"days":                abortMultipartUpload["Days"],
+				"created_before_date": abortMultipartUpload["CreatedBeforeDate"],
+			})
+		}
+		ruleMap["abort_multipart_upload"] = abortMultipartUploadMaps
+
+		noncurrentVersionExpirationMaps := make([]map[string]interface{}, 0)
+		if noncurrentVersionExpiration, ok := ruleChild["NoncurrentVersionExpiration"].(map[string]interface{}); ok {
+			noncurrentVersionExpirationMaps = append(noncurrentVersionExpirationMaps, map[string]interface{}{
+				"noncurrent_days": noncurrentVersionExpiration["NoncurrentDays"],
+			})
+		}
+		ruleMap["noncurrent_version_expiration"] = noncurrentVersionExpirationMaps
+
+		noncurrentVersionTransitionMaps := make([]map[string]interface{}, 0)
+		for _, transitionRaw := range ossXmlList(ruleChild["NoncurrentVersionTransition"]) {
+			if transition, ok := transitionRaw.(map[string]interface{}); ok {
+				noncurrentVersionTransitionMaps = append(noncurrentVersionTransitionMaps, map[string]interface{}{
+					"noncurrent_days":          transition["NoncurrentDays"],
+					"storage_class":            transition["StorageClass"],
+					"is_access_time":           transition["IsAccessTime"],
+					"return_to_std_when_visit": transition["ReturnToStdWhenVisit"],
+				})
+			}
+		}
+		ruleMap["noncurrent_version_transition"] = noncurrentVersionTransitionMaps
+
+		filterMaps := make([]map[string]interface{}, 0)
+		if filter, ok := ruleChild["Filter"].(map[string]interface{}); ok {
+			filterMap := map[string]interface{}{
+				"object_size_greater_than": filter["ObjectSizeGreaterThan"],
+				"object_size_less_than":    filter["ObjectSizeLessThan"],
+			}
+			notMaps := make([]map[string]interface{}, 0)
+			if not, ok := filter["Not"].(map[string]interface{}); ok {
+				notMap := map[string]interface{}{
+					"prefix": not["Prefix"],
+				}
+				tagMaps := make([]map[string]interface{}, 0)
+				if tag, ok := not["Tag"].(map[string]interface{}); ok {
+					tagMaps = append(tagMaps, map[string]interface{}{
+						"key":   tag["Key"],
+						"value": tag["Value"],
+					})
+				}
+				notMap["tag"] = tagMaps
+				notMaps = append(notMaps, notMap)
+			}
+			filterMap["not"] = notMaps
+			filterMaps = append(filterMaps, filterMap)
+		}
+		ruleMap["filter"] = filterMaps
+
+		ruleMaps = append(ruleMaps, ruleMap)
+	}
+	if err := d.Set("rule", ruleMaps); err != nil {
+		return err
+	}
+
+	d.Set("bucket", d.Id())
+
+	return nil
+}
+
+func resourceAliCloudOssBucketLifecycleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	if d.HasChanges("rule", "allow_same_action_overlap") {
+		if err := putOssBucketLifecycle(d, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutBucketLifecycle", AlibabaCloudSdkGoERROR)
+		}
+	}
+
+	return resourceAliCloudOssBucketLifecycleRead(d, meta)
+}
+
+func resourceAliCloudOssBucketLifecycleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	action := fmt.Sprintf("/?lifecycle")
+	var request map[string]interface{}
+	var response map[string]interface{}
+	query := make(map[string]*string)
+	hostMap := make(map[string]*string)
+	var err error
+	request = make(map[string]interface{})
+	hostMap["bucket"] = StringPointer(d.Id())
+
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		response, err = client.Do("Oss", xmlParam("DELETE", "2019-05-17", "DeleteBucketLifecycle", action), query, nil, nil, hostMap, false)
+		if err != nil {
+			if IsRetryableError("Oss", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
+	}
+
+	return nil
+}
+
+func putOssBucketLifecycle(d *schema.ResourceData, client *connectivity.AliyunClient, bucket string, timeout time.Duration) error {
+	action := fmt.Sprintf("/?lifecycle")
+	var response map[string]interface{}
+	query := make(map[string]*string)
+	headers := make(map[string]*string)
+	hostMap := make(map[string]*string)
+	hostMap["bucket"] = StringPointer(bucket)
+	if d.Get("allow_same_action_overlap").(bool) {
+		headers["x-oss-allow-same-action-overlap"] = StringPointer("true")
+	}
+
+	rules := make([]interface{}, 0)
+	for _, ruleRaw := range d.Get("rule").([]interface{}) {
+		ruleArg, ok := ruleRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rule := map[string]interface{}{
+			"Prefix": ruleArg["prefix"],
+			"Status": ruleArg["status"],
+		}
+		if v := fmt.Sprint(ruleArg["id"]); v != "" {
+			rule["ID"] = v
+		}
+		tags := make([]interface{}, 0)
+		for key, value := range ruleArg["tags"].(map[string]interface{}) {
+			tags = append(tags, map[string]interface{}{"Key": key, "Value": value})
+		}
+		if len(tags) > 0 {
+			rule["Tag"] = tags
+		}
+		if v, ok := firstOssLifecycleBlock(ruleArg["expiration"]); ok {
+			expiration := make(map[string]interface{})
+			if days := v["days"].(int); days > 0 {
+				expiration["Days"] = days
+			}
+			if date := v["created_before_date"].(string); date != "" {
+				expiration["CreatedBeforeDate"] = date
+			}
+			if v["expired_object_delete_marker"].(bool) {
+				expiration["ExpiredObjectDeleteMarker"] = true
+			}
+			rule["Expiration"] = expiration
+		}
+		transitions := make([]interface{}, 0)
+		for _, transitionRaw := range ruleArg["transition"].([]interface{}) {
+			v, ok := transitionRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			transition := map[string]interface{}{
+				"StorageClass": v["storage_class"],
+			}
+			if days := v["days"].(int); days > 0 {
+				transition["Days"] = days
+			}
+			if date := v["created_before_date"].(string); date != "" {
+				transition["CreatedBeforeDate"] = date
+			}
+			if v["is_access_time"].(bool) {
+				transition["IsAccessTime"] = true
+				transition["ReturnToStdWhenVisit"] = v["return_to_std_when_visit"]
+			}
+			transitions = append(transitions, transition)
+		}
+		if len(transitions) > 0 {
+			rule["Transition"] = transitions
+		}
+		if v, ok := firstOssLifecycleBlock(ruleArg["abort_multipart_upload"]); ok {
+			abortMultipartUpload := make(map[string]interface{})
+			if days := v["days"].(int); days > 0 {
+				abortMultipartUpload["Days"] = days
+			}
+			if date := v["created_before_date"].(string); date != "" {
+				abortMultipartUpload["CreatedBeforeDate"] = date
+			}
+			rule["AbortMultipartUpload"] = abortMultipartUpload
+		}
+		if v, ok := firstOssLifecycleBlock(ruleArg["noncurrent_version_expiration"]); ok {
+			rule["NoncurrentVersionExpiration"] = map[string]interface{}{
+				"NoncurrentDays": v["noncurrent_days"],
+			}
+		}
+		noncurrentVersionTransitions := make([]interface{}, 0)
+		for _, transitionRaw := range ruleArg["noncurrent_version_transition"].([]interface{}) {
+			v, ok := transitionRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			transition := map[string]interface{}{
+				"NoncurrentDays": v["noncurrent_days"],
+				"StorageClass":   v["storage_class"],
+			}
+			if v["is_access_time"].(bool) {
+				transition["IsAccessTime"] = true
+				transition["ReturnToStdWhenVisit"] = v["return_to_std_when_visit"]
+			}
+			noncurrentVersionTransitions = append(noncurrentVersionTransitions, transition)
+		}
+		if len(noncurrentVersionTransitions) > 0 {
+			rule["NoncurrentVersionTransition"] = noncurrentVersionTransitions
+		}
+		if v, ok := firstOssLifecycleBlock(ruleArg["filter"]); ok {
+			filter := make(map[string]interface{})
+			if size := v["object_size_greater_than"].(int); size > 0 {
+				filter["ObjectSizeGreaterThan"] = size
+			}
+			if size := v["object_size_less_than"].(int); size > 0 {
+				filter["ObjectSizeLessThan"] = size
+			}
+			if not, ok := firstOssLifecycleBlock(v["not"]); ok {
+				notMap := map[string]interface{}{
+					"Prefix": not["prefix"],
+				}
+				if tag, ok := firstOssLifecycleBlock(not["tag"]); ok {
+					notMap["Tag"] = map[string]interface{}{
+						"Key":   tag["key"],
+						"Value": tag["value"],
+					}
+				}
+				filter["Not"] = notMap
+			}
+			rule["Filter"] = filter
+		}
+		rules = append(rules, rule)
+	}
+
+	request := map[string]interface{}{
+		"LifecycleConfiguration": map[string]interface{}{
+			"Rule": rules,
+		},
+	}
+	var err error
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		response, err = client.Do("Oss", xmlParam("PUT", "2019-05-17", "PutBucketLifecycle", action), query, request, headers, hostMap, false)
+		if err != nil {
+			if IsRetryableError("Oss", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	return err
+}
+
+func firstOssLifecycleBlock(v interface{}) (map[string]interface{}, bool) {
+	blocks, ok := v.([]interface{})
+	if !ok || len(blocks) == 0 {
+		return nil, false
+	}
+	block, ok := blocks[0].(map[string]interface{})
+	return block, ok
+}
+
+// ossXmlList returns the repeated XML elements as a list, which is a single value when there is only one element.
+func ossXmlList(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return v
+	}
+	return []interface{}{v}
+}
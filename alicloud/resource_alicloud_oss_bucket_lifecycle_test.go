@@ -0,0 +1,159 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAliCloudOssBucketLifecycle_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alicloud_oss_bucket_lifecycle.default"
+	ra := resourceAttrInit(resourceId, AlicloudOssBucketLifecycleMap)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &OssServiceV2{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}, "DescribeOssBucketLifecycle")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%sossbucketlifecycle%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlicloudOssBucketLifecycleBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket": "${alicloud_oss_bucket.default.bucket}",
+					"rule": []map[string]interface{}{
+						{
+							"id":     "log",
+							"prefix": "log/",
+							"status": "Enabled",
+							"expiration": []map[string]interface{}{
+								{
+									"days": "365",
+								},
+							},
+							"transition": []map[string]interface{}{
+								{
+									"days":          "30",
+									"storage_class": "IA",
+								},
+							},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"bucket":                                 CHECKSET,
+						"rule.#":                                 "1",
+						"rule.0.id":                              "log",
+						"rule.0.expiration.0.days":               "365",
+						"rule.0.transition.0.storage_class":      "IA",
+						"rule.0.abort_multipart_upload.#":        "0",
+						"rule.0.noncurrent_version_transition.#": "0",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"allow_same_action_overlap": "true",
+					"rule": []map[string]interface{}{
+						{
+							"id":     "log",
+							"prefix": "log/",
+							"status": "Disabled",
+							"abort_multipart_upload": []map[string]interface{}{
+								{
+									"days": "7",
+								},
+							},
+						},
+						{
+							"id":     "tmp",
+							"status": "Enabled",
+							"tags": map[string]string{
+								"temporary": "true",
+							},
+							"expiration": []map[string]interface{}{
+								{
+									"days": "1",
+								},
+							},
+							"filter": []map[string]interface{}{
+								{
+									"object_size_less_than": "1024",
+									"not": []map[string]interface{}{
+										{
+											"prefix": "tmp/keep/",
+										},
+									},
+								},
+							},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"allow_same_action_overlap":             "true",
+						"rule.#":                                "2",
+						"rule.0.status":                         "Disabled",
+						"rule.0.abort_multipart_upload.0.days":  "7",
+						"rule.1.tags.%":                         "1",
+						"rule.1.filter.0.object_size_less_than": "1024",
+						"rule.1.filter.0.not.0.prefix":          "tmp/keep/",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_same_action_overlap"},
+			},
+		},
+	})
+}
+
+var AlicloudOssBucketLifecycleMap = map[string]string{}
+
+func AlicloudOssBucketLifecycleBasicDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+    default = "%s"
+}
+
+resource "alicloud_oss_bucket" "default" {
+  bucket = var.name
+  lifecycle {
+    ignore_changes = [
+      lifecycle_rule,
+    ]
+  }
+}
+`, name)
+}
+
+func TestUnitAliCloudOssBucketLifecycleXmlList(t *testing.T) {
+	rule := map[string]interface{}{"ID": "log"}
+	assert.Nil(t, ossXmlList(nil))
+	assert.Equal(t, []interface{}{rule}, ossXmlList(rule))
+	assert.Equal(t, []interface{}{"Size"}, ossXmlList("Size"))
+	assert.Equal(t, []interface{}{rule, rule}, ossXmlList([]interface{}{rule, rule}))
+
+	block, ok := firstOssLifecycleBlock([]interface{}{rule})
+	assert.True(t, ok)
+	assert.Equal(t, rule, block)
+	_, ok = firstOssLifecycleBlock([]interface{}{})
+	assert.False(t, ok)
+	_, ok = firstOssLifecycleBlock([]interface{}{nil})
+	assert.False(t, ok)
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -18,10 +20,11 @@ import (
 
 func resourceAlicloudOssBucketObject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlicloudOssBucketObjectPut,
-		Read:   resourceAlicloudOssBucketObjectRead,
-		Update: resourceAlicloudOssBucketObjectPut,
-		Delete: resourceAlicloudOssBucketObjectDelete,
+		Create:        resourceAlicloudOssBucketObjectPut,
+		Read:          resourceAlicloudOssBucketObjectRead,
+		Update:        resourceAlicloudOssBucketObjectUpdate,
+		Delete:        resourceAlicloudOssBucketObjectDelete,
+		CustomizeDiff: resourceAlicloudOssBucketObjectCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -48,6 +51,43 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 				ConflictsWith: []string{"source"},
 			},
 
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: IntBetween(1, 5*1024*1024),
+			},
+
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: IntBetween(1, 5*1024),
+			},
+
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: IntBetween(1, 100),
+			},
+
+			"checkpoint_dir": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateOssObjectMetadata,
+			},
+
+			"tags": tagsSchema(),
+
 			"acl": {
 				Type:         schema.TypeString,
 				Default:      oss.ACLPrivate,
@@ -135,6 +175,7 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 	bucket, _ := raw.(*oss.Bucket)
 	var filePath string
 	var body io.Reader
+	var size int64
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -144,6 +185,11 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 		}
 
 		filePath = path
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return WrapError(err)
+		}
+		size = info.Size()
 	} else if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
 		body = bytes.NewReader([]byte(content))
@@ -162,11 +208,34 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 		options = append(options, oss.ServerSideEncryptionKeyID(v.(string)))
 	}
 
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		options = append(options, oss.Meta(k, v.(string)))
+	}
+
+	if tagging := ossObjectTagging(d.Get("tags").(map[string]interface{})); len(tagging.Tags) > 0 {
+		options = append(options, oss.SetTagging(tagging))
+	}
+
 	if err != nil {
 		return WrapError(err)
 	}
 	if filePath != "" {
-		err = bucket.PutObjectFromFile(key, filePath, options...)
+		// The large file is uploaded in parts concurrently, and the upload is resumed from the checkpoint of the last
+		// failed upload when the checkpoint_dir is set.
+		if ossObjectMultipartUpload(d, size) {
+			options = append(options, oss.Routines(ossObjectUploadSize(d, "upload_concurrency", OssObjectDefaultUploadConcurrency)))
+			if v, ok := d.GetOk("checkpoint_dir"); ok {
+				dir, err := homedir.Expand(v.(string))
+				if err != nil {
+					return WrapError(err)
+				}
+				options = append(options, oss.CheckpointDir(true, dir))
+			}
+			partSize := int64(ossObjectUploadSize(d, "part_size", OssObjectDefaultPartSize)) * 1024 * 1024
+			err = bucket.UploadFile(key, filePath, partSize, options...)
+		} else {
+			err = bucket.PutObjectFromFile(key, filePath, options...)
+		}
 	}
 
 	if body != nil {
@@ -220,6 +289,91 @@ func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{
 	d.Set("etag", strings.Trim(object.Get("ETag"), `"`))
 	d.Set("version_id", object.Get("x-oss-version-id"))
 
+	metadata := make(map[string]interface{})
+	metaPrefix := strings.ToLower(oss.HTTPHeaderOssMetaPrefix)
+	for k := range object {
+		if strings.HasPrefix(strings.ToLower(k), metaPrefix) {
+			metadata[strings.TrimPrefix(strings.ToLower(k), metaPrefix)] = object.Get(k)
+		}
+	}
+	d.Set("metadata", metadata)
+
+	// The tagging is only read when the tags are managed, so that the objects without tags do not require the permission
+	// oss:GetObjectTagging.
+	if len(expandTagsMap(d.Get("tags"))) == 0 && len(expandTagsMap(d.Get("tags_all"))) == 0 {
+		return nil
+	}
+	tagging, err := bucket.GetObjectTagging(d.Get("key").(string))
+	if err != nil {
+		if IsExpectedErrors(err, []string{"AccessDenied"}) {
+			log.Printf("[WARN] Getting the tagging of the object %s got an error, and the tags are not refreshed: %v", d.Id(), err)
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetObjectTagging", AliyunOssGoSdk)
+	}
+	addDebug("GetObjectTagging", tagging, requestInfo, map[string]interface{}{
+		"objectKey": d.Get("key").(string),
+	})
+	tags := make(map[string]interface{})
+	for _, tag := range tagging.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
+
+// resourceAlicloudOssBucketObjectUpdate uploads the object again when its content or headers change, and only updates
+// the tagging when nothing else changes. The upload arguments do not upload the object again.
+func resourceAlicloudOssBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("source", "content", "source_hash", "acl", "content_type", "cache_control", "content_disposition", "content_encoding",
+		"content_md5", "expires", "server_side_encryption", "kms_key_id", "metadata") {
+		return resourceAlicloudOssBucketObjectPut(d, meta)
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		client := meta.(*connectivity.AliyunClient)
+		var requestInfo *oss.Client
+		raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+			requestInfo = ossClient
+			return ossClient.Bucket(d.Get("bucket").(string))
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "Bucket", AliyunOssGoSdk)
+		}
+		addDebug("Bucket", raw, requestInfo, map[string]string{"bucketName": d.Get("bucket").(string)})
+		bucket, _ := raw.(*oss.Bucket)
+
+		action := "PutObjectTagging"
+		tagging := ossObjectTagging(d.Get("tags").(map[string]interface{}))
+		if len(tagging.Tags) > 0 {
+			err = bucket.PutObjectTagging(d.Id(), tagging)
+		} else {
+			action = "DeleteObjectTagging"
+			err = bucket.DeleteObjectTagging(d.Id())
+		}
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AliyunOssGoSdk)
+		}
+		addDebug(action, tagging, requestInfo, map[string]interface{}{
+			"objectKey": d.Id(),
+		})
+	}
+
+	return resourceAlicloudOssBucketObjectRead(d, meta)
+}
+
+// resourceAlicloudOssBucketObjectCustomizeDiff marks the attributes of the uploaded object as unknown when the object is
+// uploaded again, so that the attributes which depend on them are updated in the same apply.
+func resourceAlicloudOssBucketObjectCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("source") && !diff.HasChange("content") && !diff.HasChange("source_hash") {
+		return nil
+	}
+	for _, key := range []string{"etag", "version_id", "content_length"} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return WrapError(err)
+		}
+	}
 	return nil
 }
 
@@ -249,6 +403,51 @@ func resourceAlicloudOssBucketObjectDelete(d *schema.ResourceData, meta interfac
 
 }
 
+// The default upload arguments of the object, the sizes are in MiB.
+const (
+	OssObjectDefaultMultipartThreshold = 100
+	OssObjectDefaultPartSize           = 10
+	OssObjectDefaultUploadConcurrency  = 3
+)
+
+// ossObjectMultipartUpload reports whether the source file of the size in bytes is uploaded in parts. The file with the
+// content_md5 is always uploaded by one request, because the ETag of the multipart upload is not the MD5 of the content.
+func ossObjectMultipartUpload(d *schema.ResourceData, size int64) bool {
+	if _, ok := d.GetOk("content_md5"); ok {
+		return false
+	}
+	return size >= int64(ossObjectUploadSize(d, "multipart_threshold", OssObjectDefaultMultipartThreshold))*1024*1024
+}
+
+func ossObjectUploadSize(d *schema.ResourceData, key string, defaultValue int) int {
+	if v, ok := d.GetOk(key); ok {
+		return v.(int)
+	}
+	return defaultValue
+}
+
+func ossObjectTagging(tags map[string]interface{}) oss.Tagging {
+	var tagging oss.Tagging
+	for k, v := range tags {
+		tagging.Tags = append(tagging.Tags, oss.Tag{
+			Key:   k,
+			Value: v.(string),
+		})
+	}
+	return tagging
+}
+
+// validateOssObjectMetadata checks the keys of the user metadata, which are saved in lowercase by OSS.
+func validateOssObjectMetadata(v interface{}, k string) (ws []string, errors []error) {
+	pattern := regexp.MustCompile(`^[a-z0-9\-_]+$`)
+	for key := range v.(map[string]interface{}) {
+		if !pattern.MatchString(key) {
+			errors = append(errors, fmt.Errorf("%q contains the invalid key %q, which must only contain lowercase letters, digits, hyphens (-) and underscores (_)", k, key))
+		}
+	}
+	return
+}
+
 func buildObjectHeaderOptions(d *schema.ResourceData) (options []oss.Option, err error) {
 
 	if v, ok := d.GetOk("acl"); ok {
@@ -0,0 +1,203 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceAliCloudOssBucketObjectLockConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliCloudOssBucketObjectLockConfigurationCreate,
+		Read:   resourceAliCloudOssBucketObjectLockConfigurationRead,
+		Update: resourceAliCloudOssBucketObjectLockConfigurationUpdate,
+		Delete: resourceAliCloudOssBucketObjectLockConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"object_lock_enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Enabled",
+				ValidateFunc: StringInSlice([]string{"Enabled"}, false),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_retention": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: StringInSlice([]string{"GOVERNANCE", "COMPLIANCE"}, false),
+									},
+									"days": {
+										Type:          schema.TypeInt,
+										Optional:      true,
+										ValidateFunc:  IntBetween(1, 36500),
+										ConflictsWith: []string{"rule.0.default_retention.0.years"},
+									},
+									"years": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: IntBetween(1, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAliCloudOssBucketObjectLockConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	if err := putOssBucketObjectLockConfiguration(d, client, d.Get("bucket").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_oss_bucket_object_lock_configuration", "PutBucketObjectWormConfiguration", AlibabaCloudSdkGoERROR)
+	}
+
+	d.SetId(fmt.Sprint(d.Get("bucket")))
+
+	return resourceAliCloudOssBucketObjectLockConfigurationRead(d, meta)
+}
+
+func resourceAliCloudOssBucketObjectLockConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ossServiceV2 := OssServiceV2{client}
+
+	objectRaw, err := ossServiceV2.DescribeOssBucketObjectLockConfiguration(d.Id())
+	if err != nil {
+		if !d.IsNewResource() && IsNotFoundError(err) {
+			log.Printf("[DEBUG] Resource alicloud_oss_bucket_object_lock_configuration DescribeOssBucketObjectLockConfiguration Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("object_lock_enabled", objectRaw["ObjectWormEnabled"])
+
+	ruleMaps := make([]map[string]interface{}, 0)
+	if rule, ok := objectRaw["Rule"].(map[string]interface{}); ok {
+		if defaultRetention, ok := rule["DefaultRetention"].(map[string]interface{}); ok {
+			defaultRetentionMap := map[string]interface{}{
+				"mode": defaultRetention["Mode"],
+			}
+			if v, ok := defaultRetention["Days"]; ok {
+				defaultRetentionMap["days"] = formatInt(v)
+			}
+			if v, ok := defaultRetention["Years"]; ok {
+				defaultRetentionMap["years"] = formatInt(v)
+			}
+			ruleMaps = append(ruleMaps, map[string]interface{}{
+				"default_retention": []map[string]interface{}{defaultRetentionMap},
+			})
+		}
+	}
+	if err := d.Set("rule", ruleMaps); err != nil {
+		return err
+	}
+
+	d.Set("bucket", d.Id())
+
+	return nil
+}
+
+func resourceAliCloudOssBucketObjectLockConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	if d.HasChanges("object_lock_enabled", "rule") {
+		if err := putOssBucketObjectLockConfiguration(d, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutBucketObjectWormConfiguration", AlibabaCloudSdkGoERROR)
+		}
+	}
+
+	return resourceAliCloudOssBucketObjectLockConfigurationRead(d, meta)
+}
+
+func resourceAliCloudOssBucketObjectLockConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	// The object lock can not be disabled once it is enabled, so only the default retention is removed.
+	d.Set("rule", []interface{}{})
+	if err := putOssBucketObjectLockConfiguration(d, client, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, []string{"NoSuchBucket"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutBucketObjectWormConfiguration", AlibabaCloudSdkGoERROR)
+	}
+
+	return nil
+}
+
+func putOssBucketObjectLockConfiguration(d *schema.ResourceData, client *connectivity.AliyunClient, bucket string, timeout time.Duration) error {
+	action := fmt.Sprintf("/?objectWorm")
+	var response map[string]interface{}
+	query := make(map[string]*string)
+	hostMap := make(map[string]*string)
+	hostMap["bucket"] = StringPointer(bucket)
+
+	configuration := map[string]interface{}{
+		"ObjectWormEnabled": d.Get("object_lock_enabled"),
+	}
+	if rule, ok := firstOssLifecycleBlock(d.Get("rule")); ok {
+		if v, ok := firstOssLifecycleBlock(rule["default_retention"]); ok {
+			defaultRetention := map[string]interface{}{
+				"Mode": v["mode"],
+			}
+			if days := v["days"].(int); days > 0 {
+				defaultRetention["Days"] = days
+			}
+			if years := v["years"].(int); years > 0 {
+				defaultRetention["Years"] = years
+			}
+			configuration["Rule"] = map[string]interface{}{
+				"DefaultRetention": defaultRetention,
+			}
+		}
+	}
+
+	request := map[string]interface{}{
+		"ObjectWormConfiguration": configuration,
+	}
+	var err error
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		response, err = client.Do("Oss", xmlParam("PUT", "2019-05-17", "PutBucketObjectWormConfiguration", action), query, request, nil, hostMap, false)
+		if err != nil {
+			if IsRetryableError("Oss", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	return err
+}
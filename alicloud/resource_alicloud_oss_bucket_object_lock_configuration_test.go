@@ -0,0 +1,112 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccAliCloudOssBucketObjectLockConfiguration_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alicloud_oss_bucket_object_lock_configuration.default"
+	ra := resourceAttrInit(resourceId, AlicloudOssBucketObjectLockConfigurationMap)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &OssServiceV2{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}, "DescribeOssBucketObjectLockConfiguration")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%sossbucketobjectlock%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlicloudOssBucketObjectLockConfigurationBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket": "${alicloud_oss_bucket_versioning.default.bucket}",
+					"rule": []map[string]interface{}{
+						{
+							"default_retention": []map[string]interface{}{
+								{
+									"mode": "GOVERNANCE",
+									"days": "1",
+								},
+							},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"bucket":                           CHECKSET,
+						"object_lock_enabled":              "Enabled",
+						"rule.0.default_retention.0.mode":  "GOVERNANCE",
+						"rule.0.default_retention.0.days":  "1",
+						"rule.0.default_retention.0.years": "0",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"rule": []map[string]interface{}{
+						{
+							"default_retention": []map[string]interface{}{
+								{
+									"mode":  "GOVERNANCE",
+									"years": "1",
+								},
+							},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"rule.0.default_retention.0.days":  "0",
+						"rule.0.default_retention.0.years": "1",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"rule": REMOVEKEY,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"rule.#": "0",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+var AlicloudOssBucketObjectLockConfigurationMap = map[string]string{}
+
+func AlicloudOssBucketObjectLockConfigurationBasicDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+    default = "%s"
+}
+
+resource "alicloud_oss_bucket" "default" {
+  bucket = var.name
+}
+
+resource "alicloud_oss_bucket_versioning" "default" {
+  bucket = alicloud_oss_bucket.default.bucket
+  status = "Enabled"
+}
+`, name)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccAlicloudOssBucketObject_basic(t *testing.T) {
@@ -95,6 +96,39 @@ func TestAccAlicloudOssBucketObject_basic(t *testing.T) {
 					testAccCheck(map[string]string{}),
 				),
 			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"metadata": map[string]string{
+						"release": "v1",
+					},
+					"tags": map[string]string{
+						"Created": "TF",
+						"For":     "Test",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"metadata.%":       "1",
+						"metadata.release": "v1",
+						"tags.%":           "2",
+						"tags.Created":     "TF",
+						"tags.For":         "Test",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"tags": map[string]string{
+						"Created": "TF-update",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"tags.%":       "1",
+						"tags.Created": "TF-update",
+					}),
+				),
+			},
 			{
 				Config: testAccConfig(map[string]interface{}{
 					"bucket":                 "${alicloud_oss_bucket.default.bucket}",
@@ -105,6 +139,9 @@ func TestAccAlicloudOssBucketObject_basic(t *testing.T) {
 					"source":                 tmpFile.Name(),
 					"content_type":           "binary/octet-stream",
 					"acl":                    REMOVEKEY,
+					"source_hash":            "v1",
+					"metadata":               REMOVEKEY,
+					"tags":                   REMOVEKEY,
 				}),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAlicloudOssBucketObjectExists(
@@ -116,6 +153,9 @@ func TestAccAlicloudOssBucketObject_basic(t *testing.T) {
 						"source":       tmpFile.Name(),
 						"content_type": "binary/octet-stream",
 						"acl":          "private",
+						"source_hash":  "v1",
+						"metadata.%":   "0",
+						"tags.%":       "0",
 					}),
 				),
 			},
@@ -123,6 +163,27 @@ func TestAccAlicloudOssBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestUnitAlicloudOssBucketObjectMetadata(t *testing.T) {
+	_, errs := validateOssObjectMetadata(map[string]interface{}{"release": "v1", "build_id": "1", "model-name": "m"}, "metadata")
+	assert.Empty(t, errs)
+	_, errs = validateOssObjectMetadata(map[string]interface{}{"Release": "v1", "build id": "1"}, "metadata")
+	assert.Len(t, errs, 2)
+
+	tagging := ossObjectTagging(map[string]interface{}{"Created": "TF"})
+	assert.Equal(t, []oss.Tag{{Key: "Created", Value: "TF"}}, tagging.Tags)
+	assert.Empty(t, ossObjectTagging(map[string]interface{}{}).Tags)
+
+	d := schema.TestResourceDataRaw(t, resourceAlicloudOssBucketObject().Schema, map[string]interface{}{
+		"multipart_threshold": 10,
+	})
+	assert.False(t, ossObjectMultipartUpload(d, 10*1024*1024-1))
+	assert.True(t, ossObjectMultipartUpload(d, 10*1024*1024))
+	d = schema.TestResourceDataRaw(t, resourceAlicloudOssBucketObject().Schema, map[string]interface{}{
+		"content_md5": "md5",
+	})
+	assert.False(t, ossObjectMultipartUpload(d, 200*1024*1024))
+}
+
 func resourceOssBucketObjectConfigDependence(name string) string {
 
 	return fmt.Sprintf(`
@@ -12,6 +12,8 @@ import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -2715,3 +2717,32 @@ var ossBucketBasicMap = map[string]string{
 	"creation_date":    CHECKSET,
 	"lifecycle_rule.#": "0",
 }
+
+func TestUnitAlicloudOssBucketLifecycleRuleConflict(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "bucket-mock",
+		Attributes: map[string]string{
+			"id":                            "bucket-mock",
+			"bucket":                        "bucket-mock",
+			"lifecycle_rule.#":              "1",
+			"lifecycle_rule.0.id":           "rule-mock",
+			"lifecycle_rule.0.prefix":       "logs/",
+			"lifecycle_rule.0.enabled":      "true",
+			"lifecycle_rule.0.expiration.#": "0",
+		},
+	}
+	r := resourceAlicloudOssBucket()
+
+	// The rules which are not in the lifecycle_rule are not deleted.
+	_, err := r.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{"bucket": "bucket-mock"}), nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "alicloud_oss_bucket_lifecycle")
+
+	_, err = r.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"bucket": "bucket-mock",
+		"lifecycle_rule": []interface{}{
+			map[string]interface{}{"id": "rule-mock", "prefix": "data/", "enabled": true},
+		},
+	}), nil)
+	assert.Nil(t, err)
+}
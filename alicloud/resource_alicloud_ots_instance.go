@@ -305,11 +305,12 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("accessed_by")
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if !d.IsNewResource() && d.HasChanges("tags", "tags_all") {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		remove = withoutProviderIgnoredTags(client, remove)
 
 		if len(remove) > 0 {
 			var removeKeys []string
@@ -351,7 +351,7 @@ func resourceAliCloudRamPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		ramServiceV2 := RamServiceV2{client}
 		if err := ramServiceV2.SetResourceTags(d, "policy"); err != nil {
 			return WrapError(err)
@@ -573,7 +573,7 @@ func resourceAliCloudRdsCustomUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		rdsServiceV2 := RdsServiceV2{client}
 		if err := rdsServiceV2.SetResourceTags(d, "Custom"); err != nil {
 			return WrapError(err)
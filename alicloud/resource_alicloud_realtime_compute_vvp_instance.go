@@ -394,7 +394,7 @@ func resourceAliCloudRealtimeComputeVvpInstanceUpdate(d *schema.ResourceData, me
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		realtimeComputeServiceV2 := RealtimeComputeServiceV2{client}
 		if err := realtimeComputeServiceV2.SetResourceTags(d, "vvpinstance"); err != nil {
 			return WrapError(err)
@@ -1088,7 +1088,7 @@ func resourceAliCloudRedisTairInstanceUpdate(d *schema.ResourceData, meta interf
 
 		}
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		redisServiceV2 := RedisServiceV2{client}
 		if err := redisServiceV2.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
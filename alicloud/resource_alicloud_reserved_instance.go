@@ -256,7 +256,7 @@ func resourceAliCloudReservedInstanceUpdate(d *schema.ResourceData, meta interfa
 	var err error
 	ecsService := EcsService{client}
 	d.Partial(true)
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := ecsService.SetResourceTags(d, "reservedinstance"); err != nil {
 			return WrapError(err)
 		}
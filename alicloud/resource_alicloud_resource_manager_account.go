@@ -281,7 +281,7 @@ func resourceAliCloudResourceManagerAccountUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		resourceManagerServiceV2 := ResourceManagerServiceV2{client}
 		if err := resourceManagerServiceV2.SetResourceTags(d, "Account"); err != nil {
 			return WrapError(err)
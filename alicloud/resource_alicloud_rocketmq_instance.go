@@ -984,7 +984,7 @@ func resourceAliCloudRocketmqInstanceUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		rocketmqServiceV2 := RocketmqServiceV2{client}
 		if err := rocketmqServiceV2.SetResourceTags(d, "instance"); err != nil {
 			return WrapError(err)
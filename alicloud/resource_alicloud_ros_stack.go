@@ -295,7 +295,7 @@ func resourceAlicloudRosStackUpdate(d *schema.ResourceData, meta interface{}) er
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := rosService.SetResourceTags(d, "stack"); err != nil {
 			return WrapError(err)
 		}
@@ -222,7 +222,7 @@ func resourceAliCloudVpcRouteTableUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "ROUTETABLE"); err != nil {
 			return WrapError(err)
@@ -2157,7 +2157,7 @@ func resourceAliCloudSaeApplicationUpdate(d *schema.ResourceData, meta interface
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := saeService.SetResourceTags(d, "application"); err != nil {
 			return WrapError(err)
 		}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"github.com/PaesslerAG/jsonpath"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
@@ -26,6 +28,13 @@ func resourceAliCloudEcsSecurityGroup() *schema.Resource {
 			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: dryRunCreate{
+			resourceName: "alicloud_security_group",
+			product:      "Ecs",
+			version:      "2014-05-26",
+			keys:         []string{"vpc_id", "resource_group_id"},
+			request:      buildAliCloudEcsSecurityGroupCreateRequest,
+		}.customizeDiff,
 		Schema: map[string]*schema.Schema{
 			"create_time": {
 				Type:     schema.TypeString,
@@ -83,6 +92,8 @@ func resourceAliCloudEcsSecurityGroup() *schema.Resource {
 				Computed:   true,
 				Deprecated: "Field `inner_access` has been deprecated from provider version 1.55.3. New field `inner_access_policy` instead.",
 			},
+			"ingress": securityGroupPermissionsSchema(),
+			"egress":  securityGroupPermissionsSchema(),
 		},
 	}
 }
@@ -91,12 +102,38 @@ func resourceAliCloudEcsSecurityGroupCreate(d *schema.ResourceData, meta interfa
 
 	client := meta.(*connectivity.AliyunClient)
 
-	action := "CreateSecurityGroup"
-	var request map[string]interface{}
 	var response map[string]interface{}
 	query := make(map[string]interface{})
-	var err error
-	request = make(map[string]interface{})
+	action, request, err := buildAliCloudEcsSecurityGroupCreateRequest(d, client)
+	if err != nil {
+		return WrapError(err)
+	}
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_security_group", action, AlibabaCloudSdkGoERROR)
+	}
+
+	d.SetId(fmt.Sprint(response["SecurityGroupId"]))
+
+	return resourceAliCloudEcsSecurityGroupUpdate(d, meta)
+}
+
+func buildAliCloudEcsSecurityGroupCreateRequest(d schemaGetter, client *connectivity.AliyunClient) (string, map[string]interface{}, error) {
+	action := "CreateSecurityGroup"
+	request := make(map[string]interface{})
 	request["RegionId"] = client.RegionId
 	request["ClientToken"] = buildClientToken(action)
 
@@ -122,27 +159,7 @@ func resourceAliCloudEcsSecurityGroupCreate(d *schema.ResourceData, meta interfa
 	if v, ok := d.GetOk("resource_group_id"); ok {
 		request["ResourceGroupId"] = v
 	}
-	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-	addDebug(action, response, request)
-
-	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "alicloud_security_group", action, AlibabaCloudSdkGoERROR)
-	}
-
-	d.SetId(fmt.Sprint(response["SecurityGroupId"]))
-
-	return resourceAliCloudEcsSecurityGroupUpdate(d, meta)
+	return action, request, nil
 }
 
 func resourceAliCloudEcsSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
@@ -192,6 +209,15 @@ func resourceAliCloudEcsSecurityGroupRead(d *schema.ResourceData, meta interface
 		d.Set("inner_access", fmt.Sprint(objectRaw["InnerAccessPolicy"]) == string(GroupInnerAccept))
 	}
 
+	permissionsRaw, _ := jsonpath.Get("$.Permissions.Permission", objectRaw)
+	for _, direction := range []string{"ingress", "egress"} {
+		permissions := make([]map[string]interface{}, 0)
+		for _, permission := range securityGroupPermissions(direction, permissionsRaw) {
+			permissions = append(permissions, permission.rule)
+		}
+		d.Set(direction, permissions)
+	}
+
 	return nil
 }
 
@@ -332,11 +358,22 @@ func resourceAliCloudEcsSecurityGroupUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && d.HasChanges("tags", "tags_all") {
 		if err := ecsServiceV2.SetResourceTags(d, "securitygroup"); err != nil {
 			return WrapError(err)
 		}
 	}
+
+	// The rules are only managed when the ingress or egress is configured, and then the rules which are not configured,
+	// like the ones added in the console, are revoked. An empty set, like ingress = [], revokes all the rules.
+	for _, direction := range []string{"ingress", "egress"} {
+		if !d.HasChange(direction) {
+			continue
+		}
+		if err := updateAliCloudEcsSecurityGroupPermissions(client, d.Id(), direction, d.Get(direction).(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+	}
 	d.Partial(false)
 	return resourceAliCloudEcsSecurityGroupRead(d, meta)
 }
@@ -377,3 +414,222 @@ func resourceAliCloudEcsSecurityGroupDelete(d *schema.ResourceData, meta interfa
 
 	return nil
 }
+
+// securityGroupPermissionFields are the API fields of the rule arguments which depend on the direction.
+var securityGroupPermissionFields = map[string]map[string]string{
+	"ingress": {
+		"cidr_ip":                  "SourceCidrIp",
+		"ipv6_cidr_ip":             "Ipv6SourceCidrIp",
+		"source_security_group_id": "SourceGroupId",
+		"prefix_list_id":           "SourcePrefixListId",
+	},
+	"egress": {
+		"cidr_ip":                  "DestCidrIp",
+		"ipv6_cidr_ip":             "Ipv6DestCidrIp",
+		"source_security_group_id": "DestGroupId",
+		"prefix_list_id":           "DestPrefixListId",
+	},
+}
+
+func securityGroupPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		// The attribute syntax allows ingress = [] to revoke all the rules of the direction
+		ConfigMode: schema.SchemaConfigModeAttr,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip_protocol": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: StringInSlice([]string{"tcp", "udp", "icmp", "icmpv6", "gre", "all"}, false),
+				},
+				"port_range": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "-1/-1",
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "accept",
+					ValidateFunc: StringInSlice([]string{"accept", "drop"}, false),
+				},
+				"priority": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: IntBetween(1, 100),
+				},
+				"cidr_ip": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"ipv6_cidr_ip": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"source_security_group_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"prefix_list_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+type securityGroupPermission struct {
+	ruleId string
+	rule   map[string]interface{}
+}
+
+// securityGroupPermissions returns the rules of the direction in the Permissions of DescribeSecurityGroupAttribute.
+func securityGroupPermissions(direction string, permissionsRaw interface{}) []securityGroupPermission {
+	permissionsList, _ := permissionsRaw.([]interface{})
+	permissions := make([]securityGroupPermission, 0, len(permissionsList))
+	for _, permissionRaw := range permissionsList {
+		permission, ok := permissionRaw.(map[string]interface{})
+		if !ok || fmt.Sprint(permission["Direction"]) != direction {
+			continue
+		}
+		rule := map[string]interface{}{
+			"ip_protocol": strings.ToLower(fmt.Sprint(permission["IpProtocol"])),
+			"port_range":  fmt.Sprint(permission["PortRange"]),
+			"policy":      strings.ToLower(fmt.Sprint(permission["Policy"])),
+			"priority":    formatInt(permission["Priority"]),
+			"description": "",
+		}
+		if v, ok := permission["Description"].(string); ok {
+			rule["description"] = v
+		}
+		for key, field := range securityGroupPermissionFields[direction] {
+			rule[key] = ""
+			if v, ok := permission[field].(string); ok {
+				rule[key] = v
+			}
+		}
+		permissions = append(permissions, securityGroupPermission{ruleId: fmt.Sprint(permission["SecurityGroupRuleId"]), rule: rule})
+	}
+	return permissions
+}
+
+// securityGroupPermissionKey identifies a rule by all of its arguments except the description, which can be modified.
+func securityGroupPermissionKey(rule map[string]interface{}) string {
+	return fmt.Sprintf("%v:%v:%v:%v:%v:%v:%v:%v", rule["ip_protocol"], rule["port_range"], rule["policy"], rule["priority"],
+		rule["cidr_ip"], rule["ipv6_cidr_ip"], rule["source_security_group_id"], rule["prefix_list_id"])
+}
+
+// updateAliCloudEcsSecurityGroupPermissions makes the rules of the direction the same as the desired ones. The rules are
+// compared with the ones described at present rather than the state, so that the rules added out of band are revoked.
+func updateAliCloudEcsSecurityGroupPermissions(client *connectivity.AliyunClient, id, direction string, desired []interface{}, timeout time.Duration) error {
+	ecsServiceV2 := EcsServiceV2{client}
+	objectRaw, err := ecsServiceV2.DescribeSecurityGroupDescribeSecurityGroupAttribute(id)
+	if err != nil {
+		return WrapError(err)
+	}
+	permissionsRaw, _ := jsonpath.Get("$.Permissions.Permission", objectRaw)
+	current := make(map[string]securityGroupPermission)
+	for _, permission := range securityGroupPermissions(direction, permissionsRaw) {
+		current[securityGroupPermissionKey(permission.rule)] = permission
+	}
+
+	var authorized []map[string]interface{}
+	modified := make(map[string]string)
+	desiredKeys := make(map[string]bool)
+	for _, v := range desired {
+		rule := v.(map[string]interface{})
+		key := securityGroupPermissionKey(rule)
+		desiredKeys[key] = true
+		if permission, ok := current[key]; ok {
+			if fmt.Sprint(permission.rule["description"]) != fmt.Sprint(rule["description"]) {
+				modified[permission.ruleId] = fmt.Sprint(rule["description"])
+			}
+			continue
+		}
+		authorized = append(authorized, rule)
+	}
+	var revoked []string
+	for key, permission := range current {
+		if !desiredKeys[key] {
+			revoked = append(revoked, permission.ruleId)
+		}
+	}
+	sort.Strings(revoked)
+
+	authorizeAction, revokeAction, modifyAction := "AuthorizeSecurityGroup", "RevokeSecurityGroup", "ModifySecurityGroupRule"
+	if direction == "egress" {
+		authorizeAction, revokeAction, modifyAction = "AuthorizeSecurityGroupEgress", "RevokeSecurityGroupEgress", "ModifySecurityGroupEgressRule"
+	}
+	invoke := func(action string, request map[string]interface{}) error {
+		var response map[string]interface{}
+		var err error
+		request["SecurityGroupId"] = id
+		request["RegionId"] = client.RegionId
+		wait := incrementalWait(3*time.Second, 5*time.Second)
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			response, err = client.RpcPost("Ecs", "2014-05-26", action, nil, request, true)
+			if err != nil {
+				if IsRetryableError("Ecs", err) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		addDebug(action, response, request)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
+		}
+		return nil
+	}
+
+	// The rules are revoked first, so that the rules which are replaced do not conflict with the new ones.
+	for start := 0; start < len(revoked); start += 100 {
+		request := make(map[string]interface{})
+		for i, ruleId := range revoked[start:min(start+100, len(revoked))] {
+			request[fmt.Sprintf("SecurityGroupRuleId.%d", i+1)] = ruleId
+		}
+		if err := invoke(revokeAction, request); err != nil {
+			return err
+		}
+	}
+	for start := 0; start < len(authorized); start += 100 {
+		request := map[string]interface{}{
+			"ClientToken": buildClientToken(authorizeAction),
+		}
+		for i, rule := range authorized[start:min(start+100, len(authorized))] {
+			prefix := fmt.Sprintf("Permissions.%d.", i+1)
+			request[prefix+"IpProtocol"] = rule["ip_protocol"]
+			request[prefix+"PortRange"] = rule["port_range"]
+			request[prefix+"Policy"] = rule["policy"]
+			request[prefix+"Priority"] = rule["priority"]
+			if v := fmt.Sprint(rule["description"]); v != "" {
+				request[prefix+"Description"] = v
+			}
+			for key, field := range securityGroupPermissionFields[direction] {
+				if v := fmt.Sprint(rule[key]); v != "" {
+					request[prefix+field] = v
+				}
+			}
+		}
+		if err := invoke(authorizeAction, request); err != nil {
+			return err
+		}
+	}
+	for ruleId, description := range modified {
+		if err := invoke(modifyAction, map[string]interface{}{"SecurityGroupRuleId": ruleId, "Description": description}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ func resourceAliyunSecurityGroupRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceAliyunSecurityGroupRuleCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -120,13 +121,56 @@ func resourceAliyunSecurityGroupRule() *schema.Resource {
 
 func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	ecsService := EcsService{client}
 	var response map[string]interface{}
+	action, request, err := buildAliyunSecurityGroupRuleAuthorizeRequest(d, client)
+	if err != nil {
+		return err
+	}
+	direction := d.Get("type").(string)
+	permissionsMap := request["Permissions"].([]map[string]interface{})[0]
+
 	var cidrIp string
+	if v, ok := d.GetOk("cidr_ip"); ok {
+		cidrIp = v.(string)
+	}
+	if v, ok := d.GetOk("ipv6_cidr_ip"); ok {
+		cidrIp = strings.Replace(v.(string), ":", "_", -1)
+	}
+	if v, ok := d.GetOk("source_security_group_id"); ok {
+		cidrIp = v.(string)
+	}
+
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(client.GetRetryTimeout(d.Timeout(schema.TimeoutCreate)), func() *resource.RetryError {
+		response, err = client.RpcPost("Ecs", "2014-05-26", action, nil, request, true)
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_security_group_rule", action, AlibabaCloudSdkGoERROR)
+	}
+
+	if len(cidrIp) != 0 {
+		d.SetId(fmt.Sprintf("%v:%v:%v:%v:%v:%v:%v:%v", request["SecurityGroupId"], direction, permissionsMap["IpProtocol"], permissionsMap["PortRange"], permissionsMap["NicType"], cidrIp, permissionsMap["Policy"], permissionsMap["Priority"]))
+	} else {
+		d.SetId(fmt.Sprintf("%v:%v:%v:%v:%v:%v:%v:%v", request["SecurityGroupId"], direction, permissionsMap["IpProtocol"], permissionsMap["PortRange"], permissionsMap["NicType"], d.Get("prefix_list_id"), permissionsMap["Policy"], permissionsMap["Priority"]))
+	}
+
+	return resourceAliyunSecurityGroupRuleRead(d, meta)
+}
+
+func buildAliyunSecurityGroupRuleAuthorizeRequest(d schemaGetter, client *connectivity.AliyunClient) (string, map[string]interface{}, error) {
+	ecsService := EcsService{client}
 	var sourceSecurityGroupId string
-	var prefixListId string
 	request := make(map[string]interface{})
-	var err error
 
 	request["RegionId"] = client.RegionId
 
@@ -148,7 +192,6 @@ func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interfac
 	}
 
 	if v, ok := d.GetOk("cidr_ip"); ok {
-		cidrIp = v.(string)
 		if direction == string(DirectionIngress) {
 			permissionsMap["SourceCidrIp"] = v
 		} else {
@@ -157,7 +200,6 @@ func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interfac
 	}
 
 	if v, ok := d.GetOk("ipv6_cidr_ip"); ok {
-		cidrIp = strings.Replace(v.(string), ":", "_", -1)
 		if direction == string(DirectionIngress) {
 			permissionsMap["Ipv6SourceCidrIp"] = v
 		} else {
@@ -166,7 +208,6 @@ func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interfac
 	}
 
 	if v, ok := d.GetOk("source_security_group_id"); ok {
-		cidrIp = v.(string)
 		sourceSecurityGroupId = v.(string)
 		if direction == string(DirectionIngress) {
 			permissionsMap["SourceGroupId"] = v
@@ -184,7 +225,6 @@ func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interfac
 	}
 
 	if v, ok := d.GetOk("prefix_list_id"); ok {
-		prefixListId = v.(string)
 		if direction == string(DirectionIngress) {
 			permissionsMap["SourcePrefixListId"] = v
 		} else {
@@ -197,22 +237,22 @@ func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interfac
 
 		if permissionsMap["IpProtocol"].(string) == string(Tcp) || permissionsMap["IpProtocol"].(string) == string(Udp) {
 			if v.(string) == AllPortRange {
-				return fmt.Errorf(" 'tcp' and 'udp' can support port range: [1, 65535]. Please correct it and try again.")
+				return "", nil, fmt.Errorf(" 'tcp' and 'udp' can support port range: [1, 65535]. Please correct it and try again.")
 			}
 		} else if v.(string) != AllPortRange {
-			return fmt.Errorf(" 'icmp', 'gre' and 'all' only support port range '-1/-1'. Please correct it and try again.")
+			return "", nil, fmt.Errorf(" 'icmp', 'gre' and 'all' only support port range '-1/-1'. Please correct it and try again.")
 		}
 	}
 
 	securityGroup, err := ecsService.DescribeSecurityGroup(securityGroupId)
 	if err != nil {
-		return WrapError(err)
+		return "", nil, WrapError(err)
 	}
 
 	if v, ok := d.GetOk("nic_type"); ok {
 		if securityGroup.VpcId != "" || sourceSecurityGroupId != "" {
 			if GroupRuleNicType(v.(string)) != GroupRuleIntranet {
-				return fmt.Errorf(" When security group in the vpc or authorizing permission for source/destination security group, the nic_type must be 'intranet'.")
+				return "", nil, fmt.Errorf(" When security group in the vpc or authorizing permission for source/destination security group, the nic_type must be 'intranet'.")
 			}
 		}
 
@@ -227,53 +267,27 @@ func resourceAliyunSecurityGroupRuleCreate(d *schema.ResourceData, meta interfac
 	request["Permissions"] = permissionsMaps
 
 	if direction == string(DirectionIngress) {
-		action := "AuthorizeSecurityGroup"
-		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(client.GetRetryTimeout(d.Timeout(schema.TimeoutCreate)), func() *resource.RetryError {
-			response, err = client.RpcPost("Ecs", "2014-05-26", action, nil, request, true)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		addDebug(action, response, request)
-
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alicloud_security_group_rule", action, AlibabaCloudSdkGoERROR)
-		}
-	} else {
-		action := "AuthorizeSecurityGroupEgress"
-
-		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(client.GetRetryTimeout(d.Timeout(schema.TimeoutCreate)), func() *resource.RetryError {
-			response, err = client.RpcPost("Ecs", "2014-05-26", action, nil, request, true)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		addDebug(action, response, request)
-
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, "alicloud_security_group_rule", action, AlibabaCloudSdkGoERROR)
-		}
-	}
-
-	if len(cidrIp) != 0 {
-		d.SetId(fmt.Sprintf("%v:%v:%v:%v:%v:%v:%v:%v", request["SecurityGroupId"], direction, permissionsMap["IpProtocol"], permissionsMap["PortRange"], permissionsMap["NicType"], cidrIp, permissionsMap["Policy"], permissionsMap["Priority"]))
-	} else {
-		d.SetId(fmt.Sprintf("%v:%v:%v:%v:%v:%v:%v:%v", request["SecurityGroupId"], direction, permissionsMap["IpProtocol"], permissionsMap["PortRange"], permissionsMap["NicType"], prefixListId, permissionsMap["Policy"], permissionsMap["Priority"]))
+		return "AuthorizeSecurityGroup", request, nil
 	}
+	return "AuthorizeSecurityGroupEgress", request, nil
+}
 
-	return resourceAliyunSecurityGroupRuleRead(d, meta)
+func resourceAliyunSecurityGroupRuleCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	keys := []string{"security_group_id", "source_security_group_id", "cidr_ip", "ipv6_cidr_ip"}
+	// The prefix_list_id is computed, so it is only required to be known when the rule has no other source
+	_, hasCidrIp := diff.GetOk("cidr_ip")
+	_, hasIpv6CidrIp := diff.GetOk("ipv6_cidr_ip")
+	_, hasSourceSecurityGroupId := diff.GetOk("source_security_group_id")
+	if !hasCidrIp && !hasIpv6CidrIp && !hasSourceSecurityGroupId {
+		keys = append(keys, "prefix_list_id")
+	}
+	return dryRunCreate{
+		resourceName: "alicloud_security_group_rule",
+		product:      "Ecs",
+		version:      "2014-05-26",
+		keys:         keys,
+		request:      buildAliyunSecurityGroupRuleAuthorizeRequest,
+	}.customizeDiff(diff, meta)
 }
 
 func resourceAliyunSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
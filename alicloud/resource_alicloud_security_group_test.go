@@ -3,7 +3,9 @@ package alicloud
 import (
 	"fmt"
 	"log"
+	"net/url"
 	"testing"
+	"time"
 
 	"strings"
 
@@ -12,6 +14,8 @@ import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -555,3 +559,70 @@ func AliCloudEcsSecurityGroupBasicDependence8588(name string) string {
 }
 
 // Test Ecs SecurityGroup. <<< Resource test cases, automatically generated.
+
+func TestUnitAliCloudECSSecurityGroupPermissionsWithMockServer(t *testing.T) {
+	server := connectivity.NewMockServer()
+	defer server.Close()
+	server.Register("Ecs", "DescribeSecurityGroupAttribute", connectivity.MockResponse{
+		Body: map[string]interface{}{
+			"SecurityGroupId": "sg-mock",
+			"Permissions": map[string]interface{}{
+				"Permission": []interface{}{
+					map[string]interface{}{"SecurityGroupRuleId": "sgr-ssh", "Direction": "ingress", "IpProtocol": "TCP", "PortRange": "22/22", "Policy": "Accept", "Priority": 1, "SourceCidrIp": "10.0.0.0/8", "Description": "ssh"},
+					map[string]interface{}{"SecurityGroupRuleId": "sgr-rdp", "Direction": "ingress", "IpProtocol": "TCP", "PortRange": "3389/3389", "Policy": "Accept", "Priority": 1, "SourceCidrIp": "0.0.0.0/0"},
+					map[string]interface{}{"SecurityGroupRuleId": "sgr-all", "Direction": "egress", "IpProtocol": "ALL", "PortRange": "-1/-1", "Policy": "Accept", "Priority": 1, "DestCidrIp": "0.0.0.0/0"},
+				},
+			},
+		},
+	})
+	server.Register("Ecs", "RevokeSecurityGroup", connectivity.MockResponse{})
+	server.Register("Ecs", "AuthorizeSecurityGroup", connectivity.MockResponse{})
+	server.Register("Ecs", "ModifySecurityGroupRule", connectivity.MockResponse{})
+	client, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+
+	desired := []interface{}{
+		map[string]interface{}{"ip_protocol": "tcp", "port_range": "22/22", "policy": "accept", "priority": 1, "cidr_ip": "10.0.0.0/8", "ipv6_cidr_ip": "", "source_security_group_id": "", "prefix_list_id": "", "description": "ssh from the office"},
+		map[string]interface{}{"ip_protocol": "tcp", "port_range": "443/443", "policy": "accept", "priority": 1, "cidr_ip": "0.0.0.0/0", "ipv6_cidr_ip": "", "source_security_group_id": "", "prefix_list_id": "", "description": ""},
+	}
+	err = updateAliCloudEcsSecurityGroupPermissions(client, "sg-mock", "ingress", desired, time.Minute)
+	assert.Nil(t, err)
+
+	requests := server.Requests()
+	assert.Len(t, requests, 4)
+	assert.Equal(t, "DescribeSecurityGroupAttribute", requests[0].ApiName)
+	assert.Equal(t, "RevokeSecurityGroup", requests[1].ApiName)
+	body, _ := url.ParseQuery(requests[1].Body)
+	assert.Equal(t, "sgr-rdp", body.Get("SecurityGroupRuleId.1"))
+	assert.Equal(t, "", body.Get("SecurityGroupRuleId.2"))
+	assert.Equal(t, "AuthorizeSecurityGroup", requests[2].ApiName)
+	body, _ = url.ParseQuery(requests[2].Body)
+	assert.Equal(t, "443/443", body.Get("Permissions.1.PortRange"))
+	assert.Equal(t, "0.0.0.0/0", body.Get("Permissions.1.SourceCidrIp"))
+	assert.Equal(t, "", body.Get("Permissions.2.PortRange"))
+	assert.Equal(t, "ModifySecurityGroupRule", requests[3].ApiName)
+	body, _ = url.ParseQuery(requests[3].Body)
+	assert.Equal(t, "sgr-ssh", body.Get("SecurityGroupRuleId"))
+	assert.Equal(t, "ssh from the office", body.Get("Description"))
+}
+
+func TestUnitAliCloudECSSecurityGroupEmptyPermissions(t *testing.T) {
+	r := resourceAliCloudEcsSecurityGroup()
+	d := r.TestResourceData()
+	d.SetId("sg-mock")
+	assert.Nil(t, d.Set("ingress", []interface{}{
+		map[string]interface{}{"ip_protocol": "tcp", "port_range": "22/22", "policy": "accept", "priority": 1, "cidr_ip": "10.0.0.0/8"},
+	}))
+	state := d.State()
+
+	// An omitted ingress leaves the rules unmanaged, while ingress = [] revokes all of them
+	diff, err := r.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{}), nil)
+	assert.Nil(t, err)
+	assert.True(t, diff == nil || diff.Attributes["ingress.#"] == nil)
+
+	diff, err = r.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{"ingress": []interface{}{}}), nil)
+	assert.Nil(t, err)
+	if assert.NotNil(t, diff) && assert.NotNil(t, diff.Attributes["ingress.#"]) {
+		assert.Equal(t, "0", diff.Attributes["ingress.#"].New)
+	}
+}
@@ -1840,7 +1840,7 @@ func resourceAliCloudServiceMeshServiceMeshUpdate(d *schema.ResourceData, meta i
 			}
 		}
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		serviceMeshServiceV2 := ServiceMeshServiceV2{client}
 		if err := serviceMeshServiceV2.SetResourceTags(d, "servicemesh"); err != nil {
 			return WrapError(err)
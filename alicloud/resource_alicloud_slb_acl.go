@@ -154,7 +154,7 @@ func resourceAlicloudSlbAclUpdate(d *schema.ResourceData, meta interface{}) erro
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := slbService.setInstanceTags(d, TagResourceAcl); err != nil {
 			return WrapError(err)
 		}
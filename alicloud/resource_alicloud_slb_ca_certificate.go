@@ -118,7 +118,7 @@ func resourceAlicloudSlbCaCertificateUpdate(d *schema.ResourceData, meta interfa
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := slbService.SetResourceTags(d, "certificate"); err != nil {
 			return WrapError(err)
 		}
@@ -385,7 +385,7 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := slbService.SetResourceTags(d, "instance"); err != nil {
 			return WrapError(err)
 		}
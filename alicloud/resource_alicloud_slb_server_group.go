@@ -187,7 +187,7 @@ func resourceAliyunSlbServerGroupUpdate(d *schema.ResourceData, meta interface{}
 	slbService := SlbService{client}
 	d.Partial(true)
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && d.HasChanges("tags", "tags_all") {
 		if err := slbService.SetResourceTags(d, "vservergroup"); err != nil {
 			return WrapError(err)
 		}
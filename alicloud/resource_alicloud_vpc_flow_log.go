@@ -377,7 +377,7 @@ func resourceAliCloudVpcFlowLogUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && d.HasChanges("tags", "tags_all") {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "FLOWLOG"); err != nil {
 			return WrapError(err)
@@ -309,7 +309,7 @@ func resourceAliCloudVpcIpamIpamUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		vpcIpamServiceV2 := VpcIpamServiceV2{client}
 		if err := vpcIpamServiceV2.SetResourceTags(d, "IPAM"); err != nil {
 			return WrapError(err)
@@ -290,7 +290,7 @@ func resourceAliCloudVpcIpamIpamResourceDiscoveryUpdate(d *schema.ResourceData,
 		}
 
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		vpcIpamServiceV2 := VpcIpamServiceV2{client}
 		if err := vpcIpamServiceV2.SetResourceTags(d, "IPAMRESOURCEDISCOVERY"); err != nil {
 			return WrapError(err)
@@ -416,7 +416,7 @@ func resourceAliCloudVpcPeerPeerConnectionUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		vpcPeerServiceV2 := VpcPeerServiceV2{client}
 		if err := vpcPeerServiceV2.SetResourceTags(d, "PeerConnection"); err != nil {
 			return WrapError(err)
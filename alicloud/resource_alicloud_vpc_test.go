@@ -1140,6 +1140,33 @@ func TestUnitAlicloudVPCdsafa(t *testing.T) {
 	})
 }
 
+func TestUnitAliCloudVpcVpcWithMockServer(t *testing.T) {
+	server := connectivity.NewMockServer()
+	defer server.Close()
+	server.Register("Vpc", "DescribeVpcAttribute",
+		connectivity.MockResponse{
+			Body: map[string]interface{}{"VpcId": "vpc-mock", "VpcName": "vpc_name", "Status": "Available"},
+		},
+		connectivity.MockResponse{
+			Body: map[string]interface{}{"VpcId": ""},
+		},
+	)
+	rawClient, err := server.Client("cn-hangzhou")
+	assert.Nil(t, err)
+	vpcServiceV2 := VpcServiceV2{rawClient}
+
+	object, err := vpcServiceV2.DescribeVpcVpc("vpc-mock")
+	assert.Nil(t, err)
+	assert.Equal(t, "vpc_name", object["VpcName"])
+
+	_, err = vpcServiceV2.DescribeVpcVpc("vpc-mock")
+	assert.True(t, NotFoundError(err))
+
+	requests := server.Requests()
+	assert.Len(t, requests, 2)
+	assert.Contains(t, requests[0].Body, "VpcId=vpc-mock")
+}
+
 func TestAccAliCloudVpcVpc_basic3113(t *testing.T) {
 	var v map[string]interface{}
 	resourceId := "alicloud_vpc.default"
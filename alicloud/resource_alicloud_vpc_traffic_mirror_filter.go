@@ -386,7 +386,7 @@ func resourceAliCloudVpcTrafficMirrorFilterUpdate(d *schema.ResourceData, meta i
 	}
 
 	update = false
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "TRAFFICMIRRORFILTER"); err != nil {
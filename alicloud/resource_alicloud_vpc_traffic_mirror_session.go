@@ -406,7 +406,7 @@ func resourceAliCloudVpcTrafficMirrorSessionUpdate(d *schema.ResourceData, meta
 		}
 	}
 	update = false
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "TrafficMirrorSession"); err != nil {
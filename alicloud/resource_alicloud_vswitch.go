@@ -28,6 +28,7 @@ func resourceAliCloudVpcVswitch() *schema.Resource {
 			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: resourceAliCloudVpcVswitchCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
 				Type:     schema.TypeString,
@@ -154,33 +155,10 @@ func resourceAliCloudVpcVswitchCreate(d *schema.ResourceData, meta interface{})
 		d.SetId(fmt.Sprint(response["VSwitchId"]))
 
 	} else {
-		action := "CreateVSwitch"
-		var request map[string]interface{}
 		var response map[string]interface{}
-		var err error
-		request = make(map[string]interface{})
-		request["RegionId"] = client.RegionId
-		request["ClientToken"] = buildClientToken(action)
-
-		request["CidrBlock"] = d.Get("cidr_block")
-		request["VpcId"] = d.Get("vpc_id")
-		if v, ok := d.GetOk("name"); ok {
-			request["VSwitchName"] = v
-		}
-		if v, ok := d.GetOk("vswitch_name"); ok {
-			request["VSwitchName"] = v
-		}
-		if v, ok := d.GetOk("description"); ok {
-			request["Description"] = v
-		}
-		if v, ok := d.GetOk("availability_zone"); ok {
-			request["ZoneId"] = v
-		}
-		if v, ok := d.GetOk("zone_id"); ok {
-			request["ZoneId"] = v
-		}
-		if v, ok := d.GetOk("ipv6_cidr_block_mask"); ok {
-			request["Ipv6CidrBlock"] = v
+		action, request, err := buildAliCloudVpcVswitchCreateRequest(d, client)
+		if err != nil {
+			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 5*time.Second)
 		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
@@ -215,6 +193,50 @@ func resourceAliCloudVpcVswitchCreate(d *schema.ResourceData, meta interface{})
 	return resourceAliCloudVpcVswitchUpdate(d, meta)
 }
 
+// buildAliCloudVpcVswitchCreateRequest builds the CreateVSwitch request. The default vswitch is created by CreateDefaultVSwitch,
+// and it is not dry run at plan time.
+func buildAliCloudVpcVswitchCreateRequest(d schemaGetter, client *connectivity.AliyunClient) (string, map[string]interface{}, error) {
+	action := "CreateVSwitch"
+	request := make(map[string]interface{})
+	request["RegionId"] = client.RegionId
+	request["ClientToken"] = buildClientToken(action)
+
+	request["CidrBlock"] = d.Get("cidr_block")
+	request["VpcId"] = d.Get("vpc_id")
+	if v, ok := d.GetOk("name"); ok {
+		request["VSwitchName"] = v
+	}
+	if v, ok := d.GetOk("vswitch_name"); ok {
+		request["VSwitchName"] = v
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request["Description"] = v
+	}
+	if v, ok := d.GetOk("availability_zone"); ok {
+		request["ZoneId"] = v
+	}
+	if v, ok := d.GetOk("zone_id"); ok {
+		request["ZoneId"] = v
+	}
+	if v, ok := d.GetOk("ipv6_cidr_block_mask"); ok {
+		request["Ipv6CidrBlock"] = v
+	}
+	return action, request, nil
+}
+
+func resourceAliCloudVpcVswitchCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOkExists("is_default"); ok && v.(bool) {
+		return nil
+	}
+	return dryRunCreate{
+		resourceName: "alicloud_vswitch",
+		product:      "Vpc",
+		version:      "2016-04-28",
+		keys:         []string{"vpc_id", "cidr_block", "zone_id"},
+		request:      buildAliCloudVpcVswitchCreateRequest,
+	}.customizeDiff(diff, meta)
+}
+
 func resourceAliCloudVpcVswitchRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	vpcServiceV2 := VpcServiceV2{client}
@@ -325,7 +347,7 @@ func resourceAliCloudVpcVswitchUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	update = false
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update = true
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "VSWITCH"); err != nil {
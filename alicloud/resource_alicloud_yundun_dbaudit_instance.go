@@ -171,7 +171,7 @@ func resourceAlicloudDbauditInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if err := dbauditService.setInstanceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
@@ -32,7 +32,7 @@ func (s *AckOneServiceV2) DescribeAckOneCluster(id string) (object map[string]in
 		response, err = client.RpcPost("adcp", "2022-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("adcp", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -61,7 +61,7 @@ func (s *AckOneServiceV2) AckOneClusterStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAckOneCluster(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -96,7 +96,7 @@ func (s *AckOneServiceV2) DescribeAckOneMembershipAttachment(id string) (object
 		response, err = client.RpcPost("adcp", "2022-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("adcp", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -38,7 +38,7 @@ func (s *AckServiceV2) DescribeAckNodepool(id string) (object map[string]interfa
 		response, err = client.RoaGet("CS", "2015-12-15", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("CS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -61,7 +61,7 @@ func (s *AckServiceV2) AckNodepoolStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAckNodepool(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -90,7 +90,7 @@ func (s *AckServiceV2) DescribeAsyncAckNodepoolStateRefreshFunc(d *schema.Resour
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncDescribeTaskInfo(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -139,7 +139,7 @@ func (s *AckServiceV2) DescribeAsyncDescribeTaskInfo(d *schema.ResourceData, res
 		response, err = client.RoaGet("CS", "2015-12-15", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("CS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
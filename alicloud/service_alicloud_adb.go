@@ -209,7 +209,7 @@ func (s *AdbService) DescribeAdbAccount(id string) (ds *adb.DBAccount, err error
 	request.RegionId = s.client.RegionId
 	request.DBClusterId = parts[0]
 	request.AccountName = parts[1]
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response *adb.DescribeAccountsResponse
 	if err := invoker.Run(func() error {
@@ -263,8 +263,8 @@ func (s *AdbService) WaitForAdbInstance(id string, status Status, timeout int) e
 }
 
 func (s *AdbService) setClusterTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -352,6 +352,9 @@ func (s *AdbService) tagsFromMap(m map[string]interface{}) []adb.TagResourcesTag
 }
 
 func (s *AdbService) ignoreTag(t adb.TagResource) bool {
+	if providerTagIgnored(s.client, t.TagKey) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
@@ -655,7 +658,7 @@ func (s *AdbService) DescribeDBClusterAccessWhiteList(id string) (object map[str
 func (s *AdbService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 	var response map[string]interface{}
 	var err error
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		removedTagKeys := make([]string, 0)
 		for _, v := range removed {
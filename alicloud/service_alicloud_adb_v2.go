@@ -37,7 +37,7 @@ func (s *AdbServiceV2) DescribeAdbLakeAccount(id string) (object map[string]inte
 		response, err = client.RpcPost("adb", "2021-12-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("adb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -76,7 +76,7 @@ func (s *AdbServiceV2) DescribeLakeAccountDescribeAccounts(id string) (object ma
 		response, err = client.RpcPost("adb", "2021-12-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("adb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -105,7 +105,7 @@ func (s *AdbServiceV2) AdbLakeAccountStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeLakeAccountDescribeAccounts(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -108,7 +108,7 @@ func (s *AlbService) ListTagResources(id string, resourceType string) (object in
 
 func (s *AlbService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -35,7 +35,7 @@ func (s *AlbServiceV2) DescribeAlbListAsynJobs(id, resourceType, jobId string) (
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -63,7 +63,7 @@ func (s *AlbServiceV2) AlbJobStateRefreshFunc(id string, resourceType string, jo
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAlbListAsynJobs(id, resourceType, jobId)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -101,7 +101,7 @@ func (s *AlbServiceV2) DescribeAlbListenerAclAttachment(id string) (object map[s
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -141,7 +141,7 @@ func (s *AlbServiceV2) AlbListenerAclAttachmentStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAlbListenerAclAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -178,7 +178,7 @@ func (s *AlbServiceV2) DescribeAlbLoadBalancer(id string) (object map[string]int
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -201,7 +201,7 @@ func (s *AlbServiceV2) AlbLoadBalancerStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAlbLoadBalancer(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -230,7 +230,7 @@ func (s *AlbServiceV2) DescribeAsyncAlbLoadBalancerStateRefreshFunc(d *schema.Re
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncListAsynJobs(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -261,7 +261,7 @@ func (s *AlbServiceV2) DescribeAsyncAlbLoadBalancerStateRefreshFunc(d *schema.Re
 
 // SetResourceTags <<< Encapsulated tag function for Alb.
 func (s *AlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -291,7 +291,7 @@ func (s *AlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 				if err != nil {
-					if IsExpectedErrors(err, []string{"SystemBusy", "OperationFailed.ResourceGroupStatusCheckFail", "IdempotenceProcessing"}) || NeedRetry(err) {
+					if IsExpectedErrors(err, []string{"SystemBusy", "OperationFailed.ResourceGroupStatusCheckFail", "IdempotenceProcessing"}) || IsRetryableError("Alb", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -324,7 +324,7 @@ func (s *AlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 				if err != nil {
-					if IsExpectedErrors(err, []string{"SystemBusy", "OperationFailed.ResourceGroupStatusCheckFail", "IdempotenceProcessing"}) || NeedRetry(err) {
+					if IsExpectedErrors(err, []string{"SystemBusy", "OperationFailed.ResourceGroupStatusCheckFail", "IdempotenceProcessing"}) || IsRetryableError("Alb", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -367,7 +367,7 @@ func (s *AlbServiceV2) DescribeAlbLoadBalancerSecurityGroupAttachment(id string)
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -407,7 +407,7 @@ func (s *AlbServiceV2) AlbLoadBalancerSecurityGroupAttachmentStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAlbLoadBalancerSecurityGroupAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -436,7 +436,7 @@ func (s *AlbServiceV2) DescribeAsyncAlbLoadBalancerSecurityGroupAttachmentStateR
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncListAsynJobs(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -482,7 +482,7 @@ func (s *AlbServiceV2) DescribeAlbAScript(id string) (object map[string]interfac
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -514,7 +514,7 @@ func (s *AlbServiceV2) AlbAScriptStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAlbAScript(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -558,7 +558,7 @@ func (s *AlbServiceV2) DescribeAlbLoadBalancerAccessLogConfigAttachment(id strin
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -583,7 +583,7 @@ func (s *AlbServiceV2) AlbLoadBalancerAccessLogConfigAttachmentStateRefreshFunc(
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAlbLoadBalancerAccessLogConfigAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -612,7 +612,7 @@ func (s *AlbServiceV2) DescribeAsyncAlbLoadBalancerAccessLogConfigAttachmentStat
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncListAsynJobs(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -659,7 +659,7 @@ func (s *AlbServiceV2) DescribeAlbServerGroup(id string) (object map[string]inte
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -704,7 +704,7 @@ func (s *AlbServiceV2) DescribeServerGroupListServerGroupServers(id string) (obj
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -727,7 +727,7 @@ func (s *AlbServiceV2) AlbServerGroupStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAlbServerGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -771,7 +771,7 @@ func (s *AlbServiceV2) DescribeAlbListener(id string) (object map[string]interfa
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -794,7 +794,7 @@ func (s *AlbServiceV2) AlbListenerStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAlbListener(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -823,7 +823,7 @@ func (s *AlbServiceV2) DescribeAsyncAlbListenerStateRefreshFunc(d *schema.Resour
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncListAsynJobs(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -873,7 +873,7 @@ func (s *AlbServiceV2) DescribeAlbLoadBalancerZoneShiftedAttachment(id string) (
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -913,7 +913,7 @@ func (s *AlbServiceV2) AlbLoadBalancerZoneShiftedAttachmentStateRefreshFunc(id s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAlbLoadBalancerZoneShiftedAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -962,7 +962,7 @@ func (s *AlbServiceV2) DescribeAsyncListAsynJobs(d *schema.ResourceData, res map
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -997,7 +997,7 @@ func (s *AlbServiceV2) DescribeAlbHealthCheckTemplate(id string) (object map[str
 		response, err = client.RpcPost("Alb", "2020-06-16", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Alb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1020,7 +1020,7 @@ func (s *AlbServiceV2) AlbHealthCheckTemplateStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAlbHealthCheckTemplate(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
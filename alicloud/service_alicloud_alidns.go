@@ -98,7 +98,7 @@ func (s *AlidnsService) ListTagResources(id string) (object alidns.ListTagResour
 }
 
 func (s *AlidnsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	oldItems, newItems := d.GetChange("tags")
+	oldItems, newItems := getTagsChange(d)
 	added := make([]alidns.TagResourcesTag, 0)
 	for key, value := range newItems.(map[string]interface{}) {
 		added = append(added, alidns.TagResourcesTag{
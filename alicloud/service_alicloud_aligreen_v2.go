@@ -30,7 +30,7 @@ func (s *AligreenServiceV2) DescribeAligreenAuditCallback(id string) (object map
 		response, err = client.RpcPost("Green", "2017-08-23", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Green", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -68,7 +68,7 @@ func (s *AligreenServiceV2) AligreenAuditCallbackStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAligreenAuditCallback(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -104,7 +104,7 @@ func (s *AligreenServiceV2) DescribeAligreenCallback(id string) (object map[stri
 		response, err = client.RpcPost("Green", "2017-08-23", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Green", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -142,7 +142,7 @@ func (s *AligreenServiceV2) AligreenCallbackStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAligreenCallback(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -177,7 +177,7 @@ func (s *AligreenServiceV2) DescribeAligreenBizType(id string) (object map[strin
 		response, err = client.RpcPost("Green", "2017-08-23", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Green", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -215,7 +215,7 @@ func (s *AligreenServiceV2) AligreenBizTypeStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAligreenBizType(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -252,7 +252,7 @@ func (s *AligreenServiceV2) DescribeAligreenImageLib(id string) (object map[stri
 		response, err = client.RpcPost("Green", "2017-08-23", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Green", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -293,7 +293,7 @@ func (s *AligreenServiceV2) AligreenImageLibStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAligreenImageLib(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -329,7 +329,7 @@ func (s *AligreenServiceV2) DescribeAligreenKeywordLib(id string) (object map[st
 		response, err = client.RpcPost("Green", "2017-08-23", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Green", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -370,7 +370,7 @@ func (s *AligreenServiceV2) AligreenKeywordLibStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAligreenKeywordLib(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -407,7 +407,7 @@ func (s *AligreenServiceV2) DescribeAligreenOssStockTask(id string) (object map[
 		response, err = client.RpcPost("Green", "2017-08-23", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Green", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -428,7 +428,7 @@ func (s *AligreenServiceV2) AligreenOssStockTaskStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAligreenOssStockTask(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -576,8 +576,8 @@ func (s *AlikafkaService) DescribeTags(resourceId string, resourceTags map[strin
 }
 
 func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -659,6 +659,9 @@ func (s *AlikafkaService) tagsToMap(tags []alikafka.TagResource) map[string]stri
 }
 
 func (s *AlikafkaService) ignoreTag(t alikafka.TagResource) bool {
+	if providerTagIgnored(s.client, t.TagKey) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
@@ -682,6 +685,9 @@ func (s *AlikafkaService) tagVOTagsToMap(tags []alikafka.TagVO) map[string]strin
 }
 
 func (s *AlikafkaService) tagVOIgnoreTag(t alikafka.TagVO) bool {
+	if providerTagIgnored(s.client, t.Key) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
@@ -756,7 +762,7 @@ func (s *AlikafkaService) GetAllowedIpList(id string) (object map[string]interfa
 }
 
 func (s *AlikafkaService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -32,7 +32,7 @@ func (s *AmqpServiceV2) DescribeAmqpInstance(id string) (object map[string]inter
 		response, err = client.RpcGet("amqp-open", "2019-12-12", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("amqp-open", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -80,7 +80,7 @@ func (s *AmqpServiceV2) DescribeQueryAvailableInstances(id string) (object map[s
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPostWithEndpoint("BssOpenApi", "2017-12-14", action, query, request, true, endpoint)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("BssOpenApi", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -116,7 +116,7 @@ func (s *AmqpServiceV2) AmqpInstanceStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAmqpInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -162,7 +162,7 @@ func (s *AmqpServiceV2) DescribeAmqpExchange(id string) (object map[string]inter
 			response, err = client.RpcGet("amqp-open", "2019-12-12", action, query, request)
 
 			if err != nil {
-				if NeedRetry(err) {
+				if IsRetryableError("amqp-open", err) {
 					wait()
 					return resource.RetryableError(err)
 				}
@@ -207,7 +207,7 @@ func (s *AmqpServiceV2) AmqpExchangeStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAmqpExchange(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
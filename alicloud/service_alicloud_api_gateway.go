@@ -446,7 +446,7 @@ func (s *CloudApiService) DescribeTags(resourceId string, resourceTags map[strin
 }
 
 func (s *CloudApiService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -501,6 +501,9 @@ func (s *CloudApiService) tagsToMap(tags []cloudapi.TagResource) map[string]stri
 }
 
 func (s *CloudApiService) ignoreTag(t cloudapi.TagResource) bool {
+	if providerTagIgnored(s.client, t.TagKey) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
@@ -31,7 +31,7 @@ func (s *ApiGatewayServiceV2) DescribeApiGatewayInstance(id string) (object map[
 		response, err = client.RpcPost("CloudAPI", "2016-07-14", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("CloudAPI", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -62,7 +62,7 @@ func (s *ApiGatewayServiceV2) ApiGatewayInstanceStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApiGatewayInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -99,7 +99,7 @@ func (s *ApiGatewayServiceV2) DescribeApiGatewayPlugin(id string) (object map[st
 		response, err = client.RpcPost("CloudAPI", "2016-07-14", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("CloudAPI", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -129,7 +129,7 @@ func (s *ApiGatewayServiceV2) ApiGatewayPluginStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApiGatewayPlugin(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -151,7 +151,7 @@ func (s *ApiGatewayServiceV2) ApiGatewayPluginStateRefreshFunc(id string, field
 
 // SetResourceTags <<< Encapsulated tag function for ApiGateway.
 func (s *ApiGatewayServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -180,7 +180,7 @@ func (s *ApiGatewayServiceV2) SetResourceTags(d *schema.ResourceData, resourceTy
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("CloudAPI", "2016-07-14", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("CloudAPI", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -212,7 +212,7 @@ func (s *ApiGatewayServiceV2) SetResourceTags(d *schema.ResourceData, resourceTy
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("CloudAPI", "2016-07-14", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("CloudAPI", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -250,7 +250,7 @@ func (s *ApiGatewayServiceV2) DescribeApiGatewayAccessControlList(id string) (ob
 		response, err = client.RpcPost("CloudAPI", "2016-07-14", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("CloudAPI", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -288,7 +288,7 @@ func (s *ApiGatewayServiceV2) DescribeApiGatewayAclEntryAttachmentAttribute(id s
 		response, err = client.RpcPost("CloudAPI", "2016-07-14", action, nil, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("CloudAPI", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -341,7 +341,7 @@ func (s *ApiGatewayServiceV2) ApiGatewayAccessControlListStateRefreshFunc(id str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApiGatewayAccessControlList(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -380,7 +380,7 @@ func (s *ApiGatewayServiceV2) DescribeApiGatewayApi(id string) (object map[strin
 		response, err = client.RpcPost("CloudAPI", "2016-07-14", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("CloudAPI", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
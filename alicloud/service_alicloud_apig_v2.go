@@ -35,7 +35,7 @@ func (s *ApigServiceV2) DescribeApigHttpApi(id string) (object map[string]interf
 		response, err = client.RoaGet("APIG", "2024-03-27", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("APIG", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -63,7 +63,7 @@ func (s *ApigServiceV2) ApigHttpApiStateRefreshFunc(id string, field string, fai
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApigHttpApi(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -108,7 +108,7 @@ func (s *ApigServiceV2) DescribeApigDomain(id string) (object map[string]interfa
 		response, err = client.RoaGet("APIG", "2024-03-27", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("APIG", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -136,7 +136,7 @@ func (s *ApigServiceV2) ApigDomainStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApigDomain(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -181,7 +181,7 @@ func (s *ApigServiceV2) DescribeApigGateway(id string) (object map[string]interf
 		response, err = client.RoaGet("APIG", "2024-03-27", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("APIG", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -209,7 +209,7 @@ func (s *ApigServiceV2) ApigGatewayStateRefreshFunc(id string, field string, fai
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApigGateway(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -238,7 +238,7 @@ func (s *ApigServiceV2) ApigGatewayStateRefreshFunc(id string, field string, fai
 
 // SetResourceTags <<< Encapsulated tag function for Apig.
 func (s *ApigServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -275,7 +275,7 @@ func (s *ApigServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RoaDelete("APIG", "2024-03-27", action, query, nil, body, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("APIG", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -319,7 +319,7 @@ func (s *ApigServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RoaPost("APIG", "2024-03-27", action, query, nil, body, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("APIG", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -358,7 +358,7 @@ func (s *ApigServiceV2) DescribeApigEnvironment(id string) (object map[string]in
 		response, err = client.RoaGet("APIG", "2024-03-27", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("APIG", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -386,7 +386,7 @@ func (s *ApigServiceV2) ApigEnvironmentStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApigEnvironment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -430,7 +430,7 @@ func (s *ApigServiceV2) DescribeApigService(id string) (object map[string]interf
 		response, err = client.RoaGet("APIG", "2024-03-27", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("APIG", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -458,7 +458,7 @@ func (s *ApigServiceV2) ApigServiceStateRefreshFunc(id string, field string, fai
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApigService(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -501,7 +501,7 @@ func (s *ApigServiceV2) DescribeApigPlugin(id string) (object map[string]interfa
 		response, err = client.RoaGet("APIG", "2024-03-27", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("APIG", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -541,7 +541,7 @@ func (s *ApigServiceV2) ApigPluginStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApigPlugin(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -585,7 +585,7 @@ func (s *ApigServiceV2) DescribeApigPluginClass(id string) (object map[string]in
 		response, err = client.RoaGet("APIG", "2024-03-27", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("APIG", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -613,7 +613,7 @@ func (s *ApigServiceV2) ApigPluginClassStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApigPluginClass(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -661,7 +661,7 @@ func (s *ApigServiceV2) DescribeApigOperation(id string) (object map[string]inte
 		response, err = client.RoaGet("APIG", "2024-03-27", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("APIG", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -689,7 +689,7 @@ func (s *ApigServiceV2) ApigOperationStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApigOperation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -738,7 +738,7 @@ func (s *ApigServiceV2) DescribeApigApiAttachment(id string) (object map[string]
 		response, err = client.RoaGet("APIG", "2024-03-27", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("APIG", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -771,7 +771,7 @@ func (s *ApigServiceV2) ApigApiAttachmentStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApigApiAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -819,7 +819,7 @@ func (s *ApigServiceV2) DescribeApigRoute(id string) (object map[string]interfac
 		response, err = client.RoaGet("APIG", "2024-03-27", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("APIG", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -846,7 +846,7 @@ func (s *ApigServiceV2) ApigRouteStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeApigRoute(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -383,7 +383,7 @@ func (s *ArmsService) SetResourceTags(d *schema.ResourceData, resourceType strin
 
 	resourceIdNum := strings.Count(d.Id(), ":")
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -40,7 +40,7 @@ func (s *ArmsServiceV2) DescribeArmsPrometheusMonitoring(id string) (object map[
 		response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -67,7 +67,7 @@ func (s *ArmsServiceV2) ArmsPrometheusMonitoringStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsPrometheusMonitoring(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -108,7 +108,7 @@ func (s *ArmsServiceV2) DescribeArmsRemoteWrite(id string) (object map[string]in
 		response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -135,7 +135,7 @@ func (s *ArmsServiceV2) ArmsRemoteWriteStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsRemoteWrite(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -171,7 +171,7 @@ func (s *ArmsServiceV2) DescribeArmsEnvironment(id string) (object map[string]in
 		response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -198,7 +198,7 @@ func (s *ArmsServiceV2) ArmsEnvironmentStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsEnvironment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -220,7 +220,7 @@ func (s *ArmsServiceV2) ArmsEnvironmentStateRefreshFunc(id string, field string,
 
 // SetResourceTags <<< Encapsulated tag function for Arms.
 func (s *ArmsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -254,7 +254,7 @@ func (s *ArmsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ARMS", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -287,7 +287,7 @@ func (s *ArmsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ARMS", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -325,7 +325,7 @@ func (s *ArmsServiceV2) DescribeArmsPrometheus(id string) (object map[string]int
 		response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -352,7 +352,7 @@ func (s *ArmsServiceV2) ArmsPrometheusStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsPrometheus(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -394,7 +394,7 @@ func (s *ArmsServiceV2) DescribeArmsEnvFeature(id string) (object map[string]int
 		response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -421,7 +421,7 @@ func (s *ArmsServiceV2) ArmsEnvFeatureStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsEnvFeature(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -463,7 +463,7 @@ func (s *ArmsServiceV2) DescribeArmsAddonRelease(id string) (object map[string]i
 		response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -490,7 +490,7 @@ func (s *ArmsServiceV2) ArmsAddonReleaseStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsAddonRelease(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -533,7 +533,7 @@ func (s *ArmsServiceV2) DescribeArmsEnvPodMonitor(id string) (object map[string]
 		response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -560,7 +560,7 @@ func (s *ArmsServiceV2) ArmsEnvPodMonitorStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsEnvPodMonitor(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -603,7 +603,7 @@ func (s *ArmsServiceV2) DescribeArmsEnvServiceMonitor(id string) (object map[str
 		response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -630,7 +630,7 @@ func (s *ArmsServiceV2) ArmsEnvServiceMonitorStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsEnvServiceMonitor(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -672,7 +672,7 @@ func (s *ArmsServiceV2) DescribeArmsEnvCustomJob(id string) (object map[string]i
 		response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -699,7 +699,7 @@ func (s *ArmsServiceV2) ArmsEnvCustomJobStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsEnvCustomJob(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -736,7 +736,7 @@ func (s *ArmsServiceV2) DescribeArmsSyntheticTask(id string) (object map[string]
 		response, err = client.RpcGet("ARMS", "2019-08-08", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -766,7 +766,7 @@ func (s *ArmsServiceV2) ArmsSyntheticTaskStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsSyntheticTask(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -804,7 +804,7 @@ func (s *ArmsServiceV2) DescribeArmsGrafanaWorkspace(id string) (object map[stri
 		response, err = client.RpcPost("ARMS", "2019-08-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ARMS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -834,7 +834,7 @@ func (s *ArmsServiceV2) ArmsGrafanaWorkspaceStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeArmsGrafanaWorkspace(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -181,10 +181,10 @@ func (s *CassandraService) DescribeCassandraEndpoints(id string) (object cassand
 }
 
 func (s *CassandraService) setInstanceTags(d *schema.ResourceData) error {
-	if !d.HasChange("tags") {
+	if !d.HasChanges("tags", "tags_all") {
 		return nil
 	}
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 
@@ -269,6 +269,9 @@ func (s *CassandraService) tagsToMap(tags []cassandra.Tag) map[string]string {
 }
 
 func (s *CassandraService) ignoreTag(t cassandra.Tag) bool {
+	if providerTagIgnored(s.client, t.Key) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
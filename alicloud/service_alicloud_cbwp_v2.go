@@ -33,7 +33,7 @@ func (s *CbwpServiceV2) DescribeCbwpCommonBandwidthPackage(id string) (object ma
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -75,7 +75,7 @@ func (s *CbwpServiceV2) DescribeListTagResources(id string) (object map[string]i
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -96,7 +96,7 @@ func (s *CbwpServiceV2) CbwpCommonBandwidthPackageStateRefreshFunc(id string, fi
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCbwpCommonBandwidthPackage(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -118,7 +118,7 @@ func (s *CbwpServiceV2) CbwpCommonBandwidthPackageStateRefreshFunc(id string, fi
 
 // SetResourceTags <<< Encapsulated tag function for Cbwp.
 func (s *CbwpServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -148,7 +148,7 @@ func (s *CbwpServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Vpc", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -181,7 +181,7 @@ func (s *CbwpServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Vpc", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -249,7 +249,7 @@ func (s *CbwpServiceV2) DescribeCbwpCommonBandwidthPackageAttachment(id string)
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -314,7 +314,7 @@ func (s *CbwpServiceV2) DescribeDescribeEipAddresses(id string) (object map[stri
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -352,7 +352,7 @@ func (s *CbwpServiceV2) CbwpCommonBandwidthPackageAttachmentStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCbwpCommonBandwidthPackageAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
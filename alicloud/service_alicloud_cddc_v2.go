@@ -38,7 +38,7 @@ func (s *CddcServiceV2) DescribeCddcDedicatedPropreHost(id string) (object map[s
 		response, err = client.RpcPost("cddc", "2020-03-20", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cddc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -59,7 +59,7 @@ func (s *CddcServiceV2) CddcDedicatedPropreHostStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCddcDedicatedPropreHost(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
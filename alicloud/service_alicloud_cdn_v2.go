@@ -32,7 +32,7 @@ func (s *CdnServiceV2) DescribeCdnDomain(id string) (object map[string]interface
 		response, err = client.RpcPost("Cdn", "2018-05-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cdn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -70,7 +70,7 @@ func (s *CdnServiceV2) DescribeDomainDescribeDomainCertificateInfo(id string) (o
 		response, err = client.RpcPost("Cdn", "2018-05-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cdn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -114,7 +114,7 @@ func (s *CdnServiceV2) DescribeDomainListTagResources(id string) (object map[str
 		response, err = client.RpcPost("Cdn", "2018-05-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cdn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -137,7 +137,7 @@ func (s *CdnServiceV2) CdnDomainStateRefreshFunc(id string, field string, failSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCdnDomain(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -166,7 +166,7 @@ func (s *CdnServiceV2) CdnDomainStateRefreshFunc(id string, field string, failSt
 
 // SetResourceTags <<< Encapsulated tag function for Cdn.
 func (s *CdnServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -196,7 +196,7 @@ func (s *CdnServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Cdn", "2018-05-10", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Cdn", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -229,7 +229,7 @@ func (s *CdnServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Cdn", "2018-05-10", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Cdn", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -267,7 +267,7 @@ func (s *CdnServiceV2) DescribeCdnRealTimeLogDelivery(id string) (object map[str
 		response, err = client.RpcGet("Cdn", "2018-05-10", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cdn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -290,7 +290,7 @@ func (s *CdnServiceV2) CdnRealTimeLogDeliveryStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCdnRealTimeLogDelivery(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
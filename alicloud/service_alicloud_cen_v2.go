@@ -37,7 +37,7 @@ func (s *CenServiceV2) DescribeCenTransitRouterPeerAttachment(id string) (object
 		response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -69,7 +69,7 @@ func (s *CenServiceV2) CenTransitRouterPeerAttachmentStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenTransitRouterPeerAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -98,7 +98,7 @@ func (s *CenServiceV2) CenTransitRouterPeerAttachmentStateRefreshFunc(id string,
 
 // SetResourceTags <<< Encapsulated tag function for Cen.
 func (s *CenServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -128,7 +128,7 @@ func (s *CenServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Cbn", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -161,7 +161,7 @@ func (s *CenServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Cbn", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -202,7 +202,7 @@ func (s *CenServiceV2) DescribeCenTransitRouterEcrAttachment(id string) (object
 		response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -242,7 +242,7 @@ func (s *CenServiceV2) CenTransitRouterEcrAttachmentStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenTransitRouterEcrAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -292,7 +292,7 @@ func (s *CenServiceV2) DescribeCenTrafficMarkingPolicy(id string) (object map[st
 		response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -332,7 +332,7 @@ func (s *CenServiceV2) CenTrafficMarkingPolicyStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenTrafficMarkingPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -383,7 +383,7 @@ func (s *CenServiceV2) DescribeCenTransitRouterVpcAttachment(id string) (object
 		response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -423,7 +423,7 @@ func (s *CenServiceV2) CenTransitRouterVpcAttachmentStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenTransitRouterVpcAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -465,7 +465,7 @@ func (s *CenServiceV2) DescribeCenFlowLog(id string) (object map[string]interfac
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -494,7 +494,7 @@ func (s *CenServiceV2) CenFlowLogStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenFlowLog(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -544,7 +544,7 @@ func (s *CenServiceV2) DescribeCenTransitRouterMulticastDomain(id string) (objec
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -573,7 +573,7 @@ func (s *CenServiceV2) CenTransitRouterMulticastDomainStateRefreshFunc(id string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenTransitRouterMulticastDomain(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -624,7 +624,7 @@ func (s *CenServiceV2) DescribeCenTransitRouteTableAggregation(id string) (objec
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"Operation.Blocking"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"Operation.Blocking"}) || IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -656,7 +656,7 @@ func (s *CenServiceV2) CenTransitRouteTableAggregationStateRefreshFunc(id string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenTransitRouteTableAggregation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -705,7 +705,7 @@ func (s *CenServiceV2) DescribeCenCenInstance(id string) (object map[string]inte
 		response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -734,7 +734,7 @@ func (s *CenServiceV2) CenCenInstanceStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenCenInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -778,7 +778,7 @@ func (s *CenServiceV2) DescribeCenInterRegionTrafficQosPolicy(id string) (object
 		response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -807,7 +807,7 @@ func (s *CenServiceV2) CenInterRegionTrafficQosPolicyStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenInterRegionTrafficQosPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -851,7 +851,7 @@ func (s *CenServiceV2) DescribeCenInterRegionTrafficQosQueue(id string) (object
 		response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -880,7 +880,7 @@ func (s *CenServiceV2) CenInterRegionTrafficQosQueueStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenInterRegionTrafficQosQueue(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -925,7 +925,7 @@ func (s *CenServiceV2) DescribeCenTransitRouterVpnAttachment(id string) (object
 		response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -955,7 +955,7 @@ func (s *CenServiceV2) CenTransitRouterVpnAttachmentStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenTransitRouterVpnAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1004,7 +1004,7 @@ func (s *CenServiceV2) DescribeCenTransitRouter(id string) (object map[string]in
 		response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1033,7 +1033,7 @@ func (s *CenServiceV2) CenTransitRouterStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenTransitRouter(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1084,7 +1084,7 @@ func (s *CenServiceV2) DescribeCenTransitRouterCidr(id string) (object map[strin
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1113,7 +1113,7 @@ func (s *CenServiceV2) CenTransitRouterCidrStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenTransitRouterCidr(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1163,7 +1163,7 @@ func (s *CenServiceV2) DescribeCenTransitRouterRouteTableAssociation(id string)
 		response, err = client.RpcPost("Cbn", "2017-09-12", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cbn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1192,7 +1192,7 @@ func (s *CenServiceV2) CenTransitRouterRouteTableAssociationStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCenTransitRouterRouteTableAssociation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
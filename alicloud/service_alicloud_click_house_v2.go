@@ -34,7 +34,7 @@ func (s *ClickHouseServiceV2) DescribeClickHouseEnterpriseDBCluster(id string) (
 		response, err = client.RpcPost("clickhouse", "2023-05-22", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("clickhouse", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -62,7 +62,7 @@ func (s *ClickHouseServiceV2) ClickHouseEnterpriseDBClusterStateRefreshFunc(id s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeClickHouseEnterpriseDBCluster(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -111,7 +111,7 @@ func (s *ClickHouseServiceV2) DescribeClickHouseEnterpriseDBClusterAccount(id st
 		response, err = client.RpcPost("clickhouse", "2023-05-22", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("clickhouse", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -154,7 +154,7 @@ func (s *ClickHouseServiceV2) DescribeEnterpriseDBClusterAccountDescribeAccounts
 		response, err = client.RpcPost("clickhouse", "2023-05-22", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("clickhouse", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -191,7 +191,7 @@ func (s *ClickHouseServiceV2) ClickHouseEnterpriseDBClusterAccountStateRefreshFu
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeClickHouseEnterpriseDBClusterAccount(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -239,7 +239,7 @@ func (s *ClickHouseServiceV2) DescribeClickHouseEnterpriseDbClusterPublicEndpoin
 		response, err = client.RpcPost("clickhouse", "2023-05-22", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("clickhouse", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -276,7 +276,7 @@ func (s *ClickHouseServiceV2) ClickHouseEnterpriseDbClusterPublicEndpointStateRe
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeClickHouseEnterpriseDbClusterPublicEndpoint(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -310,7 +310,7 @@ func (s *ClickHouseServiceV2) DescribeAsyncClickHouseEnterpriseDbClusterPublicEn
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncDescribeDBInstanceAttribute(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -365,7 +365,7 @@ func (s *ClickHouseServiceV2) DescribeAsyncDescribeDBInstanceAttribute(d *schema
 		response, err = client.RpcPost("clickhouse", "2023-05-22", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("clickhouse", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -400,7 +400,7 @@ func (s *ClickHouseServiceV2) DescribeClickHouseEnterpriseDbClusterBackupPolicy(
 		response, err = client.RpcPost("clickhouse", "2023-05-22", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("clickhouse", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -425,7 +425,7 @@ func (s *ClickHouseServiceV2) ClickHouseEnterpriseDbClusterBackupPolicyStateRefr
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeClickHouseEnterpriseDbClusterBackupPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -473,7 +473,7 @@ func (s *ClickHouseServiceV2) DescribeClickHouseEnterpriseDbClusterSecurityIP(id
 		response, err = client.RpcPost("clickhouse", "2023-05-22", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("clickhouse", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -510,7 +510,7 @@ func (s *ClickHouseServiceV2) ClickHouseEnterpriseDbClusterSecurityIPStateRefres
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeClickHouseEnterpriseDbClusterSecurityIP(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -11,7 +11,7 @@ import (
 
 func (s *CloudApiService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
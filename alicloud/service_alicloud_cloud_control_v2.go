@@ -35,7 +35,7 @@ func (s *CloudControlServiceV2) DescribeCloudControlResource(id string) (object
 		response, err = client.RoaGet("cloudcontrol", "2022-08-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudcontrol", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -63,7 +63,7 @@ func (s *CloudControlServiceV2) CloudControlResourceStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudControlResource(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -111,7 +111,7 @@ func (s *CloudControlServiceV2) DescribeCloudControlPrice(id string) (object map
 		response, err = client.RoaGet("cloudcontrol", "2022-08-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudcontrol", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -136,7 +136,7 @@ func (s *CloudControlServiceV2) CloudControlPriceStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudControlPrice(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -180,7 +180,7 @@ func (s *CloudControlServiceV2) DescribeCloudControlProduct(id string) (object m
 		response, err = client.RoaGet("cloudcontrol", "2022-08-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudcontrol", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -217,7 +217,7 @@ func (s *CloudControlServiceV2) CloudControlProductStateRefreshFunc(id string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudControlProduct(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -262,7 +262,7 @@ func (s *CloudControlServiceV2) DescribeCloudControlResourceType(id string) (obj
 		response, err = client.RoaGet("cloudcontrol", "2022-08-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudcontrol", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -291,7 +291,7 @@ func (s *CloudControlServiceV2) CloudControlResourceTypeStateRefreshFunc(id stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudControlResourceType(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -37,7 +37,7 @@ func (s *CloudFirewallServiceV2) DescribeCloudFirewallNatFirewallControlPolicy(i
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPostWithEndpoint("Cloudfw", "2017-12-07", action, query, request, true, endpoint)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cloudfw", err) {
 				wait()
 				return resource.RetryableError(err)
 			} else if IsExpectedErrors(err, []string{"not buy user"}) {
@@ -71,7 +71,7 @@ func (s *CloudFirewallServiceV2) CloudFirewallNatFirewallControlPolicyStateRefre
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudFirewallNatFirewallControlPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -109,7 +109,7 @@ func (s *CloudFirewallServiceV2) DescribeCloudFirewallNatFirewall(id string) (ob
 		response, err = client.RpcPost("Cloudfw", "2017-12-07", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cloudfw", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -138,7 +138,7 @@ func (s *CloudFirewallServiceV2) CloudFirewallNatFirewallStateRefreshFunc(id str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudFirewallNatFirewall(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -183,7 +183,7 @@ func (s *CloudFirewallServiceV2) DescribeCloudFirewallVpcCenTrFirewall(id string
 		response, err = client.RpcPost("Cloudfw", "2017-12-07", action, query, request, true)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"ErrorTrResourceNotReady"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"ErrorTrResourceNotReady"}) || IsRetryableError("Cloudfw", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -206,7 +206,7 @@ func (s *CloudFirewallServiceV2) CloudFirewallVpcCenTrFirewallStateRefreshFunc(i
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudFirewallVpcCenTrFirewall(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -257,7 +257,7 @@ func (s *CloudFirewallServiceV2) DescribeCloudFirewallControlPolicy(id string) (
 		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 			response, err = client.RpcPostWithEndpoint("Cloudfw", "2017-12-07", action, nil, request, true, endpoint)
 			if err != nil {
-				if NeedRetry(err) {
+				if IsRetryableError("Cloudfw", err) {
 					wait()
 					return resource.RetryableError(err)
 				} else if IsExpectedErrors(err, []string{"not buy user"}) {
@@ -327,7 +327,7 @@ func (s *CloudFirewallServiceV2) DescribeCloudFirewallIPSConfig(id string) (obje
 		response, err = client.RpcPost("Cloudfw", "2017-12-07", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cloudfw", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -347,7 +347,7 @@ func (s *CloudFirewallServiceV2) CloudFirewallIPSConfigStateRefreshFunc(id strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudFirewallIPSConfig(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -33,7 +33,7 @@ func (s *CloudMonitorServiceServiceV2) DescribeCloudMonitorServiceHybridDoubleWr
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPost("Cms", "2018-03-08", action, nil, request, false)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cms", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -83,7 +83,7 @@ func (s *CloudMonitorServiceServiceV2) DescribeCloudMonitorServiceEventRuleTarge
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPost("Cms", "2019-01-01", action, nil, request, false)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cms", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -126,7 +126,7 @@ func (s *CloudMonitorServiceServiceV2) DescribeCloudMonitorServiceMonitoringAgen
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPost("Cms", "2019-01-01", action, nil, request, false)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Cms", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -185,7 +185,7 @@ func (s *CloudMonitorServiceServiceV2) DescribeCloudMonitorServiceGroupMonitorin
 		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 			response, err = client.RpcPost("Cms", "2019-01-01", action, nil, request, false)
 			if err != nil {
-				if NeedRetry(err) {
+				if IsRetryableError("Cms", err) {
 					wait()
 					return resource.RetryableError(err)
 				}
@@ -249,7 +249,7 @@ func (s *CloudMonitorServiceServiceV2) DescribeCloudMonitorServiceBasicPublic(id
 		response, err = client.RpcPostWithEndpoint("BssOpenApi", "2017-12-14", action, query, request, true, endpoint)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("BssOpenApi", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -298,7 +298,7 @@ func (s *CloudMonitorServiceServiceV2) CloudMonitorServiceBasicPublicStateRefres
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudMonitorServiceBasicPublic(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -342,7 +342,7 @@ func (s *CloudMonitorServiceServiceV2) DescribeCloudMonitorServiceEnterprisePubl
 		response, err = client.RpcPostWithEndpoint("BssOpenApi", "2017-12-14", action, query, request, true, endpoint)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("BssOpenApi", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -392,7 +392,7 @@ func (s *CloudMonitorServiceServiceV2) CloudMonitorServiceEnterprisePublicStateR
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudMonitorServiceEnterprisePublic(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -436,7 +436,7 @@ func (s *CloudMonitorServiceServiceV2) DescribeCloudMonitorServiceNaamPublic(id
 		response, err = client.RpcPostWithEndpoint("BssOpenApi", "2017-12-14", action, query, request, true, endpoint)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("BssOpenApi", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -32,7 +32,7 @@ func (s *CloudPhoneServiceV2) DescribeCloudPhonePolicy(id string) (object map[st
 		response, err = client.RpcPost("eds-aic", "2023-09-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eds-aic", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -61,7 +61,7 @@ func (s *CloudPhoneServiceV2) CloudPhonePolicyStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudPhonePolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -106,7 +106,7 @@ func (s *CloudPhoneServiceV2) DescribeCloudPhoneInstanceGroup(id string) (object
 		response, err = client.RpcPost("eds-aic", "2023-09-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eds-aic", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -135,7 +135,7 @@ func (s *CloudPhoneServiceV2) CloudPhoneInstanceGroupStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudPhoneInstanceGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -179,7 +179,7 @@ func (s *CloudPhoneServiceV2) DescribeCloudPhoneInstance(id string) (object map[
 		response, err = client.RpcPost("eds-aic", "2023-09-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eds-aic", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -208,7 +208,7 @@ func (s *CloudPhoneServiceV2) CloudPhoneInstanceStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudPhoneInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -252,7 +252,7 @@ func (s *CloudPhoneServiceV2) DescribeCloudPhoneKeyPair(id string) (object map[s
 		response, err = client.RpcPost("eds-aic", "2023-09-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eds-aic", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -281,7 +281,7 @@ func (s *CloudPhoneServiceV2) CloudPhoneKeyPairStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudPhoneKeyPair(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -326,7 +326,7 @@ func (s *CloudPhoneServiceV2) DescribeCloudPhoneImage(id string) (object map[str
 		response, err = client.RpcPost("eds-aic", "2023-09-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eds-aic", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -351,7 +351,7 @@ func (s *CloudPhoneServiceV2) CloudPhoneImageStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudPhoneImage(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
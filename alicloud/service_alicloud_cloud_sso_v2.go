@@ -32,7 +32,7 @@ func (s *CloudSSOServiceV2) DescribeCloudSSODirectory(id string) (object map[str
 		response, err = client.RpcPost("cloudsso", "2021-05-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudsso", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -71,7 +71,7 @@ func (s *CloudSSOServiceV2) DescribeDirectoryGetPasswordPolicy(id string) (objec
 		response, err = client.RpcPost("cloudsso", "2021-05-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudsso", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -110,7 +110,7 @@ func (s *CloudSSOServiceV2) DescribeDirectoryGetExternalSAMLIdentityProvider(id
 		response, err = client.RpcPost("cloudsso", "2021-05-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudsso", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -149,7 +149,7 @@ func (s *CloudSSOServiceV2) DescribeDirectoryGetDirectory(id string) (object map
 		response, err = client.RpcPost("cloudsso", "2021-05-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudsso", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -188,7 +188,7 @@ func (s *CloudSSOServiceV2) DescribeDirectoryGetUserProvisioningConfiguration(id
 		response, err = client.RpcPost("cloudsso", "2021-05-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudsso", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -227,7 +227,7 @@ func (s *CloudSSOServiceV2) DescribeDirectoryGetMFAAuthenticationSettingInfo(id
 		response, err = client.RpcPost("cloudsso", "2021-05-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudsso", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -266,7 +266,7 @@ func (s *CloudSSOServiceV2) DescribeDirectoryGetLoginPreference(id string) (obje
 		response, err = client.RpcPost("cloudsso", "2021-05-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudsso", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -305,7 +305,7 @@ func (s *CloudSSOServiceV2) DescribeDirectoryGetSCIMSynchronizationStatus(id str
 		response, err = client.RpcPost("cloudsso", "2021-05-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudsso", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -339,7 +339,7 @@ func (s *CloudSSOServiceV2) DescribeDirectoryGetMFAAuthenticationStatus(id strin
 		response, err = client.RpcPost("cloudsso", "2021-05-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudsso", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -373,7 +373,7 @@ func (s *CloudSSOServiceV2) DescribeDirectoryGetDirectoryGlobalAccessStatus(id s
 		response, err = client.RpcPost("cloudsso", "2021-05-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cloudsso", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -396,7 +396,7 @@ func (s *CloudSSOServiceV2) CloudSSODirectoryStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCloudSSODirectory(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
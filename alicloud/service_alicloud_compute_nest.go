@@ -141,7 +141,7 @@ func (s *ComputeNestService) SetResourceTags(d *schema.ResourceData, resourceTyp
 
 	resourceIdNum := strings.Count(d.Id(), ":")
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
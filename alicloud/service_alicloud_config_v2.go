@@ -33,7 +33,7 @@ func (s *ConfigServiceV2) DescribeConfigRule(id string) (object map[string]inter
 		response, err = client.RpcPost("Config", "2020-09-07", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Config", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -61,7 +61,7 @@ func (s *ConfigServiceV2) ConfigRuleStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeConfigRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -98,7 +98,7 @@ func (s *ConfigServiceV2) DescribeConfigRemediation(id string) (object map[strin
 		response, err = client.RpcGet("Config", "2020-09-07", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Config", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -126,7 +126,7 @@ func (s *ConfigServiceV2) ConfigRemediationStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeConfigRemediation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -161,7 +161,7 @@ func (s *ConfigServiceV2) DescribeConfigDelivery(id string) (object map[string]i
 		response, err = client.RpcPost("Config", "2020-09-07", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Config", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -190,7 +190,7 @@ func (s *ConfigServiceV2) ConfigDeliveryStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeConfigDelivery(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -232,7 +232,7 @@ func (s *ConfigServiceV2) DescribeConfigAggregateDelivery(id string) (object map
 		response, err = client.RpcPost("Config", "2020-09-07", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Config", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -261,7 +261,7 @@ func (s *ConfigServiceV2) ConfigAggregateDeliveryStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeConfigAggregateDelivery(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
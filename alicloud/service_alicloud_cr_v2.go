@@ -34,7 +34,7 @@ func (s *CrServiceV2) DescribeCrInstance(id string) (object map[string]interface
 		response, err = client.RpcPost("cr", "2018-12-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cr", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -72,7 +72,7 @@ func (s *CrServiceV2) DescribeInstanceQueryAvailableInstances(id string) (object
 		response, err = client.RpcPostWithEndpoint("BssOpenApi", "2017-12-14", action, query, request, true, endpoint)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("BssOpenApi", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -117,7 +117,7 @@ func (s *CrServiceV2) DescribeInstanceListInstanceEndpoint(id string) (object ma
 		response, err = client.RpcPost("cr", "2018-12-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cr", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -137,7 +137,7 @@ func (s *CrServiceV2) CrInstanceStateRefreshFunc(id string, field string, failSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeCrInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -181,7 +181,7 @@ func (s *CrServiceV2) DescribeAsyncGetInstance(d *schema.ResourceData, res map[s
 		response, err = client.RpcPost("cr", "2018-12-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("cr", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -201,7 +201,7 @@ func (s *CrServiceV2) DescribeAsyncCrInstanceStateRefreshFunc(d *schema.Resource
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncGetInstance(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -258,7 +258,7 @@ func (s *CrServiceV2) DescribeCrRepoSyncRule(id string) (object map[string]inter
 			response, err = client.RpcPost("cr", "2018-12-01", action, query, request, true)
 
 			if err != nil {
-				if NeedRetry(err) {
+				if IsRetryableError("cr", err) {
 					wait()
 					return resource.RetryableError(err)
 				}
@@ -75,7 +75,7 @@ curl http://aliacs-k8s-%s.oss-%s.aliyuncs.com/public/pkg/run/attach/%s/attach_no
 
 func (s *CsService) GetContainerClusterByName(name string) (cluster cs.ClusterType, err error) {
 	name = Trim(name)
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	var clusters []cs.ClusterType
 	err = invoker.Run(func() error {
 		raw, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -110,7 +110,7 @@ func (s *CsService) GetContainerClusterAndCertsByName(name string) (*cs.ClusterT
 		return nil, nil, err
 	}
 	var certs cs.ClusterCerts
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 			return csClient.GetClusterCerts(cluster.ClusterID)
@@ -175,7 +175,7 @@ func (s *CsService) WaitForContainerApplication(clusterName, appName string, sta
 }
 
 func (s *CsService) DescribeCsKubernetes(id string) (cluster *cs.KubernetesClusterDetail, err error) {
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	var requestInfo *cs.Client
 	var response interface{}
 
@@ -240,7 +240,7 @@ func (s *CsClient) DescribeClusterDetail(id string) (*client.DescribeClusterDeta
 // It's used for kubernetes/managed_kubernetes/serverless_kubernetes.
 // Deprecated, use CsClient.DescribeClusterKubeConfigWithExpiration
 func (s *CsService) DescribeClusterKubeConfig(clusterId string, isResource bool) (*cs.ClusterConfig, error) {
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	var response interface{}
 	var requestInfo *cs.Client
 	var config *cs.ClusterConfig
@@ -850,7 +850,7 @@ func (s *CsClient) DescribeCsKubernetesAddonMetadata(clusterId string, name stri
 }
 
 func (s *CsService) DescribeCsKubernetesNodePool(id string) (nodePool *cs.NodePoolDetail, err error) {
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	var requestInfo *cs.Client
 	var response interface{}
 
@@ -914,7 +914,7 @@ func (s *CsService) WaitForCsKubernetes(id string, status Status, timeout int) e
 
 func (s *CsService) DescribeCsManagedKubernetes(id string) (cluster *cs.KubernetesClusterDetail, err error) {
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	var response interface{}
 
 	if err := invoker.Run(func() error {
@@ -1051,7 +1051,7 @@ func (s *CsService) CsServerlessKubernetesInstanceStateRefreshFunc(id string, fa
 func (s *CsService) DescribeCsServerlessKubernetes(id string) (*cs.ServerlessClusterResponse, error) {
 	cluster := &cs.ServerlessClusterResponse{}
 	var requestInfo *cs.Client
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	var response interface{}
 
 	if err := invoker.Run(func() error {
@@ -1116,6 +1116,9 @@ func (s *CsService) tagsToMap(tags []cs.Tag) map[string]string {
 }
 
 func (s *CsService) ignoreTag(t cs.Tag) bool {
+	if providerTagIgnored(s.client, t.Key) {
+		return true
+	}
 	filter := []string{"^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
@@ -33,7 +33,7 @@ func (s *DataWorksServiceV2) DescribeDataWorksProject(id string) (object map[str
 		response, err = client.RpcGet("dataworks-public", "2024-05-18", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) || IsExpectedErrors(err, []string{"9990020002", "9990040003"}) {
+			if IsRetryableError("dataworks-public", err) || IsExpectedErrors(err, []string{"9990020002", "9990040003"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -56,7 +56,7 @@ func (s *DataWorksServiceV2) DataWorksProjectStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDataWorksProject(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -85,7 +85,7 @@ func (s *DataWorksServiceV2) DataWorksProjectStateRefreshFunc(id string, field s
 
 // SetResourceTags <<< Encapsulated tag function for DataWorks.
 func (s *DataWorksServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -114,7 +114,7 @@ func (s *DataWorksServiceV2) SetResourceTags(d *schema.ResourceData, resourceTyp
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("dataworks-public", "2020-05-18", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("dataworks-public", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -146,7 +146,7 @@ func (s *DataWorksServiceV2) SetResourceTags(d *schema.ResourceData, resourceTyp
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("dataworks-public", "2020-05-18", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("dataworks-public", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -190,7 +190,7 @@ func (s *DataWorksServiceV2) DescribeDataWorksProjectMember(id string) (object m
 		response, err = client.RpcPost("dataworks-public", "2024-05-18", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) || IsExpectedErrors(err, []string{"9990020002"}) {
+			if IsRetryableError("dataworks-public", err) || IsExpectedErrors(err, []string{"9990020002"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -218,7 +218,7 @@ func (s *DataWorksServiceV2) DataWorksProjectMemberStateRefreshFunc(id string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDataWorksProjectMember(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -267,7 +267,7 @@ func (s *DataWorksServiceV2) DescribeDataWorksDataSource(id string) (object map[
 		response, err = client.RpcGet("dataworks-public", "2024-05-18", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("dataworks-public", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -295,7 +295,7 @@ func (s *DataWorksServiceV2) DataWorksDataSourceStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDataWorksDataSource(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -344,7 +344,7 @@ func (s *DataWorksServiceV2) DescribeDataWorksDataSourceSharedRule(id string) (o
 		response, err = client.RpcGet("dataworks-public", "2024-05-18", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("dataworks-public", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -387,7 +387,7 @@ func (s *DataWorksServiceV2) DataWorksDataSourceSharedRuleStateRefreshFunc(id st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDataWorksDataSourceSharedRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -437,7 +437,7 @@ func (s *DataWorksServiceV2) DescribeDataWorksDiAlarmRule(id string) (object map
 		response, err = client.RpcGet("dataworks-public", "2024-05-18", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("dataworks-public", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -469,7 +469,7 @@ func (s *DataWorksServiceV2) DataWorksDiAlarmRuleStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDataWorksDiAlarmRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -519,7 +519,7 @@ func (s *DataWorksServiceV2) DescribeDataWorksDiJob(id string) (object map[strin
 		response, err = client.RpcGet("dataworks-public", "2024-05-18", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("dataworks-public", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -547,7 +547,7 @@ func (s *DataWorksServiceV2) DataWorksDiJobStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDataWorksDiJob(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -592,7 +592,7 @@ func (s *DataWorksServiceV2) DescribeDataWorksNetwork(id string) (object map[str
 		response, err = client.RpcGet("dataworks-public", "2024-05-18", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("dataworks-public", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -622,7 +622,7 @@ func (s *DataWorksServiceV2) DataWorksNetworkStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDataWorksNetwork(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -667,7 +667,7 @@ func (s *DataWorksServiceV2) DescribeDataWorksDwResourceGroup(id string) (object
 		response, err = client.RpcGet("dataworks-public", "2024-05-18", action, query, nil)
 
 		if err != nil || IsExpectedErrors(err, []string{"9990040003"}) {
-			if NeedRetry(err) {
+			if IsRetryableError("dataworks-public", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -697,7 +697,7 @@ func (s *DataWorksServiceV2) DataWorksDwResourceGroupStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDataWorksDwResourceGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
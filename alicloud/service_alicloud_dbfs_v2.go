@@ -30,7 +30,7 @@ func (s *DbfsServiceV2) DescribeDbfsDbfsInstance(id string) (object map[string]i
 		response, err = client.RpcPost("DBFS", "2020-04-18", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("DBFS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -59,7 +59,7 @@ func (s *DbfsServiceV2) DbfsDbfsInstanceStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDbfsDbfsInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
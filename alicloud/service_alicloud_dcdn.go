@@ -666,7 +666,7 @@ func (s *DcdnService) SetResourceTags(d *schema.ResourceData, resourceType strin
 	resourceIdNum := strings.Count(d.Id(), ":")
 	var response map[string]interface{}
 	var err error
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		removedTagKeys := make([]string, 0)
 		for _, v := range removed {
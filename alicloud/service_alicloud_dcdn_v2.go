@@ -30,7 +30,7 @@ func (s *DcdnServiceV2) DescribeDcdnDomain(id string) (object map[string]interfa
 		response, err = client.RpcPost("dcdn", "2018-01-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("dcdn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -69,7 +69,7 @@ func (s *DcdnServiceV2) DescribeDescribeDcdnDomainCertificateInfo(id string) (ob
 		response, err = client.RpcPost("dcdn", "2018-01-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("dcdn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -113,7 +113,7 @@ func (s *DcdnServiceV2) DescribeDescribeDcdnTagResources(id string) (object map[
 		response, err = client.RpcPost("dcdn", "2018-01-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("dcdn", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -142,7 +142,7 @@ func (s *DcdnServiceV2) DcdnDomainStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDcdnDomain(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -29,7 +29,7 @@ func (s *DdosBgpServiceV2) DescribeDdosBgpPolicy(id string) (object map[string]i
 		response, err = client.RpcPost("ddosbgp", "2018-07-20", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ddosbgp", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -67,7 +67,7 @@ func (s *DdosBgpServiceV2) DdosBgpPolicyStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDdosBgpPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -41,7 +41,7 @@ func (s *DdosCooServiceV2) DescribeDdosCooPort(id string) (object map[string]int
 		response, err = client.RpcPost("ddoscoo", "2020-01-01", action, query, request, true)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"anycast_controller3006"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"anycast_controller3006"}) || IsRetryableError("ddoscoo", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -94,7 +94,7 @@ func (s *DdosCooServiceV2) DescribeDescribeNetworkRuleAttributes(id string) (obj
 		response, err = client.RpcPost("ddoscoo", "2020-01-01", action, query, request, true)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"anycast_controller3006"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"anycast_controller3006"}) || IsRetryableError("ddoscoo", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -124,7 +124,7 @@ func (s *DdosCooServiceV2) DdosCooPortStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDdosCooPort(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -161,7 +161,7 @@ func (s *DdosCooServiceV2) DescribeDdosCooDomainResource(id string) (object map[
 		response, err = client.RpcPost("ddoscoo", "2020-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ddoscoo", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -205,7 +205,7 @@ func (s *DdosCooServiceV2) DescribeDomainResourceDescribeWebRules(id string) (ob
 		response, err = client.RpcPost("ddoscoo", "2020-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ddoscoo", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -234,7 +234,7 @@ func (s *DdosCooServiceV2) DdosCooDomainResourceStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDdosCooDomainResource(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -280,7 +280,7 @@ func (s *DdosCooServiceV2) DescribeDdosCooInstance(id string) (object map[string
 		response, err = client.RpcPost("ddoscoo", "2020-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ddoscoo", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -323,7 +323,7 @@ func (s *DdosCooServiceV2) DescribeInstanceDescribeInstanceSpecs(id string) (obj
 		response, err = client.RpcPost("ddoscoo", "2020-01-01", action, query, request, true)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"InvalidInstanceId"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"InvalidInstanceId"}) || IsRetryableError("ddoscoo", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -333,7 +333,7 @@ func (s *DdosCooServiceV2) DescribeInstanceDescribeInstanceSpecs(id string) (obj
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		if IsExpectedErrors(err, []string{"InstanceNotFound", "ddos_coop3301"}) || NotFoundError(err) {
+		if IsExpectedErrors(err, []string{"InstanceNotFound", "ddos_coop3301"}) || IsNotFoundError(err) {
 			return object, WrapErrorf(NotFoundErr("Instance", id), NotFoundMsg, response)
 		}
 		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
@@ -367,7 +367,7 @@ func (s *DdosCooServiceV2) DescribeInstanceDescribeInstanceExt(id string) (objec
 		response, err = client.RpcPost("ddoscoo", "2020-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ddoscoo", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -409,7 +409,7 @@ func (s *DdosCooServiceV2) DescribeInstanceDescribeTagResources(id string) (obje
 		response, err = client.RpcPost("ddoscoo", "2020-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ddoscoo", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -458,7 +458,7 @@ func (s *DdosCooServiceV2) ModifyDdosCooInstance(schemaName string, specName str
 	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		response, err = client.RpcPost("ddoscoo", "2020-01-01", action, nil, request, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ddoscoo", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -478,7 +478,7 @@ func (s *DdosCooServiceV2) DdosCooInstanceStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDdosCooInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -507,7 +507,7 @@ func (s *DdosCooServiceV2) DdosCooInstanceStateRefreshFunc(id string, field stri
 
 // SetResourceTags <<< Encapsulated tag function for DdosCoo.
 func (s *DdosCooServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -537,7 +537,7 @@ func (s *DdosCooServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ddoscoo", "2020-01-01", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ddoscoo", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -570,7 +570,7 @@ func (s *DdosCooServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ddoscoo", "2020-01-01", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ddoscoo", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -34,7 +34,7 @@ func (s *DfsServiceV2) DescribeDfsAccessGroup(id string) (object map[string]inte
 		response, err = client.RpcPost("DFS", "2018-06-20", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("DFS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -64,7 +64,7 @@ func (s *DfsServiceV2) DfsAccessGroupStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDfsAccessGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -106,7 +106,7 @@ func (s *DfsServiceV2) DescribeDfsAccessRule(id string) (object map[string]inter
 		response, err = client.RpcPost("DFS", "2018-06-20", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("DFS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -136,7 +136,7 @@ func (s *DfsServiceV2) DfsAccessRuleStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDfsAccessRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -174,7 +174,7 @@ func (s *DfsServiceV2) DescribeDfsFileSystem(id string) (object map[string]inter
 		response, err = client.RpcPost("DFS", "2018-06-20", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("DFS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -202,7 +202,7 @@ func (s *DfsServiceV2) DfsFileSystemStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDfsFileSystem(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -252,7 +252,7 @@ func (s *DfsServiceV2) DescribeDfsMountPoint(id string) (object map[string]inter
 		response, err = client.RpcPost("DFS", "2018-06-20", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("DFS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -280,7 +280,7 @@ func (s *DfsServiceV2) DfsMountPointStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDfsMountPoint(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -334,7 +334,7 @@ func (s *DfsServiceV2) DescribeDfsVscMountPoint(id string) (object map[string]in
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPost("DFS", "2018-06-20", action, query, request, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("DFS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -366,7 +366,7 @@ func (s *DfsServiceV2) DfsVscMountPointStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDfsVscMountPoint(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -36,7 +36,7 @@ func (s *DMSEnterpriseServiceV2) DescribeDMSEnterpriseAuthorityTemplate(id strin
 		response, err = client.RpcPost("dms-enterprise", "2018-11-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("dms-enterprise", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -61,7 +61,7 @@ func (s *DMSEnterpriseServiceV2) DMSEnterpriseAuthorityTemplateStateRefreshFunc(
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDMSEnterpriseAuthorityTemplate(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
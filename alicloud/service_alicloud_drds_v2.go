@@ -34,7 +34,7 @@ func (s *DrdsServiceV2) DescribeDrdsPolardbxInstance(id string) (object map[stri
 		response, err = client.RpcPost("polardbx", "2020-02-02", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("polardbx", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -63,7 +63,7 @@ func (s *DrdsServiceV2) DrdsPolardbxInstanceStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDrdsPolardbxInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -98,7 +98,7 @@ func (s *DrdsServiceV2) DrdsPolardbxInstanceAsynJobs(d *schema.ResourceData, res
 		response, err = client.RpcPost("polardbx", "2020-02-02", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("polardbx", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -126,7 +126,7 @@ func (s *DrdsServiceV2) DrdsPolardbxInstanceJobStateRefreshFunc(d *schema.Resour
 	return func() (interface{}, string, error) {
 		object, err := s.DrdsPolardbxInstanceAsynJobs(d, response)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -163,7 +163,7 @@ func (s *DrdsServiceV2) DrdsPolardbxInstanceAsynDeleteJobs(d *schema.ResourceDat
 		response, err = client.RpcPost("polardbx", "2020-02-02", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("polardbx", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -191,7 +191,7 @@ func (s *DrdsServiceV2) DrdsPolardbxInstanceDeleteJobStateRefreshFunc(d *schema.
 	return func() (interface{}, string, error) {
 		object, err := s.DrdsPolardbxInstanceAsynDeleteJobs(d, response)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
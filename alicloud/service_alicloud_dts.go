@@ -195,7 +195,7 @@ func (s *DtsService) ListTagResources(id string, resourceType string) (object in
 
 func (s *DtsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
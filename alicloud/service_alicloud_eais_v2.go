@@ -37,7 +37,7 @@ func (s *EaisServiceV2) DescribeEaisClientInstanceAttachment(id string) (object
 		response, err = client.RpcPost("eais", "2019-06-24", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eais", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -74,7 +74,7 @@ func (s *EaisServiceV2) EaisClientInstanceAttachmentStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEaisClientInstanceAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -119,7 +119,7 @@ func (s *EaisServiceV2) DescribeEaisInstance(id string) (object map[string]inter
 		response, err = client.RpcPost("eais", "2019-06-24", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eais", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -151,7 +151,7 @@ func (s *EaisServiceV2) EaisInstanceStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEaisInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -180,7 +180,7 @@ func (s *EaisServiceV2) EaisInstanceStateRefreshFunc(id string, field string, fa
 
 // SetResourceTags <<< Encapsulated tag function for Eais.
 func (s *EaisServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -210,7 +210,7 @@ func (s *EaisServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("eais", "2019-06-24", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("eais", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -243,7 +243,7 @@ func (s *EaisServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("eais", "2019-06-24", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("eais", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -38,7 +38,7 @@ func (s *EbsServiceV2) DescribeEbsReplicaPairDrill(id string) (object map[string
 		response, err = client.RpcPost("ebs", "2021-07-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ebs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -69,7 +69,7 @@ func (s *EbsServiceV2) EbsReplicaPairDrillStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEbsReplicaPairDrill(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -112,7 +112,7 @@ func (s *EbsServiceV2) DescribeEbsReplicaGroupDrill(id string) (object map[strin
 		response, err = client.RpcPost("ebs", "2021-07-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ebs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -143,7 +143,7 @@ func (s *EbsServiceV2) EbsReplicaGroupDrillStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEbsReplicaGroupDrill(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -183,7 +183,7 @@ func (s *EbsServiceV2) DescribeEbsEnterpriseSnapshotPolicy(id string) (object ma
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ebs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -219,7 +219,7 @@ func (s *EbsServiceV2) EbsEnterpriseSnapshotPolicyStateRefreshFunc(id string, fi
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEbsEnterpriseSnapshotPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -241,7 +241,7 @@ func (s *EbsServiceV2) EbsEnterpriseSnapshotPolicyStateRefreshFunc(id string, fi
 
 // SetResourceTags <<< Encapsulated tag function for Ebs.
 func (s *EbsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -272,7 +272,7 @@ func (s *EbsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ebs", "2021-07-30", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ebs", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -306,7 +306,7 @@ func (s *EbsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ebs", "2021-07-30", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ebs", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -351,7 +351,7 @@ func (s *EbsServiceV2) DescribeEbsEnterpriseSnapshotPolicyAttachment(id string)
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ebs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -382,7 +382,7 @@ func (s *EbsServiceV2) EbsEnterpriseSnapshotPolicyAttachmentStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEbsEnterpriseSnapshotPolicyAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -421,7 +421,7 @@ func (s *EbsServiceV2) DescribeEbsSolutionInstance(id string) (object map[string
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ebs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -457,7 +457,7 @@ func (s *EbsServiceV2) EbsSolutionInstanceStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEbsSolutionInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -495,7 +495,7 @@ func (s *EbsServiceV2) DescribeEbsDiskReplicaPair(id string) (object map[string]
 		response, err = client.RpcPost("ebs", "2021-07-30", action, query, request, true)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"InternalError"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"InternalError"}) || IsRetryableError("ebs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -524,7 +524,7 @@ func (s *EbsServiceV2) EbsDiskReplicaPairStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEbsDiskReplicaPair(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -568,7 +568,7 @@ func (s *EbsServiceV2) DescribeEbsDiskReplicaGroup(id string) (object map[string
 		response, err = client.RpcPost("ebs", "2021-07-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ebs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -611,7 +611,7 @@ func (s *EbsServiceV2) DescribeDiskReplicaGroupListTagResources(id string) (obje
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ebs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -631,7 +631,7 @@ func (s *EbsServiceV2) EbsDiskReplicaGroupStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEbsDiskReplicaGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -333,7 +333,7 @@ func (s *EcdService) EcdNetworkPackageRefreshFunc(id string, failStates []string
 
 func (s *EcdService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
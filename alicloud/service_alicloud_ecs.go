@@ -444,24 +444,9 @@ func (s *EcsService) DescribeAvailableResources(d *schema.ResourceData, meta int
 		request.SystemDiskCategory = strings.TrimSpace(v.(string))
 	}
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	var response *ecs.DescribeAvailableResourceResponse
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-			return ecsClient.DescribeAvailableResource(request)
-		})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		response, _ = raw.(*ecs.DescribeAvailableResourceResponse)
-		return nil
-	}); err != nil {
-		return "", nil, "", WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	response, err := s.describeAvailableResource(request, d.Id())
+	if err != nil {
+		return "", nil, "", err
 	}
 	requestId = response.RequestId
 
@@ -509,6 +494,28 @@ func (s *EcsService) DescribeAvailableResources(d *schema.ResourceData, meta int
 	return
 }
 
+func (s *EcsService) describeAvailableResource(request *ecs.DescribeAvailableResourceRequest, id string) (response *ecs.DescribeAvailableResourceResponse, err error) {
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeAvailableResource(request)
+		})
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ = raw.(*ecs.DescribeAvailableResourceResponse)
+		return nil
+	}); err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	return response, nil
+}
+
 func (s *EcsService) InstanceTypeValidation(targetType, zoneId string, validZones []ecs.AvailableZone) error {
 
 	mapInstanceTypeToZones := make(map[string]string)
@@ -1516,6 +1523,9 @@ func (s *EcsService) tagsToMap(tags []ecs.Tag) map[string]string {
 }
 
 func (s *EcsService) ecsTagIgnored(t ecs.Tag) bool {
+	if providerTagIgnored(s.client, t.TagKey) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
@@ -1530,7 +1540,7 @@ func (s *EcsService) ecsTagIgnored(t ecs.Tag) bool {
 
 func (s *EcsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -2822,7 +2832,7 @@ func (s *EcsService) DescribeEcsSystemDisk(id string) (object map[string]interfa
 
 func (s *EcsService) SetInstanceSetResourceTags(d *schema.ResourceData, resourceType string, instanceIds []string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -33,7 +33,7 @@ func (s *EcsServiceV2) DescribeEcsImageComponent(id string) (object map[string]i
 		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ecs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -62,7 +62,7 @@ func (s *EcsServiceV2) EcsImageComponentStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEcsImageComponent(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -84,7 +84,7 @@ func (s *EcsServiceV2) EcsImageComponentStateRefreshFunc(id string, field string
 
 // SetResourceTags <<< Encapsulated tag function for Ecs.
 func (s *EcsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -114,7 +114,7 @@ func (s *EcsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Ecs", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -147,7 +147,7 @@ func (s *EcsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Ecs", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -186,7 +186,7 @@ func (s *EcsServiceV2) DescribeEcsImage(id string) (object map[string]interface{
 		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ecs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -219,7 +219,7 @@ func (s *EcsServiceV2) EcsImageStateRefreshFunc(id string, field string, failSta
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEcsImage(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -257,7 +257,7 @@ func (s *EcsServiceV2) DescribeEcsAutoSnapshotPolicy(id string) (object map[stri
 		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ecs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -307,7 +307,7 @@ func (s *EcsServiceV2) DescribeEcsImagePipelineExecution(id string) (object map[
 		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ecs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -336,7 +336,7 @@ func (s *EcsServiceV2) EcsImagePipelineExecutionStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEcsImagePipelineExecution(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -381,7 +381,7 @@ func (s *EcsServiceV2) DescribeEcsKeyPair(id string) (object map[string]interfac
 		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ecs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -431,7 +431,7 @@ func (s *EcsServiceV2) DescribeEcsDisk(id string) (object map[string]interface{}
 		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"InternalError"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"InternalError"}) || IsRetryableError("Ecs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -465,7 +465,7 @@ func (s *EcsServiceV2) EcsDiskStateRefreshFunc(id string, field string, failStat
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEcsDisk(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -510,7 +510,7 @@ func (s *EcsServiceV2) DescribeEcsSecurityGroup(id string) (object map[string]in
 		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ecs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -556,7 +556,7 @@ func (s *EcsServiceV2) DescribeSecurityGroupDescribeSecurityGroupAttribute(id st
 		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ecs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -595,7 +595,7 @@ func (s *EcsServiceV2) DescribeEcsSnapshot(id string) (object map[string]interfa
 		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ecs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -627,7 +627,7 @@ func (s *EcsServiceV2) EcsSnapshotStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEcsSnapshot(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -677,7 +677,7 @@ func (s *EcsServiceV2) DescribeEcsRamRoleAttachment(id string) (object map[strin
 		response, err = client.RpcPost("Ecs", "2014-05-26", action, query, request, true)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"unexpected end of JSON input"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"unexpected end of JSON input"}) || IsRetryableError("Ecs", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -717,7 +717,7 @@ func (s *EcsServiceV2) EcsRamRoleAttachmentStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEcsRamRoleAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
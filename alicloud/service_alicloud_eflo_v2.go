@@ -33,7 +33,7 @@ func (s *EfloServiceV2) DescribeEfloNode(id string) (object map[string]interface
 		response, err = client.RpcPost("eflo-controller", "2022-12-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-controller", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -43,7 +43,7 @@ func (s *EfloServiceV2) DescribeEfloNode(id string) (object map[string]interface
 	})
 	addDebug(action, response, request)
 	if err != nil {
-		if IsExpectedErrors(err, []string{"RESOURCE_NOT_FOUND"}) || NotFoundError(err) {
+		if IsExpectedErrors(err, []string{"RESOURCE_NOT_FOUND"}) || IsNotFoundError(err) {
 			return object, WrapErrorf(NotFoundErr("EfloNode", id), NotFoundMsg, response)
 		}
 		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
@@ -56,7 +56,7 @@ func (s *EfloServiceV2) EfloNodeStateRefreshFunc(id string, field string, failSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEfloNode(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -101,7 +101,7 @@ func (s *EfloServiceV2) DescribeEfloCluster(id string) (object map[string]interf
 		response, err = client.RpcPost("eflo-controller", "2022-12-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-controller", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -139,7 +139,7 @@ func (s *EfloServiceV2) DescribeClusterListTagResources(id string) (object map[s
 		response, err = client.RpcPost("eflo-controller", "2022-12-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-controller", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -159,7 +159,7 @@ func (s *EfloServiceV2) EfloClusterStateRefreshFunc(id string, field string, fai
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEfloCluster(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -196,7 +196,7 @@ func (s *EfloServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 		apiVersion = "2023-08-28"
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -226,7 +226,7 @@ func (s *EfloServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost(apiProductCode, apiVersion, action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError(apiProductCode, err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -259,7 +259,7 @@ func (s *EfloServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost(apiProductCode, apiVersion, action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError(apiProductCode, err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -303,7 +303,7 @@ func (s *EfloServiceV2) DescribeEfloNodeGroup(id string) (object map[string]inte
 		response, err = client.RpcPost("eflo-controller", "2022-12-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-controller", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -354,7 +354,7 @@ func (s *EfloServiceV2) DescribeNodeGroupListClusterNodes(id string) (object map
 		response, err = client.RpcPost("eflo-controller", "2022-12-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-controller", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -374,7 +374,7 @@ func (s *EfloServiceV2) EfloNodeGroupStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEfloNodeGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -403,7 +403,7 @@ func (s *EfloServiceV2) DescribeAsyncEfloNodeGroupStateRefreshFunc(d *schema.Res
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncDescribeTask(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -454,7 +454,7 @@ func (s *EfloServiceV2) DescribeAsyncDescribeTask(d *schema.ResourceData, res ma
 		response, err = client.RpcPost("eflo-controller", "2022-12-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-controller", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -490,7 +490,7 @@ func (s *EfloServiceV2) DescribeEfloInvocation(id string) (object map[string]int
 		response, err = client.RpcPost("eflo-controller", "2022-12-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-controller", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -519,7 +519,7 @@ func (s *EfloServiceV2) EfloInvocationStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEfloInvocation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -563,7 +563,7 @@ func (s *EfloServiceV2) DescribeNodeListTagResources(id string) (object map[stri
 		response, err = client.RpcPost("eflo-controller", "2022-12-15", action, query, request, true)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"SystemError"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"SystemError"}) || IsRetryableError("eflo-controller", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -597,7 +597,7 @@ func (s *EfloServiceV2) DescribeEfloExperimentPlanTemplate(id string) (object ma
 		response, err = client.RpcPost("eflo-cnp", "2023-08-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-cnp", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -625,7 +625,7 @@ func (s *EfloServiceV2) EfloExperimentPlanTemplateStateRefreshFunc(id string, fi
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEfloExperimentPlanTemplate(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -670,7 +670,7 @@ func (s *EfloServiceV2) DescribeEfloResource(id string) (object map[string]inter
 		response, err = client.RpcPost("eflo-cnp", "2023-08-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-cnp", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -698,7 +698,7 @@ func (s *EfloServiceV2) EfloResourceStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEfloResource(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -743,7 +743,7 @@ func (s *EfloServiceV2) DescribeEfloExperimentPlan(id string) (object map[string
 		response, err = client.RpcPost("eflo-cnp", "2023-08-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-cnp", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -771,7 +771,7 @@ func (s *EfloServiceV2) EfloExperimentPlanStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEfloExperimentPlan(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -816,7 +816,7 @@ func (s *EfloServiceV2) DescribeEfloVsc(id string) (object map[string]interface{
 		response, err = client.RpcPost("eflo-controller", "2022-12-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eflo-controller", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -857,7 +857,7 @@ func (s *EfloServiceV2) DescribeVscListTagResources(id string) (object map[strin
 		response, err = client.RpcPost("eflo-controller", "2022-12-15", action, query, request, true)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"SystemError"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"SystemError"}) || IsRetryableError("eflo-controller", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -877,7 +877,7 @@ func (s *EfloServiceV2) EfloVscStateRefreshFunc(id string, field string, failSta
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEfloVsc(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
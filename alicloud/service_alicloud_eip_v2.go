@@ -33,7 +33,7 @@ func (s *EipServiceV2) DescribeEipAddress(id string) (object map[string]interfac
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -74,7 +74,7 @@ func (s *EipServiceV2) DescribeDescribeHighDefinitionMonitorLogAttribute(id stri
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -95,7 +95,7 @@ func (s *EipServiceV2) EipAddressStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEipAddress(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -117,7 +117,7 @@ func (s *EipServiceV2) EipAddressStateRefreshFunc(id string, field string, failS
 
 // SetResourceTags <<< Encapsulated tag function for Eip.
 func (s *EipServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -147,7 +147,7 @@ func (s *EipServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Vpc", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -180,7 +180,7 @@ func (s *EipServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Vpc", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -221,7 +221,7 @@ func (s *EipServiceV2) DescribeEipSegmentAddress(id string) (object map[string]i
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -251,7 +251,7 @@ func (s *EipServiceV2) EipSegmentAddressStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEipSegmentAddress(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -293,7 +293,7 @@ func (s *EipServiceV2) DescribeEipAssociation(id string) (object map[string]inte
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -331,7 +331,7 @@ func (s *EipServiceV2) EipAssociationStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEipAssociation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
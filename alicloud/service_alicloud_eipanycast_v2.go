@@ -37,7 +37,7 @@ func (s *EipanycastServiceV2) DescribeEipanycastAnycastEipAddress(id string) (ob
 		response, err = client.RpcPost("Eipanycast", "2020-03-09", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Eipanycast", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -62,7 +62,7 @@ func (s *EipanycastServiceV2) EipanycastAnycastEipAddressStateRefreshFunc(id str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEipanycastAnycastEipAddress(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -84,7 +84,7 @@ func (s *EipanycastServiceV2) EipanycastAnycastEipAddressStateRefreshFunc(id str
 
 // SetResourceTags <<< Encapsulated tag function for Eipanycast.
 func (s *EipanycastServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -115,7 +115,7 @@ func (s *EipanycastServiceV2) SetResourceTags(d *schema.ResourceData, resourceTy
 				response, err = client.RpcPost("Eipanycast", "2020-03-09", action, query, request, false)
 
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Eipanycast", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -149,7 +149,7 @@ func (s *EipanycastServiceV2) SetResourceTags(d *schema.ResourceData, resourceTy
 				response, err = client.RpcPost("Eipanycast", "2020-03-09", action, query, request, false)
 
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Eipanycast", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -195,7 +195,7 @@ func (s *EipanycastServiceV2) DescribeEipanycastAnycastEipAddressAttachment(id s
 		response, err = client.RpcPost("Eipanycast", "2020-03-09", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Eipanycast", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -235,7 +235,7 @@ func (s *EipanycastServiceV2) EipanycastAnycastEipAddressAttachmentStateRefreshF
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEipanycastAnycastEipAddressAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -151,7 +151,7 @@ func (s *ElasticsearchService) getKibanaPvlNetworkInfo(id string) (interface{},
 	listKibanaPvlNetworkReq.SetContentType("application/json")
 	listKibanaPvlNetworkResp := responses.BaseResponse{}
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err := invoker.Run(func() error {
 		raw, err := s.client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
 			err := elasticsearchClient.DoAction(&listKibanaPvlNetworkReq, &listKibanaPvlNetworkResp)
@@ -431,7 +431,7 @@ func updateInstanceTags(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	elasticsearchService := ElasticsearchService{client}
 
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	remove, add := elasticsearchService.diffElasticsearchTags(o, n)
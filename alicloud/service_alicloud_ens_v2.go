@@ -33,7 +33,7 @@ func (s *EnsServiceV2) DescribeEnsInstance(id string) (object map[string]interfa
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -74,7 +74,7 @@ func (s *EnsServiceV2) DescribeListTagResources(id string) (object map[string]in
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -95,7 +95,7 @@ func (s *EnsServiceV2) EnsInstanceStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -142,7 +142,7 @@ func (s *EnsServiceV2) DescribeEnsDisk(id string) (object map[string]interface{}
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -184,7 +184,7 @@ func (s *EnsServiceV2) DescribeDiskListTagResources(id string) (object map[strin
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -204,7 +204,7 @@ func (s *EnsServiceV2) EnsDiskStateRefreshFunc(id string, field string, failStat
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsDisk(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -253,7 +253,7 @@ func (s *EnsServiceV2) DescribeEnsSnapshot(id string) (object map[string]interfa
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -283,7 +283,7 @@ func (s *EnsServiceV2) EnsSnapshotStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsSnapshot(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -320,7 +320,7 @@ func (s *EnsServiceV2) DescribeEnsNetwork(id string) (object map[string]interfac
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -350,7 +350,7 @@ func (s *EnsServiceV2) EnsNetworkStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsNetwork(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -388,7 +388,7 @@ func (s *EnsServiceV2) DescribeEnsEip(id string) (object map[string]interface{},
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -417,7 +417,7 @@ func (s *EnsServiceV2) EnsEipStateRefreshFunc(id string, field string, failState
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsEip(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -461,7 +461,7 @@ func (s *EnsServiceV2) DescribeEnsLoadBalancer(id string) (object map[string]int
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -487,7 +487,7 @@ func (s *EnsServiceV2) EnsLoadBalancerStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsLoadBalancer(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -525,7 +525,7 @@ func (s *EnsServiceV2) DescribeEnsVswitch(id string) (object map[string]interfac
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -548,7 +548,7 @@ func (s *EnsServiceV2) EnsVswitchStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsVswitch(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -592,7 +592,7 @@ func (s *EnsServiceV2) DescribeEnsSecurityGroup(id string) (object map[string]in
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -616,7 +616,7 @@ func (s *EnsServiceV2) EnsSecurityGroupStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsSecurityGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -654,7 +654,7 @@ func (s *EnsServiceV2) DescribeEnsImage(id string) (object map[string]interface{
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -683,7 +683,7 @@ func (s *EnsServiceV2) EnsImageStateRefreshFunc(id string, field string, failSta
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsImage(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -731,7 +731,7 @@ func (s *EnsServiceV2) DescribeEnsDiskInstanceAttachment(id string) (object map[
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -762,7 +762,7 @@ func (s *EnsServiceV2) EnsDiskInstanceAttachmentStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsDiskInstanceAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -803,7 +803,7 @@ func (s *EnsServiceV2) DescribeEnsInstanceSecurityGroupAttachment(id string) (ob
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -834,7 +834,7 @@ func (s *EnsServiceV2) EnsInstanceSecurityGroupAttachmentStateRefreshFunc(id str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsInstanceSecurityGroupAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -875,7 +875,7 @@ func (s *EnsServiceV2) DescribeEnsEipInstanceAttachment(id string) (object map[s
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -919,7 +919,7 @@ func (s *EnsServiceV2) EnsEipInstanceAttachmentStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsEipInstanceAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -956,7 +956,7 @@ func (s *EnsServiceV2) DescribeEnsNatGateway(id string) (object map[string]inter
 		response, err = client.RpcGet("Ens", "2017-11-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -986,7 +986,7 @@ func (s *EnsServiceV2) EnsNatGatewayStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsNatGateway(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1022,7 +1022,7 @@ func (s *EnsServiceV2) DescribeEnsKeyPair(id string) (object map[string]interfac
 		response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ens", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1052,7 +1052,7 @@ func (s *EnsServiceV2) EnsKeyPairStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEnsKeyPair(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1074,7 +1074,7 @@ func (s *EnsServiceV2) EnsKeyPairStateRefreshFunc(id string, field string, failS
 
 // SetResourceTags <<< Encapsulated tag function for Ens.
 func (s *EnsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -1104,7 +1104,7 @@ func (s *EnsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Ens", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -1137,7 +1137,7 @@ func (s *EnsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Ens", "2017-11-10", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Ens", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -35,7 +35,7 @@ func (s *EsaServiceV2) DescribeEsaSite(id string) (object map[string]interface{}
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -75,7 +75,7 @@ func (s *EsaServiceV2) DescribeSiteListTagResources(id string) (object map[strin
 		response, err = client.RpcPost("ESA", "2024-09-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -109,7 +109,7 @@ func (s *EsaServiceV2) DescribeSiteGetManagedTransform(id string) (object map[st
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -143,7 +143,7 @@ func (s *EsaServiceV2) DescribeSiteGetCacheTag(id string) (object map[string]int
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -177,7 +177,7 @@ func (s *EsaServiceV2) DescribeSiteGetIPv6(id string) (object map[string]interfa
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -211,7 +211,7 @@ func (s *EsaServiceV2) DescribeSiteGetCacheReserve(id string) (object map[string
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -245,7 +245,7 @@ func (s *EsaServiceV2) DescribeSiteGetTieredCache(id string) (object map[string]
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -279,7 +279,7 @@ func (s *EsaServiceV2) DescribeSiteGetCrossBorderOptimization(id string) (object
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -313,7 +313,7 @@ func (s *EsaServiceV2) DescribeSiteGetSiteNameExclusive(id string) (object map[s
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -347,7 +347,7 @@ func (s *EsaServiceV2) DescribeSiteGetCnameFlattening(id string) (object map[str
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -381,7 +381,7 @@ func (s *EsaServiceV2) DescribeSiteGetSeoBypass(id string) (object map[string]in
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -415,7 +415,7 @@ func (s *EsaServiceV2) DescribeSiteGetDevelopmentMode(id string) (object map[str
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -449,7 +449,7 @@ func (s *EsaServiceV2) DescribeSiteGetSitePause(id string) (object map[string]in
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -472,7 +472,7 @@ func (s *EsaServiceV2) EsaSiteStateRefreshFunc(id string, field string, failStat
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaSite(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -501,7 +501,7 @@ func (s *EsaServiceV2) DescribeAsyncEsaSiteStateRefreshFunc(d *schema.ResourceDa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncGetSite(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 		}
@@ -532,7 +532,7 @@ func (s *EsaServiceV2) DescribeAsyncEsaSiteStateRefreshFunc(d *schema.ResourceDa
 
 // SetResourceTags <<< Encapsulated tag function for Esa.
 func (s *EsaServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -565,7 +565,7 @@ func (s *EsaServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ESA", "2024-09-10", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ESA", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -601,7 +601,7 @@ func (s *EsaServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ESA", "2024-09-10", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ESA", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -640,7 +640,7 @@ func (s *EsaServiceV2) DescribeEsaRatePlanInstance(id string) (object map[string
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -684,7 +684,7 @@ func (s *EsaServiceV2) DescribeDescribeRatePlanInstanceStatus(id string) (object
 		response, err = client.RpcPost("ESA", "2024-09-10", action, query, nil, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -704,7 +704,7 @@ func (s *EsaServiceV2) EsaRatePlanInstanceStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeDescribeRatePlanInstanceStatus(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -749,7 +749,7 @@ func (s *EsaServiceV2) DescribeEsaRecord(id string) (object map[string]interface
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -774,7 +774,7 @@ func (s *EsaServiceV2) EsaRecordStateRefreshFunc(id string, field string, failSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaRecord(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -818,7 +818,7 @@ func (s *EsaServiceV2) DescribeEsaList(id string) (object map[string]interface{}
 		response, err = client.RpcPost("ESA", "2024-09-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -843,7 +843,7 @@ func (s *EsaServiceV2) EsaListStateRefreshFunc(id string, field string, failStat
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaList(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -887,7 +887,7 @@ func (s *EsaServiceV2) DescribeEsaPage(id string) (object map[string]interface{}
 		response, err = client.RpcPost("ESA", "2024-09-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -912,7 +912,7 @@ func (s *EsaServiceV2) EsaPageStateRefreshFunc(id string, field string, failStat
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaPage(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -961,7 +961,7 @@ func (s *EsaServiceV2) DescribeEsaHttpRequestHeaderModificationRule(id string) (
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -981,7 +981,7 @@ func (s *EsaServiceV2) EsaHttpRequestHeaderModificationRuleStateRefreshFunc(id s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaHttpRequestHeaderModificationRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1031,7 +1031,7 @@ func (s *EsaServiceV2) DescribeEsaRewriteUrlRule(id string) (object map[string]i
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, nil)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"InternalException"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"InternalException"}) || IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1055,7 +1055,7 @@ func (s *EsaServiceV2) EsaRewriteUrlRuleStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaRewriteUrlRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1105,7 +1105,7 @@ func (s *EsaServiceV2) DescribeEsaRedirectRule(id string) (object map[string]int
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1125,7 +1125,7 @@ func (s *EsaServiceV2) EsaRedirectRuleStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaRedirectRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1175,7 +1175,7 @@ func (s *EsaServiceV2) DescribeEsaHttpResponseHeaderModificationRule(id string)
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1195,7 +1195,7 @@ func (s *EsaServiceV2) EsaHttpResponseHeaderModificationRuleStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaHttpResponseHeaderModificationRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1245,7 +1245,7 @@ func (s *EsaServiceV2) DescribeEsaHttpsBasicConfiguration(id string) (object map
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1265,7 +1265,7 @@ func (s *EsaServiceV2) EsaHttpsBasicConfigurationStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaHttpsBasicConfiguration(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1310,7 +1310,7 @@ func (s *EsaServiceV2) DescribeAsyncGetSite(d *schema.ResourceData, res map[stri
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1350,7 +1350,7 @@ func (s *EsaServiceV2) DescribeEsaCompressionRule(id string) (object map[string]
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1370,7 +1370,7 @@ func (s *EsaServiceV2) EsaCompressionRuleStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaCompressionRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1419,7 +1419,7 @@ func (s *EsaServiceV2) DescribeEsaHttpsApplicationConfiguration(id string) (obje
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1439,7 +1439,7 @@ func (s *EsaServiceV2) EsaHttpsApplicationConfigurationStateRefreshFunc(id strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaHttpsApplicationConfiguration(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1488,7 +1488,7 @@ func (s *EsaServiceV2) DescribeEsaNetworkOptimization(id string) (object map[str
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1508,7 +1508,7 @@ func (s *EsaServiceV2) EsaNetworkOptimizationStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaNetworkOptimization(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1558,7 +1558,7 @@ func (s *EsaServiceV2) DescribeEsaCacheRule(id string) (object map[string]interf
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1583,7 +1583,7 @@ func (s *EsaServiceV2) EsaCacheRuleStateRefreshFunc(id string, field string, fai
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaCacheRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1632,7 +1632,7 @@ func (s *EsaServiceV2) DescribeEsaOriginRule(id string) (object map[string]inter
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1657,7 +1657,7 @@ func (s *EsaServiceV2) EsaOriginRuleStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaOriginRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1706,7 +1706,7 @@ func (s *EsaServiceV2) DescribeEsaImageTransform(id string) (object map[string]i
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1726,7 +1726,7 @@ func (s *EsaServiceV2) EsaImageTransformStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaImageTransform(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1775,7 +1775,7 @@ func (s *EsaServiceV2) DescribeEsaWaitingRoom(id string) (object map[string]inte
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1804,7 +1804,7 @@ func (s *EsaServiceV2) EsaWaitingRoomStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaWaitingRoom(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1853,7 +1853,7 @@ func (s *EsaServiceV2) DescribeEsaOriginPool(id string) (object map[string]inter
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1873,7 +1873,7 @@ func (s *EsaServiceV2) EsaOriginPoolStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaOriginPool(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1923,7 +1923,7 @@ func (s *EsaServiceV2) DescribeEsaWaitingRoomEvent(id string) (object map[string
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1952,7 +1952,7 @@ func (s *EsaServiceV2) EsaWaitingRoomEventStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaWaitingRoomEvent(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2002,7 +2002,7 @@ func (s *EsaServiceV2) DescribeEsaWaitingRoomRule(id string) (object map[string]
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2031,7 +2031,7 @@ func (s *EsaServiceV2) EsaWaitingRoomRuleStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaWaitingRoomRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2080,7 +2080,7 @@ func (s *EsaServiceV2) DescribeEsaCertificate(id string) (object map[string]inte
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2100,7 +2100,7 @@ func (s *EsaServiceV2) EsaCertificateStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaCertificate(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2149,7 +2149,7 @@ func (s *EsaServiceV2) DescribeEsaClientCertificate(id string) (object map[strin
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2169,7 +2169,7 @@ func (s *EsaServiceV2) EsaClientCertificateStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaClientCertificate(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2218,7 +2218,7 @@ func (s *EsaServiceV2) DescribeEsaClientCaCertificate(id string) (object map[str
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2238,7 +2238,7 @@ func (s *EsaServiceV2) EsaClientCaCertificateStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaClientCaCertificate(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2282,7 +2282,7 @@ func (s *EsaServiceV2) DescribeEsaKvNamespace(id string) (object map[string]inte
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2302,7 +2302,7 @@ func (s *EsaServiceV2) EsaKvNamespaceStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaKvNamespace(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2347,7 +2347,7 @@ func (s *EsaServiceV2) DescribeEsaCacheReserveInstance(id string) (object map[st
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2381,7 +2381,7 @@ func (s *EsaServiceV2) EsaCacheReserveInstanceStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaCacheReserveInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2430,7 +2430,7 @@ func (s *EsaServiceV2) DescribeEsaSiteDeliveryTask(id string) (object map[string
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2450,7 +2450,7 @@ func (s *EsaServiceV2) EsaSiteDeliveryTaskStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaSiteDeliveryTask(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2494,7 +2494,7 @@ func (s *EsaServiceV2) DescribeEsaEdgeContainerApp(id string) (object map[string
 		response, err = client.RpcPost("ESA", "2024-09-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2519,7 +2519,7 @@ func (s *EsaServiceV2) EsaEdgeContainerAppStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaEdgeContainerApp(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2567,7 +2567,7 @@ func (s *EsaServiceV2) DescribeEsaEdgeContainerAppRecord(id string) (object map[
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2607,7 +2607,7 @@ func (s *EsaServiceV2) EsaEdgeContainerAppRecordStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaEdgeContainerAppRecord(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2655,7 +2655,7 @@ func (s *EsaServiceV2) DescribeEsaScheduledPreloadJob(id string) (object map[str
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2681,7 +2681,7 @@ func (s *EsaServiceV2) EsaScheduledPreloadJobStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaScheduledPreloadJob(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2729,7 +2729,7 @@ func (s *EsaServiceV2) DescribeEsaScheduledPreloadExecution(id string) (object m
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2766,7 +2766,7 @@ func (s *EsaServiceV2) EsaScheduledPreloadExecutionStateRefreshFunc(id string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaScheduledPreloadExecution(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2816,7 +2816,7 @@ func (s *EsaServiceV2) DescribeEsaKv(id string) (object map[string]interface{},
 		response, err = client.RpcGet("ESA", "2024-09-10", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2836,7 +2836,7 @@ func (s *EsaServiceV2) EsaKvStateRefreshFunc(id string, field string, failStates
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaKv(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2885,7 +2885,7 @@ func (s *EsaServiceV2) DescribeEsaVideoProcessing(id string) (object map[string]
 		response, err = client.RpcPost("ESA", "2024-09-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2905,7 +2905,7 @@ func (s *EsaServiceV2) EsaVideoProcessingStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaVideoProcessing(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2949,7 +2949,7 @@ func (s *EsaServiceV2) DescribeEsaRoutine(id string) (object map[string]interfac
 		response, err = client.RpcPost("ESA", "2024-09-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2969,7 +2969,7 @@ func (s *EsaServiceV2) EsaRoutineStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaRoutine(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -3019,7 +3019,7 @@ func (s *EsaServiceV2) DescribeEsaRoutineRoute(id string) (object map[string]int
 		response, err = client.RpcPost("ESA", "2024-09-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ESA", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -3045,7 +3045,7 @@ func (s *EsaServiceV2) EsaRoutineRouteStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeEsaRoutineRoute(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -904,7 +904,7 @@ func (s *EssService) WaitForEssAlarm(id string, status Status, timeout int) erro
 
 func (s *EssService) SetResourceTags(d *schema.ResourceData, scalingGroupId string, client *connectivity.AliyunClient) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 
 		// untag resources
@@ -1002,3 +1002,68 @@ func (s *EssService) ListTagResources(scalingGroupId string, client *connectivit
 	}
 	return tags, nil
 }
+
+// DescribeEssInstanceRefresh describes the instance refresh task whose id is <scaling_group_id>:<instance_refresh_task_id>.
+func (s *EssService) DescribeEssInstanceRefresh(id string) (object map[string]interface{}, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return object, WrapError(err)
+	}
+	client := s.client
+	var response map[string]interface{}
+	action := "DescribeInstanceRefreshes"
+	request := map[string]interface{}{
+		"RegionId":                 client.RegionId,
+		"ScalingGroupId":           parts[0],
+		"InstanceRefreshTaskIds.1": parts[1],
+	}
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		response, err = client.RpcPost("Ess", "2014-08-28", action, nil, request, true)
+		if err != nil {
+			if IsRetryableError("Ess", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
+	}
+
+	v, err := jsonpath.Get("$.InstanceRefreshTasks", response)
+	if err != nil {
+		return object, WrapErrorf(err, FailedGetAttributeMsg, id, "$.InstanceRefreshTasks", response)
+	}
+	if tasks, ok := v.([]interface{}); ok {
+		for _, task := range tasks {
+			if item, ok := task.(map[string]interface{}); ok && fmt.Sprint(item["InstanceRefreshTaskId"]) == parts[1] {
+				return item, nil
+			}
+		}
+	}
+	return object, WrapErrorf(NotFoundErr("InstanceRefresh", id), NotFoundMsg, response)
+}
+
+func (s *EssService) EssInstanceRefreshStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeEssInstanceRefresh(id)
+		if err != nil {
+			if IsNotFoundError(err) {
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		currentStatus := fmt.Sprint(object["Status"])
+		for _, failState := range failStates {
+			if currentStatus == failState {
+				return object, currentStatus, WrapError(Error(FailedToReachTargetStatusWithResponse, id, convertObjectToJsonString(object)))
+			}
+		}
+		return object, currentStatus, nil
+	}
+}
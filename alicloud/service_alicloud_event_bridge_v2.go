@@ -27,7 +27,7 @@ func (s *EventBridgeServiceV2) DescribeEventBridgeConnection(id string) (object
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPost("eventbridge", "2020-04-01", action, nil, request, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eventbridge", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -79,7 +79,7 @@ func (s *EventBridgeServiceV2) DescribeEventBridgeApiDestination(id string) (obj
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPost("eventbridge", "2020-04-01", action, nil, request, false)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("eventbridge", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -35,7 +35,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeExpressConnectRouterExpressConne
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -83,7 +83,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeDescribeInstanceGrantedToExpress
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -117,7 +117,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeDescribeExpressConnectRouterRout
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -151,7 +151,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeDescribeDisabledExpressConnectRo
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -185,7 +185,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeDescribeExpressConnectRouterInte
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -219,7 +219,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeDescribeExpressConnectRouterRegi
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -240,7 +240,7 @@ func (s *ExpressConnectRouterServiceV2) ExpressConnectRouterExpressConnectRouter
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectRouterExpressConnectRouter(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -277,7 +277,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeAsyncDescribeInstanceGrantedToEx
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -301,7 +301,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeAsyncExpressConnectRouterExpress
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncDescribeInstanceGrantedToExpressConnectRouter(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -340,7 +340,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeAsyncGetExpressConnectRouter(d *
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -361,7 +361,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeAsyncGetExpressConnectRouter(d *
 
 // SetResourceTags <<< Encapsulated tag function for ExpressConnectRouter.
 func (s *ExpressConnectRouterServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -391,7 +391,7 @@ func (s *ExpressConnectRouterServiceV2) SetResourceTags(d *schema.ResourceData,
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ExpressConnectRouter", "2023-09-01", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ExpressConnectRouter", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -424,7 +424,7 @@ func (s *ExpressConnectRouterServiceV2) SetResourceTags(d *schema.ResourceData,
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ExpressConnectRouter", "2023-09-01", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ExpressConnectRouter", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -471,7 +471,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeExpressConnectRouterExpressConne
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -520,7 +520,7 @@ func (s *ExpressConnectRouterServiceV2) ExpressConnectRouterExpressConnectRouter
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectRouterExpressConnectRouterVbrChildInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -569,7 +569,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeAsyncExpressConnectRouterVbrChil
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -589,7 +589,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeAsyncExpressConnectRouterExpress
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncExpressConnectRouterVbrChildInstanceDescribeExpressConnectRouterChildInstance(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -644,7 +644,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeExpressConnectRouterExpressConne
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -691,7 +691,7 @@ func (s *ExpressConnectRouterServiceV2) ExpressConnectRouterExpressConnectRouter
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectRouterExpressConnectRouterTrAssociation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -737,7 +737,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeExpressConnectRouterExpressConne
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -803,7 +803,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeDescribeExpressConnectRouterAllo
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -832,7 +832,7 @@ func (s *ExpressConnectRouterServiceV2) ExpressConnectRouterExpressConnectRouter
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectRouterExpressConnectRouterVpcAssociation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -880,7 +880,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeExpressConnectRouterGrantAssocia
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ExpressConnectRouter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -929,7 +929,7 @@ func (s *ExpressConnectRouterServiceV2) ExpressConnectRouterGrantAssociationStat
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectRouterGrantAssociation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
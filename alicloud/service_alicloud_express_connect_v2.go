@@ -35,7 +35,7 @@ func (s *ExpressConnectServiceV2) DescribeExpressConnectEcFailoverTestJob(id str
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -64,7 +64,7 @@ func (s *ExpressConnectServiceV2) ExpressConnectEcFailoverTestJobStateRefreshFun
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectEcFailoverTestJob(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -101,7 +101,7 @@ func (s *ExpressConnectServiceV2) DescribeExpressConnectBgpPeer(id string) (obje
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -131,7 +131,7 @@ func (s *ExpressConnectServiceV2) ExpressConnectBgpPeerStateRefreshFunc(id strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectBgpPeer(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -171,7 +171,7 @@ func (s *ExpressConnectServiceV2) DescribeExpressConnectTrafficQos(id string) (o
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -201,7 +201,7 @@ func (s *ExpressConnectServiceV2) ExpressConnectTrafficQosStateRefreshFunc(id st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectTrafficQos(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -245,7 +245,7 @@ func (s *ExpressConnectServiceV2) DescribeExpressConnectTrafficQosAssociation(id
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -286,7 +286,7 @@ func (s *ExpressConnectServiceV2) ExpressConnectTrafficQosAssociationStateRefres
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectTrafficQosAssociation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -326,7 +326,7 @@ func (s *ExpressConnectServiceV2) DescribeAsyncTrafficQosAssociationDescribeExpr
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -347,7 +347,7 @@ func (s *ExpressConnectServiceV2) DescribeAsyncExpressConnectTrafficQosAssociati
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncTrafficQosAssociationDescribeExpressConnectTrafficQos(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -395,7 +395,7 @@ func (s *ExpressConnectServiceV2) DescribeExpressConnectTrafficQosQueue(id strin
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -425,7 +425,7 @@ func (s *ExpressConnectServiceV2) ExpressConnectTrafficQosQueueStateRefreshFunc(
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectTrafficQosQueue(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -465,7 +465,7 @@ func (s *ExpressConnectServiceV2) DescribeAsyncTrafficQosQueueDescribeExpressCon
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -486,7 +486,7 @@ func (s *ExpressConnectServiceV2) DescribeAsyncExpressConnectTrafficQosQueueStat
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncTrafficQosQueueDescribeExpressConnectTrafficQos(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -535,7 +535,7 @@ func (s *ExpressConnectServiceV2) DescribeExpressConnectTrafficQosRule(id string
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -565,7 +565,7 @@ func (s *ExpressConnectServiceV2) ExpressConnectTrafficQosRuleStateRefreshFunc(i
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeExpressConnectTrafficQosRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -605,7 +605,7 @@ func (s *ExpressConnectServiceV2) DescribeAsyncTrafficQosRuleDescribeExpressConn
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -626,7 +626,7 @@ func (s *ExpressConnectServiceV2) DescribeAsyncExpressConnectTrafficQosRuleState
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncTrafficQosRuleDescribeExpressConnectTrafficQos(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
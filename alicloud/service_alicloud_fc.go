@@ -421,7 +421,7 @@ func (s *FcService) DescribeFcFunctionAsyncInvokeConfig(id string) (*fc.GetFunct
 }
 
 func (s *FcService) SetResourceTags(d *schema.ResourceData, resourceArn *string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 
 		removedTagKeys := make([]string, 0)
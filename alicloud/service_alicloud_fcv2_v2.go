@@ -35,7 +35,7 @@ func (s *Fcv2ServiceV2) DescribeFcv2Function(id string) (object map[string]inter
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.RoaGet("FC-Open", "2021-04-06", action, query, nil, nil)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC-Open", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -59,7 +59,7 @@ func (s *Fcv2ServiceV2) Fcv2FunctionStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv2Function(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -34,7 +34,7 @@ func (s *Fcv3ServiceV2) DescribeFcv3Function(id string) (object map[string]inter
 		response, err = client.RoaGet("FC", "2023-03-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -57,7 +57,7 @@ func (s *Fcv3ServiceV2) Fcv3FunctionStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv3Function(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -102,7 +102,7 @@ func (s *Fcv3ServiceV2) DescribeFcv3CustomDomain(id string) (object map[string]i
 		response, err = client.RoaGet("FC", "2023-03-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -125,7 +125,7 @@ func (s *Fcv3ServiceV2) Fcv3CustomDomainStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv3CustomDomain(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -167,7 +167,7 @@ func (s *Fcv3ServiceV2) DescribeFcv3FunctionVersion(id string) (object map[strin
 		response, err = client.RoaGet("FC", "2023-03-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -190,7 +190,7 @@ func (s *Fcv3ServiceV2) Fcv3FunctionVersionStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv3FunctionVersion(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -232,7 +232,7 @@ func (s *Fcv3ServiceV2) DescribeFcv3Alias(id string) (object map[string]interfac
 		response, err = client.RoaGet("FC", "2023-03-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -255,7 +255,7 @@ func (s *Fcv3ServiceV2) Fcv3AliasStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv3Alias(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -293,7 +293,7 @@ func (s *Fcv3ServiceV2) DescribeFcv3AsyncInvokeConfig(id string) (object map[str
 		response, err = client.RoaGet("FC", "2023-03-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -316,7 +316,7 @@ func (s *Fcv3ServiceV2) Fcv3AsyncInvokeConfigStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv3AsyncInvokeConfig(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -354,7 +354,7 @@ func (s *Fcv3ServiceV2) DescribeFcv3ConcurrencyConfig(id string) (object map[str
 		response, err = client.RoaGet("FC", "2023-03-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -377,7 +377,7 @@ func (s *Fcv3ServiceV2) Fcv3ConcurrencyConfigStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv3ConcurrencyConfig(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -420,7 +420,7 @@ func (s *Fcv3ServiceV2) DescribeFcv3Trigger(id string) (object map[string]interf
 		response, err = client.RoaGet("FC", "2023-03-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -443,7 +443,7 @@ func (s *Fcv3ServiceV2) Fcv3TriggerStateRefreshFunc(id string, field string, fai
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv3Trigger(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -488,7 +488,7 @@ func (s *Fcv3ServiceV2) DescribeFcv3ProvisionConfig(id string) (object map[strin
 		response, err = client.RoaGet("FC", "2023-03-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -513,7 +513,7 @@ func (s *Fcv3ServiceV2) Fcv3ProvisionConfigStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv3ProvisionConfig(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -555,7 +555,7 @@ func (s *Fcv3ServiceV2) DescribeFcv3LayerVersion(id string) (object map[string]i
 		response, err = client.RoaGet("FC", "2023-03-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -578,7 +578,7 @@ func (s *Fcv3ServiceV2) Fcv3LayerVersionStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv3LayerVersion(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -618,7 +618,7 @@ func (s *Fcv3ServiceV2) DescribeFcv3VpcBinding(id string) (object map[string]int
 		response, err = client.RoaGet("FC", "2023-03-30", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("FC", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -652,7 +652,7 @@ func (s *Fcv3ServiceV2) Fcv3VpcBindingStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeFcv3VpcBinding(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -673,7 +673,7 @@ func (s *Fcv3ServiceV2) Fcv3VpcBindingStateRefreshFunc(id string, field string,
 // DescribeFcv3VpcBinding >>> Encapsulated.
 // SetResourceTags <<< Encapsulated tag function for Fcv3.
 func (s *Fcv3ServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -705,7 +705,7 @@ func (s *Fcv3ServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RoaDelete("FC", "2023-03-30", action, query, nil, body, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("FC", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -743,7 +743,7 @@ func (s *Fcv3ServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RoaPost("FC", "2023-03-30", action, query, nil, body, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("FC", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
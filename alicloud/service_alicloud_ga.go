@@ -1694,7 +1694,7 @@ func (s *GaService) SetResourceTags(d *schema.ResourceData, resourceType string)
 	client := s.client
 	resourceIdNum := strings.Count(d.Id(), ":")
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		var response map[string]interface{}
 		var err error
@@ -32,7 +32,7 @@ func (s *GovernanceServiceV2) DescribeGovernanceBaseline(id string) (object map[
 		response, err = client.RpcPost("governance", "2021-01-20", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("governance", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -56,7 +56,7 @@ func (s *GovernanceServiceV2) GovernanceBaselineStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGovernanceBaseline(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -94,7 +94,7 @@ func (s *GovernanceServiceV2) DescribeGovernanceAccount(id string) (object map[s
 		response, err = client.RpcPost("governance", "2021-01-20", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("governance", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -117,7 +117,7 @@ func (s *GovernanceServiceV2) GovernanceAccountStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGovernanceAccount(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -293,7 +293,7 @@ func (s *GpdbService) WaitForGpdbConnection(id string, status Status, timeout in
 }
 
 func (s *GpdbService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := diffGpdbTags(gpdbTagsFromMap(o), gpdbTagsFromMap(n))
@@ -347,6 +347,9 @@ func (s *GpdbService) tagsToMap(tags []gpdb.Tag) map[string]string {
 }
 
 func (s *GpdbService) ignoreTag(t gpdb.Tag) bool {
+	if providerTagIgnored(s.client, t.Key) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
@@ -476,7 +479,7 @@ func (s *GpdbService) ListTagResources(id string, resourceType string) (object i
 
 func (s *GpdbService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
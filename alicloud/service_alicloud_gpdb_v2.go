@@ -37,7 +37,7 @@ func (s *GpdbServiceV2) DescribeGpdbLogBackup(id string) (object map[string]inte
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -81,7 +81,7 @@ func (s *GpdbServiceV2) GpdbLogBackupStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbLogBackup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -117,7 +117,7 @@ func (s *GpdbServiceV2) DescribeGpdbBackupPolicy(id string) (object map[string]i
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -141,7 +141,7 @@ func (s *GpdbServiceV2) GpdbBackupPolicyStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbBackupPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -182,7 +182,7 @@ func (s *GpdbServiceV2) DescribeGpdbDbResourceGroup(id string) (object map[strin
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -212,7 +212,7 @@ func (s *GpdbServiceV2) GpdbDbResourceGroupStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbDbResourceGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -258,7 +258,7 @@ func (s *GpdbServiceV2) DescribeGpdbRemoteADBDataSource(id string) (object map[s
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -293,7 +293,7 @@ func (s *GpdbServiceV2) GpdbRemoteADBDataSourceStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbRemoteADBDataSource(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -335,7 +335,7 @@ func (s *GpdbServiceV2) DescribeGpdbExternalDataService(id string) (object map[s
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -359,7 +359,7 @@ func (s *GpdbServiceV2) GpdbExternalDataServiceStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbExternalDataService(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -401,7 +401,7 @@ func (s *GpdbServiceV2) DescribeGpdbStreamingDataService(id string) (object map[
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -425,7 +425,7 @@ func (s *GpdbServiceV2) GpdbStreamingDataServiceStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbStreamingDataService(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -467,7 +467,7 @@ func (s *GpdbServiceV2) DescribeGpdbStreamingDataSource(id string) (object map[s
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -491,7 +491,7 @@ func (s *GpdbServiceV2) GpdbStreamingDataSourceStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbStreamingDataSource(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -532,7 +532,7 @@ func (s *GpdbServiceV2) DescribeGpdbAccount(id string) (object map[string]interf
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -556,7 +556,7 @@ func (s *GpdbServiceV2) GpdbAccountStateRefreshFunc(id string, field string, fai
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbAccount(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -597,7 +597,7 @@ func (s *GpdbServiceV2) DescribeGpdbJdbcDataSource(id string) (object map[string
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -618,7 +618,7 @@ func (s *GpdbServiceV2) GpdbJdbcDataSourceStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbJdbcDataSource(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -660,7 +660,7 @@ func (s *GpdbServiceV2) DescribeGpdbHadoopDataSource(id string) (object map[stri
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -681,7 +681,7 @@ func (s *GpdbServiceV2) GpdbHadoopDataSourceStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbHadoopDataSource(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -724,7 +724,7 @@ func (s *GpdbServiceV2) DescribeGpdbStreamingJob(id string) (object map[string]i
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -748,7 +748,7 @@ func (s *GpdbServiceV2) GpdbStreamingJobStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbStreamingJob(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -789,7 +789,7 @@ func (s *GpdbServiceV2) DescribeGpdbDBInstanceIPArray(id string) (object map[str
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -819,7 +819,7 @@ func (s *GpdbServiceV2) GpdbDBInstanceIPArrayStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbDBInstanceIPArray(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -866,7 +866,7 @@ func (s *GpdbServiceV2) DescribeGpdbDatabase(id string) (object map[string]inter
 		response, err = client.RpcPost("gpdb", "2016-05-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("gpdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -889,7 +889,7 @@ func (s *GpdbServiceV2) GpdbDatabaseStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGpdbDatabase(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -32,7 +32,7 @@ func (s *GwlbServiceV2) DescribeGwlbLoadBalancer(id string) (object map[string]i
 		response, err = client.RpcPost("Gwlb", "2024-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Gwlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -55,7 +55,7 @@ func (s *GwlbServiceV2) GwlbLoadBalancerStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGwlbLoadBalancer(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -77,7 +77,7 @@ func (s *GwlbServiceV2) GwlbLoadBalancerStateRefreshFunc(id string, field string
 
 // SetResourceTags <<< Encapsulated tag function for Gwlb.
 func (s *GwlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -111,7 +111,7 @@ func (s *GwlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Gwlb", "2024-04-15", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Gwlb", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -148,7 +148,7 @@ func (s *GwlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Gwlb", "2024-04-15", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Gwlb", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -187,7 +187,7 @@ func (s *GwlbServiceV2) DescribeGwlbListener(id string) (object map[string]inter
 		response, err = client.RpcPost("Gwlb", "2024-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Gwlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -210,7 +210,7 @@ func (s *GwlbServiceV2) GwlbListenerStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGwlbListener(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -248,7 +248,7 @@ func (s *GwlbServiceV2) DescribeGwlbServerGroup(id string) (object map[string]in
 		response, err = client.RpcPost("Gwlb", "2024-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Gwlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -293,7 +293,7 @@ func (s *GwlbServiceV2) DescribeListServerGroupServers(id string) (object map[st
 		response, err = client.RpcPost("Gwlb", "2024-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Gwlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -313,7 +313,7 @@ func (s *GwlbServiceV2) GwlbServerGroupStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeGwlbServerGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -348,7 +348,7 @@ func (s *GwlbServiceV2) DescribeAsyncServerGroupListServerGroupServers(d *schema
 		response, err = client.RpcPost("Gwlb", "2024-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Gwlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -368,7 +368,7 @@ func (s *GwlbServiceV2) DescribeAsyncGwlbServerGroupStateRefreshFunc(d *schema.R
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncServerGroupListServerGroupServers(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
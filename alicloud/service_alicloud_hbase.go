@@ -35,7 +35,7 @@ type HBaseService struct {
 }
 
 func (s *HBaseService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 
@@ -120,6 +120,9 @@ func (s *HBaseService) tagsToMap(tags []hbase.Tag) map[string]string {
 }
 
 func (s *HBaseService) ignoreTag(t hbase.Tag) bool {
+	if providerTagIgnored(s.client, t.Key) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
@@ -33,7 +33,7 @@ func (s *HbrServiceV2) DescribeHbrPolicy(id string) (object map[string]interface
 		response, err = client.RpcPost("hbr", "2017-09-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("hbr", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -62,7 +62,7 @@ func (s *HbrServiceV2) HbrPolicyStateRefreshFunc(id string, field string, failSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeHbrPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -112,7 +112,7 @@ func (s *HbrServiceV2) DescribeHbrPolicyBinding(id string) (object map[string]in
 			response, err = client.RpcPost("hbr", "2017-09-08", action, query, request, true)
 
 			if err != nil {
-				if NeedRetry(err) {
+				if IsRetryableError("hbr", err) {
 					wait()
 					return resource.RetryableError(err)
 				}
@@ -163,7 +163,7 @@ func (s *HbrServiceV2) HbrPolicyBindingStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeHbrPolicyBinding(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -202,7 +202,7 @@ func (s *HbrServiceV2) DescribeHbrCrossAccount(id string) (object map[string]int
 		response, err = client.RpcPost("hbr", "2017-09-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("hbr", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -242,7 +242,7 @@ func (s *HbrServiceV2) HbrCrossAccountStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeHbrCrossAccount(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -287,7 +287,7 @@ func (s *HbrServiceV2) DescribeHbrVault(id string) (object map[string]interface{
 		response, err = client.RpcPost("hbr", "2017-09-08", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("hbr", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -319,7 +319,7 @@ func (s *HbrServiceV2) HbrVaultStateRefreshFunc(id string, field string, failSta
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeHbrVault(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -348,7 +348,7 @@ func (s *HbrServiceV2) HbrVaultStateRefreshFunc(id string, field string, failSta
 
 // SetResourceTags <<< Encapsulated tag function for Hbr.
 func (s *HbrServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -378,7 +378,7 @@ func (s *HbrServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("hbr", "2017-09-08", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("hbr", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -411,7 +411,7 @@ func (s *HbrServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("hbr", "2017-09-08", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("hbr", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -230,7 +230,7 @@ func (s *HitsdbService) ListTagResources(id string, resourceType string) (object
 
 func (s *HitsdbService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -35,7 +35,7 @@ func (s *HologramServiceV2) DescribeHologramInstance(id string) (object map[stri
 		response, err = client.RoaGet("Hologram", "2022-06-01", action, query, nil, body)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Hologram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -64,7 +64,7 @@ func (s *HologramServiceV2) HologramInstanceStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeHologramInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -85,7 +85,7 @@ func (s *HologramServiceV2) HologramInstanceStateRefreshFunc(id string, field st
 
 // SetResourceTags <<< Encapsulated tag function for Hologram.
 func (s *HologramServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -115,7 +115,7 @@ func (s *HologramServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 				response, err = client.RoaPost("Hologram", "2022-06-01", action, query, nil, body, true)
 
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Hologram", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -153,7 +153,7 @@ func (s *HologramServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 				response, err = client.RoaPost("Hologram", "2022-06-01", action, query, nil, body, true)
 
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Hologram", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -34,7 +34,7 @@ func (s *KmsServiceV2) DescribeKmsInstance(id string) (object map[string]interfa
 		response, err = client.RpcPost("Kms", "2016-01-20", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Kms", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -64,7 +64,7 @@ func (s *KmsServiceV2) KmsInstanceStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeKmsInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -109,7 +109,7 @@ func (s *KmsServiceV2) DescribeKmsNetworkRule(id string) (object map[string]inte
 		response, err = client.RpcPost("Kms", "2016-01-20", action, query, request, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Kms", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -133,7 +133,7 @@ func (s *KmsServiceV2) KmsNetworkRuleStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeKmsNetworkRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -169,7 +169,7 @@ func (s *KmsServiceV2) DescribeKmsPolicy(id string) (object map[string]interface
 		response, err = client.RpcPost("Kms", "2016-01-20", action, query, request, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Kms", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -193,7 +193,7 @@ func (s *KmsServiceV2) KmsPolicyStateRefreshFunc(id string, field string, failSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeKmsPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -229,7 +229,7 @@ func (s *KmsServiceV2) DescribeKmsClientKey(id string) (object map[string]interf
 		response, err = client.RpcGet("Kms", "2016-01-20", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Kms", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -253,7 +253,7 @@ func (s *KmsServiceV2) KmsClientKeyStateRefreshFunc(id string, field string, fai
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeKmsClientKey(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -289,7 +289,7 @@ func (s *KmsServiceV2) DescribeKmsApplicationAccessPoint(id string) (object map[
 		response, err = client.RpcPost("Kms", "2016-01-20", action, query, request, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Kms", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -313,7 +313,7 @@ func (s *KmsServiceV2) KmsApplicationAccessPointStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeKmsApplicationAccessPoint(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -347,7 +347,7 @@ func (s *KmsServiceV2) DescribeKmsKey(id string) (object map[string]interface{},
 		response, err = client.RpcPost("Kms", "2016-01-20", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Kms", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -382,7 +382,7 @@ func (s *KmsServiceV2) KmsKeyStateRefreshFunc(id string, field string, failState
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeKmsKey(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -420,7 +420,7 @@ func (s *KmsServiceV2) DescribeKmsKeyPolicy(id string) (object map[string]interf
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPost("Kms", "2016-01-20", action, nil, request, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Kms", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -479,7 +479,7 @@ func (s *KmsServiceV2) ListTagResources(id string, resourceType string) (object
 		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 			response, err = client.RpcPost("Kms", "2016-01-20", action, nil, request, true)
 			if err != nil {
-				if NeedRetry(err) {
+				if IsRetryableError("Kms", err) {
 					wait()
 					return resource.RetryableError(err)
 				}
@@ -516,7 +516,7 @@ func (s *KmsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 
 	resourceIdNum := strings.Count(d.Id(), ":")
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 		removedTagKeys := make([]string, 0)
@@ -551,7 +551,7 @@ func (s *KmsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
 				response, err := client.RpcPost("Kms", "2016-01-20", action, nil, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Kms", err) {
 						wait()
 						return resource.RetryableError(err)
 
@@ -594,7 +594,7 @@ func (s *KmsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
 				response, err := client.RpcPost("Kms", "2016-01-20", action, nil, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Kms", err) {
 						wait()
 						return resource.RetryableError(err)
 
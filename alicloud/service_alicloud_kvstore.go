@@ -179,8 +179,8 @@ func (s *KvstoreService) ModifyInstanceConfig(id string, config string) error {
 }
 
 func (s *KvstoreService) setInstanceTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -248,6 +248,9 @@ func (s *KvstoreService) tagsFromMap(m map[string]interface{}) []r_kvstore.TagRe
 }
 
 func (s *KvstoreService) ignoreTag(t r_kvstore.TagResource) bool {
+	if providerTagIgnored(s.client, t.TagKey) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
@@ -331,7 +334,7 @@ func (s *KvstoreService) DescribeKVstoreAccount(id string) (*r_kvstore.Account,
 	request.RegionId = s.client.RegionId
 	request.InstanceId = parts[0]
 	request.AccountName = parts[1]
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	invoker.AddCatcher(KVstoreInstanceStatusCatcher)
 	var response *r_kvstore.DescribeAccountsResponse
 	if err := invoker.Run(func() error {
@@ -34,7 +34,7 @@ func (s *LindormServiceV2) DescribeLindormPublicNetwork(id string) (object map[s
 		response, err = client.RpcPost("hitsdb", "2020-06-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("hitsdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -68,7 +68,7 @@ func (s *LindormServiceV2) DescribePublicNetworkGetLindormInstanceEngineList(id
 		response, err = client.RpcPost("hitsdb", "2020-06-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("hitsdb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -91,7 +91,7 @@ func (s *LindormServiceV2) LindormPublicNetworkStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeLindormPublicNetwork(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
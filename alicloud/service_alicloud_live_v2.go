@@ -33,7 +33,7 @@ func (s *LiveServiceV2) DescribeLiveCaster(id string) (object map[string]interfa
 		response, err = client.RpcPost("live", "2016-11-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("live", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -65,7 +65,7 @@ func (s *LiveServiceV2) LiveCasterStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeLiveCaster(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -93,7 +93,7 @@ func (s *LiveServiceV2) LiveCasterStateRefreshFunc(id string, field string, fail
 // DescribeLiveCaster >>> Encapsulated.
 // SetResourceTags <<< Encapsulated tag function for Live.
 func (s *LiveServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -123,7 +123,7 @@ func (s *LiveServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("live", "2016-11-01", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("live", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -156,7 +156,7 @@ func (s *LiveServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("live", "2016-11-01", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("live", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -36,7 +36,7 @@ func (s *MaxComputeServiceV2) DescribeMaxComputeProject(id string) (object map[s
 		response, err = client.RoaGet("MaxCompute", "2022-01-04", action, query, nil, nil)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"500"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"500"}) || IsRetryableError("MaxCompute", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -76,7 +76,7 @@ func (s *MaxComputeServiceV2) DescribeProjectListTagResources(id string) (object
 		response, err = client.RoaGet("MaxCompute", "2022-01-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("MaxCompute", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -96,7 +96,7 @@ func (s *MaxComputeServiceV2) MaxComputeProjectStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMaxComputeProject(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -125,7 +125,7 @@ func (s *MaxComputeServiceV2) MaxComputeProjectStateRefreshFunc(id string, field
 
 // SetResourceTags <<< Encapsulated tag function for MaxCompute.
 func (s *MaxComputeServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -155,7 +155,7 @@ func (s *MaxComputeServiceV2) SetResourceTags(d *schema.ResourceData, resourceTy
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RoaDelete("MaxCompute", "2022-01-04", action, query, nil, nil, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("MaxCompute", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -198,7 +198,7 @@ func (s *MaxComputeServiceV2) SetResourceTags(d *schema.ResourceData, resourceTy
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RoaPost("MaxCompute", "2022-01-04", action, query, nil, body, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("MaxCompute", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -241,7 +241,7 @@ func (s *MaxComputeServiceV2) DescribeMaxComputeQuotaPlan(id string) (object map
 		response, err = client.RoaGet("MaxCompute", "2022-01-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("MaxCompute", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -269,7 +269,7 @@ func (s *MaxComputeServiceV2) MaxComputeQuotaPlanStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMaxComputeQuotaPlan(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -319,7 +319,7 @@ func (s *MaxComputeServiceV2) DescribeMaxComputeRole(id string) (object map[stri
 		response, err = client.RoaGet("MaxCompute", "2022-01-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("MaxCompute", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -342,7 +342,7 @@ func (s *MaxComputeServiceV2) MaxComputeRoleStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMaxComputeRole(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -391,7 +391,7 @@ func (s *MaxComputeServiceV2) DescribeMaxComputeQuotaSchedule(id string) (object
 		response, err = client.RoaGet("MaxCompute", "2022-01-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("MaxCompute", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -411,7 +411,7 @@ func (s *MaxComputeServiceV2) MaxComputeQuotaScheduleStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMaxComputeQuotaSchedule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -460,7 +460,7 @@ func (s *MaxComputeServiceV2) DescribeMaxComputeRoleUserAttachment(id string) (o
 		response, err = client.RoaGet("MaxCompute", "2022-01-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("MaxCompute", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -497,7 +497,7 @@ func (s *MaxComputeServiceV2) MaxComputeRoleUserAttachmentStateRefreshFunc(id st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMaxComputeRoleUserAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -542,7 +542,7 @@ func (s *MaxComputeServiceV2) DescribeMaxComputeTunnelQuotaTimer(id string) (obj
 		response, err = client.RoaGet("MaxCompute", "2022-01-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("MaxCompute", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -562,7 +562,7 @@ func (s *MaxComputeServiceV2) MaxComputeTunnelQuotaTimerStateRefreshFunc(id stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMaxComputeTunnelQuotaTimer(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -608,7 +608,7 @@ func (s *MaxComputeServiceV2) DescribeMaxComputeQuota(id string) (object map[str
 		response, err = client.RoaGet("MaxCompute", "2022-01-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("MaxCompute", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -643,7 +643,7 @@ func (s *MaxComputeServiceV2) DescribeQuotaListTagResources(id string) (object m
 		response, err = client.RoaGet("MaxCompute", "2022-01-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("MaxCompute", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -663,7 +663,7 @@ func (s *MaxComputeServiceV2) MaxComputeQuotaStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMaxComputeQuota(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
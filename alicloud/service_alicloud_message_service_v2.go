@@ -35,7 +35,7 @@ func (s *MessageServiceServiceV2) DescribeMessageServiceQueue(id string) (object
 		response, err = client.RpcPost("Mns-open", "2022-01-19", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Mns-open", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -78,7 +78,7 @@ func (s *MessageServiceServiceV2) DescribeMessageServiceTopic(id string) (object
 		response, err = client.RpcPost("Mns-open", "2022-01-19", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Mns-open", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -106,7 +106,7 @@ func (s *MessageServiceServiceV2) DescribeMessageServiceTopic(id string) (object
 
 // SetResourceTags <<< Encapsulated tag function for MessageService.
 func (s *MessageServiceServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -142,7 +142,7 @@ func (s *MessageServiceServiceV2) SetResourceTags(d *schema.ResourceData, resour
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Mns-open", "2022-01-19", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Mns-open", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -181,7 +181,7 @@ func (s *MessageServiceServiceV2) SetResourceTags(d *schema.ResourceData, resour
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Mns-open", "2022-01-19", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Mns-open", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -220,7 +220,7 @@ func (s *MessageServiceServiceV2) DescribeMessageServiceEndpoint(id string) (obj
 		response, err = client.RpcPost("Mns-open", "2022-01-19", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Mns-open", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -245,7 +245,7 @@ func (s *MessageServiceServiceV2) MessageServiceEndpointStateRefreshFunc(id stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMessageServiceEndpoint(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -296,7 +296,7 @@ func (s *MessageServiceServiceV2) DescribeMessageServiceEndpointAcl(id string) (
 		response, err = client.RpcPost("Mns-open", "2022-01-19", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Mns-open", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -337,7 +337,7 @@ func (s *MessageServiceServiceV2) MessageServiceEndpointAclStateRefreshFunc(id s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMessageServiceEndpointAcl(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -387,7 +387,7 @@ func (s *MessageServiceServiceV2) DescribeMessageServiceSubscription(id string)
 		response, err = client.RpcPost("Mns-open", "2022-01-19", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Mns-open", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -415,7 +415,7 @@ func (s *MessageServiceServiceV2) MessageServiceSubscriptionStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMessageServiceSubscription(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -463,7 +463,7 @@ func (s *MessageServiceServiceV2) DescribeMessageServiceService(id string) (obje
 		response, err = client.RpcPostWithEndpoint("BssOpenApi", "2017-12-14", action, query, request, true, endpoint)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("BssOpenApi", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -781,7 +781,7 @@ func (s *MongoDBService) ResetAccountPassword(d *schema.ResourceData, password s
 }
 
 func (s *MongoDBService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 
@@ -836,6 +836,9 @@ func (s *MongoDBService) tagsToMap(tags []dds.Tag) map[string]string {
 }
 
 func (s *MongoDBService) ignoreTag(t dds.Tag) bool {
+	if providerTagIgnored(s.client, t.Key) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
@@ -859,6 +862,9 @@ func (s *MongoDBService) tagsInAttributeToMap(tags []dds.Tag) map[string]string
 }
 
 func (s *MongoDBService) ignoreTagInAttribute(t dds.Tag) bool {
+	if providerTagIgnored(s.client, t.Key) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
@@ -1091,7 +1097,7 @@ func (s *MongoDBService) MongodbServerlessInstanceStateRefreshFunc(id string, fa
 }
 
 func (s *MongoDBService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 		removedTagKeys := make([]string, 0)
@@ -32,7 +32,7 @@ func (s *MongodbServiceV2) DescribeMongodbPrivateSrvNetworkAddress(id string) (o
 		response, err = client.RpcPost("Dds", "2015-12-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Dds", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -57,7 +57,7 @@ func (s *MongodbServiceV2) MongodbPrivateSrvNetworkAddressStateRefreshFunc(id st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMongodbPrivateSrvNetworkAddress(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -107,7 +107,7 @@ func (s *MongodbServiceV2) DescribeMongodbAccount(id string) (object map[string]
 		response, err = client.RpcPost("Dds", "2015-12-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Dds", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -136,7 +136,7 @@ func (s *MongodbServiceV2) MongodbAccountStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeMongodbAccount(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
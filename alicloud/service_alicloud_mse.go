@@ -75,7 +75,7 @@ func (s *MseService) GetInstanceIdBYClusterId(clusterId string) (object map[stri
 }
 
 func (s *MseService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -38,7 +38,7 @@ func (s *NasServiceV2) DescribeNasAccessRule(id string) (object map[string]inter
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -72,7 +72,7 @@ func (s *NasServiceV2) NasAccessRuleStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNasAccessRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -114,7 +114,7 @@ func (s *NasServiceV2) DescribeNasAccessGroup(id string) (object map[string]inte
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -148,7 +148,7 @@ func (s *NasServiceV2) NasAccessGroupStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNasAccessGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -186,7 +186,7 @@ func (s *NasServiceV2) DescribeNasFileSystem(id string) (object map[string]inter
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"InvalidFileSystemStatus.Ordering"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"InvalidFileSystemStatus.Ordering"}) || IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -229,7 +229,7 @@ func (s *NasServiceV2) DescribeFileSystemDescribeSmbAcl(id string) (object map[s
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -265,7 +265,7 @@ func (s *NasServiceV2) DescribeFileSystemGetRecycleBinAttribute(id string) (obje
 		response, err = client.RpcGet("NAS", "2017-06-26", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -305,7 +305,7 @@ func (s *NasServiceV2) DescribeFileSystemListTagResources(id string) (object map
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -339,7 +339,7 @@ func (s *NasServiceV2) DescribeFileSystemDescribeNfsAcl(id string) (object map[s
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -364,7 +364,7 @@ func (s *NasServiceV2) NasFileSystemStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNasFileSystem(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -393,7 +393,7 @@ func (s *NasServiceV2) DescribeAsyncNasFileSystemStateRefreshFunc(d *schema.Reso
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncDescribeFileSystems(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -424,7 +424,7 @@ func (s *NasServiceV2) DescribeAsyncNasFileSystemStateRefreshFunc(d *schema.Reso
 
 // SetResourceTags <<< Encapsulated tag function for Nas.
 func (s *NasServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -454,7 +454,7 @@ func (s *NasServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("NAS", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -487,7 +487,7 @@ func (s *NasServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("NAS", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -523,7 +523,7 @@ func (s *NasServiceV2) DescribeNasAutoSnapshotPolicy(id string) (object map[stri
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, nil, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -556,7 +556,7 @@ func (s *NasServiceV2) NasAutoSnapshotPolicyStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNasAutoSnapshotPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -608,7 +608,7 @@ func (s *NasServiceV2) DescribeNasAccessPoint(id string) (object map[string]inte
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -637,7 +637,7 @@ func (s *NasServiceV2) NasAccessPointStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNasAccessPoint(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -680,7 +680,7 @@ func (s *NasServiceV2) DescribeNasMountTarget(id string) (object map[string]inte
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -712,7 +712,7 @@ func (s *NasServiceV2) NasMountTargetStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNasMountTarget(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -742,7 +742,7 @@ func (s *NasServiceV2) DescribeAsyncNasMountTargetStateRefreshFunc(d *schema.Res
 		object, err := s.DescribeAsyncDescribeMountTargets(d, res)
 
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -793,7 +793,7 @@ func (s *NasServiceV2) DescribeAsyncDescribeMountTargets(d *schema.ResourceData,
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -829,7 +829,7 @@ func (s *NasServiceV2) DescribeAsyncDescribeFileSystems(d *schema.ResourceData,
 		response, err = client.RpcPost("NAS", "2017-06-26", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("NAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -36,7 +36,7 @@ func (s *NATGatewayServiceV2) DescribeNATGatewaySnatEntry(id string) (object map
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -68,7 +68,7 @@ func (s *NATGatewayServiceV2) NATGatewaySnatEntryStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNATGatewaySnatEntry(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
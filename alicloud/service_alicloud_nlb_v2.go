@@ -40,7 +40,7 @@ func (s *NlbServiceV2) DescribeNlbLoadbalancerCommonBandwidthPackageAttachment(i
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Nlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -66,7 +66,7 @@ func (s *NlbServiceV2) NlbLoadbalancerCommonBandwidthPackageAttachmentStateRefre
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNlbLoadbalancerCommonBandwidthPackageAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -108,7 +108,7 @@ func (s *NlbServiceV2) DescribeNlbListenerAdditionalCertificateAttachment(id str
 		response, err = client.RpcPost("Nlb", "2022-04-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Nlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -145,7 +145,7 @@ func (s *NlbServiceV2) NlbListenerAdditionalCertificateAttachmentStateRefreshFun
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNlbListenerAdditionalCertificateAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -174,7 +174,7 @@ func (s *NlbServiceV2) DescribeAsyncNlbListenerAdditionalCertificateAttachmentSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncGetJobStatus(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -226,7 +226,7 @@ func (s *NlbServiceV2) DescribeNlbLoadBalancerSecurityGroupAttachment(id string)
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Nlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -254,7 +254,7 @@ func (s *NlbServiceV2) NlbLoadBalancerSecurityGroupAttachmentStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNlbLoadBalancerSecurityGroupAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -276,7 +276,7 @@ func (s *NlbServiceV2) DescribeAsyncNlbLoadBalancerSecurityGroupAttachmentStateR
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncGetJobStatus(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -313,7 +313,7 @@ func (s *NlbServiceV2) DescribeNlbSecurityPolicy(id string) (object map[string]i
 		response, err = client.RpcPost("Nlb", "2022-04-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Nlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -343,7 +343,7 @@ func (s *NlbServiceV2) NlbSecurityPolicyStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNlbSecurityPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -375,7 +375,7 @@ func (s *NlbServiceV2) NlbSecurityPolicyStateRefreshFunc(id string, field string
 
 // SetResourceTags <<< Encapsulated tag function for Nlb.
 func (s *NlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -406,7 +406,7 @@ func (s *NlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Nlb", "2022-04-30", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Nlb", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -440,7 +440,7 @@ func (s *NlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Nlb", "2022-04-30", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Nlb", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -479,7 +479,7 @@ func (s *NlbServiceV2) DescribeNlbServerGroup(id string) (object map[string]inte
 		response, err = client.RpcPost("Nlb", "2022-04-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Nlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -508,7 +508,7 @@ func (s *NlbServiceV2) NlbServerGroupStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNlbServerGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -537,7 +537,7 @@ func (s *NlbServiceV2) DescribeAsyncNlbServerGroupStateRefreshFunc(d *schema.Res
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncGetJobStatus(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -593,7 +593,7 @@ func (s *NlbServiceV2) DescribeNlbServerGroupServerAttachment(id string) (object
 		response, err = client.RpcPost("Nlb", "2022-04-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Nlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -633,7 +633,7 @@ func (s *NlbServiceV2) NlbServerGroupServerAttachmentStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNlbServerGroupServerAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -680,7 +680,7 @@ func (s *NlbServiceV2) DescribeNlbListener(id string) (object map[string]interfa
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Nlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -703,7 +703,7 @@ func (s *NlbServiceV2) NlbListenerStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNlbListener(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -732,7 +732,7 @@ func (s *NlbServiceV2) DescribeAsyncNlbListenerStateRefreshFunc(d *schema.Resour
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncGetJobStatus(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -781,7 +781,7 @@ func (s *NlbServiceV2) DescribeNlbLoadBalancer(id string) (object map[string]int
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Nlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -804,7 +804,7 @@ func (s *NlbServiceV2) NlbLoadBalancerStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNlbLoadBalancer(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -833,7 +833,7 @@ func (s *NlbServiceV2) DescribeAsyncNlbLoadBalancerStateRefreshFunc(d *schema.Re
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncGetJobStatus(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -882,7 +882,7 @@ func (s *NlbServiceV2) DescribeAsyncGetJobStatus(d *schema.ResourceData, res map
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Nlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -923,7 +923,7 @@ func (s *NlbServiceV2) DescribeNlbLoadBalancerZoneShiftedAttachment(id string) (
 		response, err = client.RpcPost("Nlb", "2022-04-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Nlb", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -960,7 +960,7 @@ func (s *NlbServiceV2) NlbLoadBalancerZoneShiftedAttachmentStateRefreshFunc(id s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNlbLoadBalancerZoneShiftedAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -30,7 +30,7 @@ func (s *OceanBaseServiceV2) DescribeOceanBaseInstance(id string) (object map[st
 		response, err = client.RpcPost("OceanBasePro", "2019-09-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("OceanBasePro", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -69,7 +69,7 @@ func (s *OceanBaseServiceV2) DescribeDescribeInstances(id string) (object map[st
 		response, err = client.RpcPost("OceanBasePro", "2019-09-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("OceanBasePro", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -99,7 +99,7 @@ func (s *OceanBaseServiceV2) OceanBaseInstanceStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOceanBaseInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
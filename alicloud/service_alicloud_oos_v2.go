@@ -33,7 +33,7 @@ func (s *OosServiceV2) DescribeOosPatchBaseline(id string) (object map[string]in
 		response, err = client.RpcPost("oos", "2019-06-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("oos", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -63,7 +63,7 @@ func (s *OosServiceV2) OosPatchBaselineStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOosPatchBaseline(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -84,7 +84,7 @@ func (s *OosServiceV2) OosPatchBaselineStateRefreshFunc(id string, field string,
 // DescribeOosPatchBaseline >>> Encapsulated.
 // SetResourceTags <<< Encapsulated tag function for Oos.
 func (s *OosServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -115,7 +115,7 @@ func (s *OosServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 				response, err = client.RpcPost("oos", "2019-06-01", action, query, request, false)
 
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("oos", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -144,7 +144,7 @@ func (s *OosServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 				response, err = client.RpcPost("oos", "2019-06-01", action, query, request, false)
 
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("oos", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -183,7 +183,7 @@ func (s *OosServiceV2) DescribeOosSecretParameter(id string) (object map[string]
 		response, err = client.RpcPost("oos", "2019-06-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("oos", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -223,7 +223,7 @@ func (s *OosServiceV2) DescribeSecretParameterListTagResources(id string) (objec
 		response, err = client.RpcPost("oos", "2019-06-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("oos", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -246,7 +246,7 @@ func (s *OosServiceV2) OosSecretParameterStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOosSecretParameter(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -35,7 +35,7 @@ func (s *OssServiceV2) DescribeOssBucketAcl(id string) (object map[string]interf
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketAcl", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -67,7 +67,7 @@ func (s *OssServiceV2) OssBucketAclStateRefreshFunc(id string, field string, fai
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketAcl(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -106,7 +106,7 @@ func (s *OssServiceV2) DescribeOssBucketReferer(id string) (object map[string]in
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketReferer", action), query, nil, nil, hostMap, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -137,7 +137,7 @@ func (s *OssServiceV2) OssBucketRefererStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketReferer(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -183,7 +183,7 @@ func (s *OssServiceV2) DescribeOssBucketHttpsConfig(id string) (object map[strin
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketHttpsConfig", action), query, nil, nil, hostMap, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -214,7 +214,7 @@ func (s *OssServiceV2) OssBucketHttpsConfigStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketHttpsConfig(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -260,7 +260,7 @@ func (s *OssServiceV2) DescribeOssBucketCors(id string) (object map[string]inter
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketCors", action), query, nil, nil, hostMap, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -293,7 +293,7 @@ func (s *OssServiceV2) OssBucketCorsStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketCors(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -336,7 +336,7 @@ func (s *OssServiceV2) DescribeOssBucketPolicy(id string) (object map[string]int
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlJsonParam("GET", "2019-05-17", "GetBucketPolicy", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -360,7 +360,7 @@ func (s *OssServiceV2) OssBucketPolicyStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -397,7 +397,7 @@ func (s *OssServiceV2) DescribeOssBucketVersioning(id string) (object map[string
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketVersioning", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -431,7 +431,7 @@ func (s *OssServiceV2) OssBucketVersioningStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketVersioning(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -466,7 +466,7 @@ func (s *OssServiceV2) DescribeOssBucketArchiveDirectRead(id string) (object map
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketArchiveDirectRead", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -495,7 +495,7 @@ func (s *OssServiceV2) OssBucketArchiveDirectReadStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketArchiveDirectRead(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -531,7 +531,7 @@ func (s *OssServiceV2) DescribeOssBucketRequestPayment(id string) (object map[st
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketRequestPayment", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -560,7 +560,7 @@ func (s *OssServiceV2) OssBucketRequestPaymentStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketRequestPayment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -596,7 +596,7 @@ func (s *OssServiceV2) DescribeOssBucketTransferAcceleration(id string) (object
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketTransferAcceleration", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -628,7 +628,7 @@ func (s *OssServiceV2) OssBucketTransferAccelerationStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketTransferAcceleration(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -664,7 +664,7 @@ func (s *OssServiceV2) DescribeOssBucketAccessMonitor(id string) (object map[str
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketAccessMonitor", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -695,7 +695,7 @@ func (s *OssServiceV2) OssBucketAccessMonitorStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketAccessMonitor(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -731,7 +731,7 @@ func (s *OssServiceV2) DescribeOssBucketLogging(id string) (object map[string]in
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketLogging", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -764,7 +764,7 @@ func (s *OssServiceV2) OssBucketLoggingStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketLogging(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -809,7 +809,7 @@ func (s *OssServiceV2) DescribeOssBucketServerSideEncryption(id string) (object
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketEncryption", action), query, nil, nil, hostMap, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -840,7 +840,7 @@ func (s *OssServiceV2) OssBucketServerSideEncryptionStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketServerSideEncryption(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -882,7 +882,7 @@ func (s *OssServiceV2) DescribeOssBucketUserDefinedLogFields(id string) (object
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketUserDefinedLogFieldsConfig", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -909,7 +909,7 @@ func (s *OssServiceV2) OssBucketUserDefinedLogFieldsStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketUserDefinedLogFields(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -944,7 +944,7 @@ func (s *OssServiceV2) DescribeOssBucketMetaQuery(id string) (object map[string]
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketMetaQueryStatus", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -976,7 +976,7 @@ func (s *OssServiceV2) OssBucketMetaQueryStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketMetaQuery(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1017,7 +1017,7 @@ func (s *OssServiceV2) DescribeOssBucketDataRedundancyTransition(id string) (obj
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketDataRedundancyTransition", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1044,7 +1044,7 @@ func (s *OssServiceV2) OssBucketDataRedundancyTransitionStateRefreshFunc(id stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketDataRedundancyTransition(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1083,7 +1083,7 @@ func (s *OssServiceV2) DescribeOssAccountPublicAccessBlock(id string) (object ma
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetPublicAccessBlock", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1115,7 +1115,7 @@ func (s *OssServiceV2) OssAccountPublicAccessBlockStateRefreshFunc(id string, fi
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssAccountPublicAccessBlock(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1150,7 +1150,7 @@ func (s *OssServiceV2) DescribeOssBucketPublicAccessBlock(id string) (object map
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketPublicAccessBlock", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1179,7 +1179,7 @@ func (s *OssServiceV2) OssBucketPublicAccessBlockStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketPublicAccessBlock(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1218,7 +1218,7 @@ func (s *OssServiceV2) DescribeOssBucketCname(id string) (object map[string]inte
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "ListCname", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1260,7 +1260,7 @@ func (s *OssServiceV2) OssBucketCnameStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketCname(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1308,7 +1308,7 @@ func (s *OssServiceV2) DescribeOssBucketCnameToken(id string) (object map[string
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("POST", "2019-05-17", "CreateCnameToken", action), query, body, nil, hostMap, false)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1339,7 +1339,7 @@ func (s *OssServiceV2) OssBucketCnameTokenStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketCnameToken(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1375,7 +1375,7 @@ func (s *OssServiceV2) DescribeOssBucketWebsite(id string) (object map[string]in
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketWebsite", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1406,7 +1406,7 @@ func (s *OssServiceV2) OssBucketWebsiteStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketWebsite(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1453,7 +1453,7 @@ func (s *OssServiceV2) DescribeOssAccessPoint(id string) (object map[string]inte
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetAccessPoint", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1485,7 +1485,7 @@ func (s *OssServiceV2) OssAccessPointStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssAccessPoint(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1527,7 +1527,7 @@ func (s *OssServiceV2) DescribeOssBucketLifecycle(id string) (object map[string]
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketLifecycle", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1558,7 +1558,7 @@ func (s *OssServiceV2) OssBucketLifecycleStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketLifecycle(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1604,7 +1604,7 @@ func (s *OssServiceV2) DescribeOssBucketWorm(id string) (object map[string]inter
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketWorm", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1635,7 +1635,7 @@ func (s *OssServiceV2) OssBucketWormStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketWorm(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1686,7 +1686,7 @@ func (s *OssServiceV2) DescribeOssBucketStyle(id string) (object map[string]inte
 		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetStyle", action), query, nil, nil, hostMap, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Oss", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1714,7 +1714,7 @@ func (s *OssServiceV2) OssBucketStyleStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeOssBucketStyle(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1740,3 +1740,100 @@ func (s *OssServiceV2) OssBucketStyleStateRefreshFunc(id string, field string, f
 }
 
 // DescribeOssBucketStyle >>> Encapsulated.
+
+// DescribeOssBucketInventory <<< Encapsulated get interface for Oss BucketInventory.
+
+func (s *OssServiceV2) DescribeOssBucketInventory(id string) (object map[string]interface{}, err error) {
+	client := s.client
+	var request map[string]interface{}
+	var response map[string]interface{}
+	var query map[string]*string
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 {
+		err = WrapError(fmt.Errorf("invalid Resource Id %s. Expected parts' length %d, got %d", id, 2, len(parts)))
+		return object, err
+	}
+	action := fmt.Sprintf("/?inventory")
+	request = make(map[string]interface{})
+	query = make(map[string]*string)
+	hostMap := make(map[string]*string)
+	hostMap["bucket"] = StringPointer(parts[0])
+	query["inventoryId"] = StringPointer(parts[1])
+
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketInventory", action), query, nil, nil, hostMap, true)
+		if err != nil {
+			if IsRetryableError("Oss", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"NoSuchBucket", "NoSuchInventory"}) {
+			return object, WrapErrorf(NotFoundErr("BucketInventory", id), NotFoundMsg, response)
+		}
+		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
+	}
+	if response == nil {
+		return object, WrapErrorf(NotFoundErr("BucketInventory", id), NotFoundMsg, response)
+	}
+
+	v, err := jsonpath.Get("$.InventoryConfiguration", response)
+	if err != nil {
+		return object, WrapErrorf(err, FailedGetAttributeMsg, id, "$.InventoryConfiguration", response)
+	}
+
+	return v.(map[string]interface{}), nil
+}
+
+// DescribeOssBucketInventory >>> Encapsulated.
+
+// DescribeOssBucketObjectLockConfiguration <<< Encapsulated get interface for Oss BucketObjectLockConfiguration.
+
+func (s *OssServiceV2) DescribeOssBucketObjectLockConfiguration(id string) (object map[string]interface{}, err error) {
+	client := s.client
+	var request map[string]interface{}
+	var response map[string]interface{}
+	var query map[string]*string
+	action := fmt.Sprintf("/?objectWorm")
+	request = make(map[string]interface{})
+	query = make(map[string]*string)
+	hostMap := make(map[string]*string)
+	hostMap["bucket"] = StringPointer(id)
+
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		response, err = client.Do("Oss", xmlParam("GET", "2019-05-17", "GetBucketObjectWormConfiguration", action), query, nil, nil, hostMap, true)
+		if err != nil {
+			if IsRetryableError("Oss", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"NoSuchBucket", "NoSuchObjectWormConfiguration"}) {
+			return object, WrapErrorf(NotFoundErr("BucketObjectLockConfiguration", id), NotFoundMsg, response)
+		}
+		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
+	}
+
+	v, _ := jsonpath.Get("$.ObjectWormConfiguration", response)
+	object, ok := v.(map[string]interface{})
+	// The object lock of the bucket can not be disabled once it is enabled, and the bucket without it is regarded as not found.
+	if !ok || fmt.Sprint(object["ObjectWormEnabled"]) != "Enabled" {
+		return nil, WrapErrorf(NotFoundErr("BucketObjectLockConfiguration", id), NotFoundMsg, response)
+	}
+
+	return object, nil
+}
+
+// DescribeOssBucketObjectLockConfiguration >>> Encapsulated.
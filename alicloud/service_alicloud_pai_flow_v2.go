@@ -32,7 +32,7 @@ func (s *PaiFlowServiceV2) DescribePaiFlowPipeline(id string) (object map[string
 		response, err = client.RoaGet("PAIFlow", "2021-02-02", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("PAIFlow", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -55,7 +55,7 @@ func (s *PaiFlowServiceV2) PaiFlowPipelineStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiFlowPipeline(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -36,7 +36,7 @@ func (s *PaiServiceV2) DescribePaiService(id string) (object map[string]interfac
 		response, err = client.RoaGet("EAS", "2021-07-01", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("EAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -58,7 +58,7 @@ func (s *PaiServiceV2) PaiServiceStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiService(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -113,7 +113,7 @@ func (s *PaiServiceV2) DescribePaiTrainingJob(id string) (object map[string]inte
 		response, err = client.RoaGet("EAS", "2022-01-12", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("EAS", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -135,7 +135,7 @@ func (s *PaiServiceV2) PaiTrainingJobStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiTrainingJob(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -164,7 +164,7 @@ func (s *PaiServiceV2) PaiTrainingJobStateRefreshFunc(id string, field string, f
 
 // SetResourceTags <<< Encapsulated tag function for Pai.
 func (s *PaiServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -198,7 +198,7 @@ func (s *PaiServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 				response, err = client.RpcPost("EAS", "2021-07-02", action, query, request, false)
 
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("EAS", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -231,7 +231,7 @@ func (s *PaiServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 				response, err = client.RpcPost("EAS", "2021-07-02", action, query, request, false)
 
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("EAS", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -32,7 +32,7 @@ func (s *PaiWorkspaceServiceV2) DescribePaiWorkspaceWorkspace(id string) (object
 		response, err = client.RoaGet("AIWorkSpace", "2021-02-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("AIWorkSpace", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -54,7 +54,7 @@ func (s *PaiWorkspaceServiceV2) PaiWorkspaceWorkspaceStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiWorkspaceWorkspace(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -91,7 +91,7 @@ func (s *PaiWorkspaceServiceV2) DescribePaiWorkspaceDataset(id string) (object m
 		response, err = client.RoaGet("AIWorkSpace", "2021-02-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("AIWorkSpace", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -113,7 +113,7 @@ func (s *PaiWorkspaceServiceV2) PaiWorkspaceDatasetStateRefreshFunc(id string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiWorkspaceDataset(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -157,7 +157,7 @@ func (s *PaiWorkspaceServiceV2) DescribePaiWorkspaceExperiment(id string) (objec
 		response, err = client.RoaGet("AIWorkSpace", "2021-02-04", action, query, nil, nil)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"NotFoundErrorProblem"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"NotFoundErrorProblem"}) || IsRetryableError("AIWorkSpace", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -179,7 +179,7 @@ func (s *PaiWorkspaceServiceV2) PaiWorkspaceExperimentStateRefreshFunc(id string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiWorkspaceExperiment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -227,7 +227,7 @@ func (s *PaiWorkspaceServiceV2) DescribePaiWorkspaceDatasetversion(id string) (o
 		response, err = client.RoaGet("AIWorkSpace", "2021-02-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("AIWorkSpace", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -249,7 +249,7 @@ func (s *PaiWorkspaceServiceV2) PaiWorkspaceDatasetversionStateRefreshFunc(id st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiWorkspaceDatasetversion(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -293,7 +293,7 @@ func (s *PaiWorkspaceServiceV2) DescribePaiWorkspaceRun(id string) (object map[s
 		response, err = client.RoaGet("AIWorkSpace", "2021-02-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("AIWorkSpace", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -317,7 +317,7 @@ func (s *PaiWorkspaceServiceV2) PaiWorkspaceRunStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiWorkspaceRun(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -362,7 +362,7 @@ func (s *PaiWorkspaceServiceV2) DescribePaiWorkspaceCodeSource(id string) (objec
 		response, err = client.RoaGet("AIWorkSpace", "2021-02-04", action, query, nil, nil)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"201400004"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"201400004"}) || IsRetryableError("AIWorkSpace", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -384,7 +384,7 @@ func (s *PaiWorkspaceServiceV2) PaiWorkspaceCodeSourceStateRefreshFunc(id string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiWorkspaceCodeSource(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -434,7 +434,7 @@ func (s *PaiWorkspaceServiceV2) DescribePaiWorkspaceMember(id string) (object ma
 		response, err = client.RoaGet("AIWorkSpace", "2021-02-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("AIWorkSpace", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -457,7 +457,7 @@ func (s *PaiWorkspaceServiceV2) PaiWorkspaceMemberStateRefreshFunc(id string, fi
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiWorkspaceMember(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -503,7 +503,7 @@ func (s *PaiWorkspaceServiceV2) DescribePaiWorkspaceModel(id string) (object map
 		response, err = client.RoaGet("AIWorkSpace", "2021-02-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("AIWorkSpace", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -526,7 +526,7 @@ func (s *PaiWorkspaceServiceV2) PaiWorkspaceModelStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiWorkspaceModel(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -576,7 +576,7 @@ func (s *PaiWorkspaceServiceV2) DescribePaiWorkspaceModelVersion(id string) (obj
 		response, err = client.RoaGet("AIWorkSpace", "2021-02-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("AIWorkSpace", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -599,7 +599,7 @@ func (s *PaiWorkspaceServiceV2) PaiWorkspaceModelVersionStateRefreshFunc(id stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiWorkspaceModelVersion(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -649,7 +649,7 @@ func (s *PaiWorkspaceServiceV2) DescribePaiWorkspaceUserConfig(id string) (objec
 		response, err = client.RoaGet("AIWorkSpace", "2021-02-04", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("AIWorkSpace", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -678,7 +678,7 @@ func (s *PaiWorkspaceServiceV2) PaiWorkspaceUserConfigStateRefreshFunc(id string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePaiWorkspaceUserConfig(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
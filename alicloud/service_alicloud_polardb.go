@@ -327,7 +327,7 @@ func (s *PolarDBService) DescribePolarDBAccountPrivilege(id string) (account *po
 	request.RegionId = s.client.RegionId
 	request.DBClusterId = parts[0]
 	request.AccountName = parts[1]
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response *polardb.DescribeAccountsResponse
 	if err := invoker.Run(func() error {
@@ -764,7 +764,7 @@ func (s *PolarDBService) DescribePolarDBAccount(id string) (ds *polardb.DBAccoun
 	request.RegionId = s.client.RegionId
 	request.DBClusterId = parts[0]
 	request.AccountName = parts[1]
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response *polardb.DescribeAccountsResponse
 	if err := invoker.Run(func() error {
@@ -1030,8 +1030,8 @@ func (s *PolarDBService) CreateClusterParamsModifyParameters(d *schema.ResourceD
 }
 
 func (s *PolarDBService) setClusterTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -1121,6 +1121,9 @@ func (s *PolarDBService) tagsFromMap(m map[string]interface{}) []polardb.TagReso
 }
 
 func (s *PolarDBService) ignoreTag(t polardb.TagResource) bool {
+	if providerTagIgnored(s.client, t.TagKey) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
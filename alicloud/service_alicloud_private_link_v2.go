@@ -33,7 +33,7 @@ func (s *PrivateLinkServiceV2) DescribePrivateLinkVpcEndpointService(id string)
 		response, err = client.RpcPost("Privatelink", "2020-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Privatelink", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -70,7 +70,7 @@ func (s *PrivateLinkServiceV2) DescribeVpcEndpointServiceListTagResources(id str
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Privatelink", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -90,7 +90,7 @@ func (s *PrivateLinkServiceV2) PrivateLinkVpcEndpointServiceStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePrivateLinkVpcEndpointService(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -119,7 +119,7 @@ func (s *PrivateLinkServiceV2) PrivateLinkVpcEndpointServiceStateRefreshFunc(id
 
 // SetResourceTags <<< Encapsulated tag function for PrivateLink.
 func (s *PrivateLinkServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -153,7 +153,7 @@ func (s *PrivateLinkServiceV2) SetResourceTags(d *schema.ResourceData, resourceT
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Privatelink", "2020-04-15", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Privatelink", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -190,7 +190,7 @@ func (s *PrivateLinkServiceV2) SetResourceTags(d *schema.ResourceData, resourceT
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Privatelink", "2020-04-15", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Privatelink", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -234,7 +234,7 @@ func (s *PrivateLinkServiceV2) DescribePrivateLinkVpcEndpointServiceUser(id stri
 		response, err = client.RpcPost("Privatelink", "2020-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Privatelink", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -271,7 +271,7 @@ func (s *PrivateLinkServiceV2) PrivateLinkVpcEndpointServiceUserStateRefreshFunc
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePrivateLinkVpcEndpointServiceUser(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -313,7 +313,7 @@ func (s *PrivateLinkServiceV2) DescribePrivateLinkVpcEndpointServiceResource(id
 		response, err = client.RpcPost("Privatelink", "2020-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Privatelink", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -356,7 +356,7 @@ func (s *PrivateLinkServiceV2) PrivateLinkVpcEndpointServiceResourceStateRefresh
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePrivateLinkVpcEndpointServiceResource(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -405,7 +405,7 @@ func (s *PrivateLinkServiceV2) DescribePrivateLinkVpcEndpointZone(id string) (ob
 		response, err = client.RpcPost("Privatelink", "2020-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Privatelink", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -445,7 +445,7 @@ func (s *PrivateLinkServiceV2) PrivateLinkVpcEndpointZoneStateRefreshFunc(id str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePrivateLinkVpcEndpointZone(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -488,7 +488,7 @@ func (s *PrivateLinkServiceV2) DescribePrivateLinkVpcEndpointConnection(id strin
 		response, err = client.RpcPost("Privatelink", "2020-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Privatelink", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -522,7 +522,7 @@ func (s *PrivateLinkServiceV2) PrivateLinkVpcEndpointConnectionStateRefreshFunc(
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePrivateLinkVpcEndpointConnection(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -567,7 +567,7 @@ func (s *PrivateLinkServiceV2) DescribePrivateLinkVpcEndpoint(id string) (object
 		response, err = client.RpcPost("Privatelink", "2020-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Privatelink", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -603,7 +603,7 @@ func (s *PrivateLinkServiceV2) DescribeVpcEndpointListTagResources(id string) (o
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Privatelink", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -634,7 +634,7 @@ func (s *PrivateLinkServiceV2) DescribeVpcEndpointListVpcEndpointSecurityGroups(
 		response, err = client.RpcPost("Privatelink", "2020-04-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Privatelink", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -662,7 +662,7 @@ func (s *PrivateLinkServiceV2) PrivateLinkVpcEndpointStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribePrivateLinkVpcEndpoint(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
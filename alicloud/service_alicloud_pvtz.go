@@ -450,7 +450,7 @@ func (s *PvtzService) DescribePvtzZone(id string) (object map[string]interface{}
 }
 
 func (s *PvtzService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -32,7 +32,7 @@ func (s *QuotasServiceV2) DescribeQuotasTemplateQuota(id string) (object map[str
 		response, err = client.Do("quotas", rpcParam("POST", "2020-05-10", action), nil, request, nil, nil, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("quotas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -64,7 +64,7 @@ func (s *QuotasServiceV2) QuotasTemplateQuotaStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeQuotasTemplateQuota(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -99,7 +99,7 @@ func (s *QuotasServiceV2) DescribeQuotasQuotaApplication(id string) (object map[
 		response, err = client.Do("quotas", rpcParam("POST", "2020-05-10", action), nil, request, nil, nil, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("quotas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -126,7 +126,7 @@ func (s *QuotasServiceV2) QuotasQuotaApplicationStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeQuotasQuotaApplication(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -160,7 +160,7 @@ func (s *QuotasServiceV2) DescribeQuotasQuotaAlarm(id string) (object map[string
 		response, err = client.Do("quotas", rpcParam("POST", "2020-05-10", action), nil, request, nil, nil, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("quotas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -188,7 +188,7 @@ func (s *QuotasServiceV2) QuotasQuotaAlarmStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeQuotasQuotaAlarm(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -221,7 +221,7 @@ func (s *QuotasServiceV2) DescribeQuotasTemplateApplications(id string) (object
 		response, err = client.Do("quotas", rpcParam("POST", "2020-05-10", action), nil, request, nil, nil, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("quotas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -261,7 +261,7 @@ func (s *QuotasServiceV2) DescribeListQuotaApplicationsDetailForTemplate(id stri
 		response, err = client.RpcPost("quotas", "2020-05-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("quotas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -282,7 +282,7 @@ func (s *QuotasServiceV2) QuotasTemplateApplicationsStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeQuotasTemplateApplications(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -326,7 +326,7 @@ func (s *QuotasServiceV2) DescribeQuotasTemplateService(id string) (object map[s
 		response, err = client.RpcPost("quotas", "2020-05-10", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("quotas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -352,7 +352,7 @@ func (s *QuotasServiceV2) QuotasTemplateServiceStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeQuotasTemplateService(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -371,3 +371,59 @@ func (s *QuotasServiceV2) QuotasTemplateServiceStateRefreshFunc(id string, field
 }
 
 // DescribeQuotasTemplateService >>> Encapsulated.
+
+// DescribeQuotasQuota describes the quota whose id is <product_code>:<quota_action_code> in the dimensions, like the
+// regionId of the ECS quotas.
+func (s *QuotasServiceV2) DescribeQuotasQuota(id string, dimensions map[string]string) (object map[string]interface{}, err error) {
+	client := s.client
+	var request map[string]interface{}
+	var response map[string]interface{}
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return object, WrapError(err)
+	}
+	action := "ListProductQuotas"
+	request = make(map[string]interface{})
+	request["ProductCode"] = parts[0]
+	request["QuotaActionCode"] = parts[1]
+	dimensionsMaps := make([]map[string]interface{}, 0, len(dimensions))
+	for key, value := range dimensions {
+		dimensionsMaps = append(dimensionsMaps, map[string]interface{}{
+			"Key":   key,
+			"Value": value,
+		})
+	}
+	if len(dimensionsMaps) > 0 {
+		request["Dimensions"] = dimensionsMaps
+	}
+
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		response, err = client.Do("quotas", rpcParam("POST", "2020-05-10", action), nil, request, nil, nil, false)
+
+		if err != nil {
+			if IsRetryableError("quotas", err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, response, request)
+		return nil
+	})
+	if err != nil {
+		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
+	}
+
+	v, err := jsonpath.Get("$.Quotas", response)
+	if err != nil {
+		return object, WrapErrorf(err, FailedGetAttributeMsg, id, "$.Quotas", response)
+	}
+	for _, item := range v.([]interface{}) {
+		if quota, ok := item.(map[string]interface{}); ok && fmt.Sprint(quota["QuotaActionCode"]) == parts[1] {
+			return quota, nil
+		}
+	}
+
+	return object, WrapErrorf(NotFoundErr("Quota", id), NotFoundMsg, ProviderERROR, fmt.Sprint(response["RequestId"]))
+}
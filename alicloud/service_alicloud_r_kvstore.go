@@ -108,7 +108,7 @@ func (s *R_kvstoreService) DescribeSecurityIps(id, securityIpGroupName string) (
 }
 
 func (s *R_kvstoreService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	oldItems, newItems := d.GetChange("tags")
+	oldItems, newItems := getTagsChange(d)
 	added := make([]r_kvstore.TagResourcesTag, 0)
 	for key, value := range newItems.(map[string]interface{}) {
 		added = append(added, r_kvstore.TagResourcesTag{
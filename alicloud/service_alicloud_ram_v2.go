@@ -40,7 +40,7 @@ func (s *RamServiceV2) DescribeRamUserGroupAttachment(id string) (object map[str
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -77,7 +77,7 @@ func (s *RamServiceV2) RamUserGroupAttachmentStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamUserGroupAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -122,7 +122,7 @@ func (s *RamServiceV2) DescribeRamGroup(id string) (object map[string]interface{
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -150,7 +150,7 @@ func (s *RamServiceV2) RamGroupStateRefreshFunc(id string, field string, failSta
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -194,7 +194,7 @@ func (s *RamServiceV2) DescribeRamLoginProfile(id string) (object map[string]int
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -222,7 +222,7 @@ func (s *RamServiceV2) RamLoginProfileStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamLoginProfile(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -267,7 +267,7 @@ func (s *RamServiceV2) DescribeRamSamlProvider(id string) (object map[string]int
 		response, err = client.RpcPost("Ims", "2019-08-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ims", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -295,7 +295,7 @@ func (s *RamServiceV2) RamSamlProviderStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamSamlProvider(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -349,7 +349,7 @@ func (s *RamServiceV2) DescribeRamRolePolicyAttachment(id string) (object map[st
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -392,7 +392,7 @@ func (s *RamServiceV2) RamRolePolicyAttachmentStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamRolePolicyAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -437,7 +437,7 @@ func (s *RamServiceV2) DescribeRamSystemPolicy(id string) (object map[string]int
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -460,7 +460,7 @@ func (s *RamServiceV2) RamSystemPolicyStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamSystemPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -506,7 +506,7 @@ func (s *RamServiceV2) DescribeRamPolicy(id string) (object map[string]interface
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -543,7 +543,7 @@ func (s *RamServiceV2) DescribePolicyListTagResources(id string) (object map[str
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -563,7 +563,7 @@ func (s *RamServiceV2) RamPolicyStateRefreshFunc(id string, field string, failSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -651,7 +651,7 @@ func (s *RamServiceV2) ParsePolicyDocument(policyDocument string) (statement []m
 
 // SetResourceTags <<< Encapsulated tag function for Ram.
 func (s *RamServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -680,7 +680,7 @@ func (s *RamServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Ram", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -717,7 +717,7 @@ func (s *RamServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Ram", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -756,7 +756,7 @@ func (s *RamServiceV2) DescribeRamAccessKey(id, userName string) (object map[str
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -816,7 +816,7 @@ func (s *RamServiceV2) DescribeRamUserPolicyAttachment(id string) (object map[st
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -859,7 +859,7 @@ func (s *RamServiceV2) RamUserPolicyAttachmentStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamUserPolicyAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -907,7 +907,7 @@ func (s *RamServiceV2) DescribeRamGroupPolicyAttachment(id string) (object map[s
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -950,7 +950,7 @@ func (s *RamServiceV2) RamGroupPolicyAttachmentStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamGroupPolicyAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -994,7 +994,7 @@ func (s *RamServiceV2) DescribeRamAccountAlias(id string) (object map[string]int
 		response, err = client.RpcPost("Ram", "2015-05-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ram", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1014,7 +1014,7 @@ func (s *RamServiceV2) RamAccountAliasStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamAccountAlias(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1058,7 +1058,7 @@ func (s *RamServiceV2) DescribeRamPasswordPolicy(id string) (object map[string]i
 		response, err = client.RpcPost("Ims", "2019-08-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ims", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1083,7 +1083,7 @@ func (s *RamServiceV2) RamPasswordPolicyStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamPasswordPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1127,7 +1127,7 @@ func (s *RamServiceV2) DescribeRamSecurityPreference(id string) (object map[stri
 		response, err = client.RpcPost("Ims", "2019-08-15", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Ims", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1152,7 +1152,7 @@ func (s *RamServiceV2) RamSecurityPreferenceStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRamSecurityPreference(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
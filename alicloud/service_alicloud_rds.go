@@ -133,7 +133,7 @@ func (s *RdsService) DescribeDBAccountPrivilege(id string) (object map[string]in
 		"SourceIp":     s.client.SourceIp,
 	}
 	client := s.client
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response map[string]interface{}
 	if err := invoker.Run(func() error {
@@ -1708,7 +1708,7 @@ func (s *RdsService) flattenDBSecurityIPs(list []interface{}) []map[string]inter
 }
 
 func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 		var err error
@@ -1841,6 +1841,9 @@ func (s *RdsService) tagsToMap(tags []Tag) map[string]string {
 }
 
 func (s *RdsService) ignoreTag(t Tag) bool {
+	if providerTagIgnored(s.client, t.Key) {
+		return true
+	}
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
@@ -33,7 +33,7 @@ func (s *RdsServiceV2) DescribeRdsCustom(id string) (object map[string]interface
 		response, err = client.RpcPost("Rds", "2014-08-15", action, query, request, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Rds", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -68,7 +68,7 @@ func (s *RdsServiceV2) DescribeListTagResources(id string) (object map[string]in
 		response, err = client.RpcPost("Rds", "2014-08-15", action, query, request, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Rds", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -88,7 +88,7 @@ func (s *RdsServiceV2) RdsCustomStateRefreshFunc(id string, field string, failSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRdsCustom(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -117,7 +117,7 @@ func (s *RdsServiceV2) RdsCustomStateRefreshFunc(id string, field string, failSt
 
 // SetResourceTags <<< Encapsulated tag function for Rds.
 func (s *RdsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		client := s.client
 		var request map[string]interface{}
@@ -147,7 +147,7 @@ func (s *RdsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Rds", "2014-08-15", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Rds", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -180,7 +180,7 @@ func (s *RdsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Rds", "2014-08-15", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Rds", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -219,7 +219,7 @@ func (s *RdsServiceV2) DescribeRdsCustomDeploymentSet(id string) (object map[str
 		response, err = client.RpcGet("Rds", "2014-08-15", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Rds", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -253,7 +253,7 @@ func (s *RdsServiceV2) RdsCustomDeploymentSetStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRdsCustomDeploymentSet(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -298,7 +298,7 @@ func (s *RdsServiceV2) DescribeRdsCustomDisk(id string) (object map[string]inter
 		response, err = client.RpcGet("Rds", "2014-08-15", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Rds", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -327,7 +327,7 @@ func (s *RdsServiceV2) RdsCustomDiskStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRdsCustomDisk(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
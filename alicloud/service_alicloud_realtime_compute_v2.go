@@ -32,7 +32,7 @@ func (s *RealtimeComputeServiceV2) DescribeRealtimeComputeVvpInstance(id string)
 		response, err = client.RpcGet("foasconsole", "2019-06-01", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("foasconsole", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -62,7 +62,7 @@ func (s *RealtimeComputeServiceV2) RealtimeComputeVvpInstanceStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRealtimeComputeVvpInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -84,7 +84,7 @@ func (s *RealtimeComputeServiceV2) RealtimeComputeVvpInstanceStateRefreshFunc(id
 
 // SetResourceTags <<< Encapsulated tag function for RealtimeCompute.
 func (s *RealtimeComputeServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -115,7 +115,7 @@ func (s *RealtimeComputeServiceV2) SetResourceTags(d *schema.ResourceData, resou
 				response, err = client.RpcPost("foasconsole", "2019-06-01", action, query, request, true)
 
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("foasconsole", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -149,7 +149,7 @@ func (s *RealtimeComputeServiceV2) SetResourceTags(d *schema.ResourceData, resou
 				response, err = client.RpcPost("foasconsole", "2019-06-01", action, query, request, true)
 
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("foasconsole", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
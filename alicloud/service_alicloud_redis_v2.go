@@ -33,7 +33,7 @@ func (s *RedisServiceV2) DescribeRedisTairInstance(id string) (object map[string
 		response, err = client.RpcPost("R-kvstore", "2015-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("R-kvstore", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -76,7 +76,7 @@ func (s *RedisServiceV2) DescribeTairInstanceDescribeInstanceConfig(id string) (
 		response, err = client.RpcPost("R-kvstore", "2015-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("R-kvstore", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -110,7 +110,7 @@ func (s *RedisServiceV2) DescribeDescribeSecurityIps(id, securityIpGroupName str
 		response, err = client.RpcPost("R-kvstore", "2015-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("R-kvstore", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -164,7 +164,7 @@ func (s *RedisServiceV2) DescribeTairInstanceDescribeSecurityGroupConfiguration(
 		response, err = client.RpcPost("R-kvstore", "2015-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("R-kvstore", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -207,7 +207,7 @@ func (s *RedisServiceV2) DescribeTairInstanceDescribeInstanceSSL(id string) (obj
 		response, err = client.RpcPost("R-kvstore", "2015-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("R-kvstore", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -241,7 +241,7 @@ func (s *RedisServiceV2) DescribeTairInstanceDescribeIntranetAttribute(id string
 		response, err = client.RpcPost("R-kvstore", "2015-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("R-kvstore", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -264,7 +264,7 @@ func (s *RedisServiceV2) RedisTairInstanceStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRedisTairInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -293,7 +293,7 @@ func (s *RedisServiceV2) RedisTairInstanceStateRefreshFunc(id string, field stri
 
 // SetResourceTags <<< Encapsulated tag function for Redis.
 func (s *RedisServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -323,7 +323,7 @@ func (s *RedisServiceV2) SetResourceTags(d *schema.ResourceData, resourceType st
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("R-kvstore", "2015-01-01", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("R-kvstore", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -356,7 +356,7 @@ func (s *RedisServiceV2) SetResourceTags(d *schema.ResourceData, resourceType st
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("R-kvstore", "2015-01-01", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("R-kvstore", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
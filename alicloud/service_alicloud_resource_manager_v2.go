@@ -31,7 +31,7 @@ func (s *ResourceManagerServiceV2) DescribeResourceManagerSavedQuery(id string)
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPost("ResourceCenter", "2022-12-01", action, query, request, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ResourceCenter", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -60,7 +60,7 @@ func (s *ResourceManagerServiceV2) ResourceManagerSavedQueryStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeResourceManagerSavedQuery(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -98,7 +98,7 @@ func (s *ResourceManagerServiceV2) DescribeResourceManagerAutoGroupingRule(id st
 		response, err = client.RpcPost("ResourceManager", "2020-03-31", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ResourceManager", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -126,7 +126,7 @@ func (s *ResourceManagerServiceV2) ResourceManagerAutoGroupingRuleStateRefreshFu
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeResourceManagerAutoGroupingRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -171,7 +171,7 @@ func (s *ResourceManagerServiceV2) DescribeResourceManagerAccount(id string) (ob
 		response, err = client.RpcPost("ResourceManager", "2020-03-31", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ResourceManager", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -199,7 +199,7 @@ func (s *ResourceManagerServiceV2) ResourceManagerAccountStateRefreshFunc(id str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeResourceManagerAccount(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -228,7 +228,7 @@ func (s *ResourceManagerServiceV2) DescribeAsyncResourceManagerAccountStateRefre
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeAsyncGetAccountDeletionStatus(d, res)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 		}
@@ -259,7 +259,7 @@ func (s *ResourceManagerServiceV2) DescribeAsyncResourceManagerAccountStateRefre
 
 // SetResourceTags <<< Encapsulated tag function for ResourceManager.
 func (s *ResourceManagerServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -289,7 +289,7 @@ func (s *ResourceManagerServiceV2) SetResourceTags(d *schema.ResourceData, resou
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ResourceManager", "2020-03-31", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ResourceManager", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -322,7 +322,7 @@ func (s *ResourceManagerServiceV2) SetResourceTags(d *schema.ResourceData, resou
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("ResourceManager", "2020-03-31", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("ResourceManager", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -361,7 +361,7 @@ func (s *ResourceManagerServiceV2) DescribeAsyncGetAccountDeletionStatus(d *sche
 		response, err = client.RpcPost("ResourceManager", "2020-03-31", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("ResourceManager", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
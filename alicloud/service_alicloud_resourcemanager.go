@@ -602,7 +602,7 @@ func (s *ResourcemanagerService) ListTagResources(id string, resourceType string
 
 func (s *ResourcemanagerService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 		removedTagKeys := make([]string, 0)
@@ -34,7 +34,7 @@ func (s *RocketmqServiceV2) DescribeRocketmqInstance(id string) (object map[stri
 		response, err = client.RoaGet("RocketMQ", "2022-08-01", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("RocketMQ", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -79,7 +79,7 @@ func (s *RocketmqServiceV2) DescribeGetInstanceAccount(id string) (object map[st
 		response, err = client.RoaGet("RocketMQ", "2022-08-01", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("RocketMQ", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -105,7 +105,7 @@ func (s *RocketmqServiceV2) RocketmqInstanceStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRocketmqInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -147,7 +147,7 @@ func (s *RocketmqServiceV2) DescribeInstanceGetInstanceIpWhitelist(id string) (o
 		response, err = client.RoaGet("RocketMQ", "2022-08-01", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("RocketMQ", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -175,7 +175,7 @@ func (s *RocketmqServiceV2) DescribeInstanceGetInstanceIpWhitelist(id string) (o
 
 // SetResourceTags <<< Encapsulated tag function for Rocketmq.
 func (s *RocketmqServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -207,7 +207,7 @@ func (s *RocketmqServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RoaDelete("RocketMQ", "2022-08-01", action, query, nil, body, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("RocketMQ", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -248,7 +248,7 @@ func (s *RocketmqServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RoaPost("RocketMQ", "2022-08-01", action, query, nil, body, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("RocketMQ", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -292,7 +292,7 @@ func (s *RocketmqServiceV2) DescribeRocketmqConsumerGroup(id string) (object map
 		response, err = client.RoaGet("RocketMQ", "2022-08-01", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("RocketMQ", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -320,7 +320,7 @@ func (s *RocketmqServiceV2) RocketmqConsumerGroupStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRocketmqConsumerGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -370,7 +370,7 @@ func (s *RocketmqServiceV2) DescribeRocketmqTopic(id string) (object map[string]
 		response, err = client.RoaGet("RocketMQ", "2022-08-01", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("RocketMQ", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -398,7 +398,7 @@ func (s *RocketmqServiceV2) RocketmqTopicStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRocketmqTopic(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -448,7 +448,7 @@ func (s *RocketmqServiceV2) DescribeRocketmqAccount(id string) (object map[strin
 		response, err = client.RoaGet("RocketMQ", "2022-08-01", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("RocketMQ", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -476,7 +476,7 @@ func (s *RocketmqServiceV2) RocketmqAccountStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRocketmqAccount(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -528,7 +528,7 @@ func (s *RocketmqServiceV2) DescribeRocketmqAcl(id string) (object map[string]in
 		response, err = client.RoaGet("RocketMQ", "2022-08-01", action, query, nil, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("RocketMQ", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -556,7 +556,7 @@ func (s *RocketmqServiceV2) RocketmqAclStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeRocketmqAcl(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -191,7 +191,7 @@ func (s *RosService) ListTagResources(id string, resourceType string) (object in
 }
 
 func (s *RosService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		client := s.client
 		added, removed := parsingTags(d)
 		removedTagKeys := make([]string, 0)
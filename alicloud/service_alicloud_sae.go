@@ -640,7 +640,7 @@ func (s *SaeService) ListTagResources(id string, resourceType string) (object in
 
 func (s *SaeService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 		ids, err := json.Marshal([]string{d.Id()})
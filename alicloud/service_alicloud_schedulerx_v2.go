@@ -38,7 +38,7 @@ func (s *SchedulerxServiceV2) DescribeSchedulerxJob(id string) (object map[strin
 		response, err = client.RpcGet("schedulerx2", "2019-04-30", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("schedulerx2", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -66,7 +66,7 @@ func (s *SchedulerxServiceV2) SchedulerxJobStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSchedulerxJob(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -111,7 +111,7 @@ func (s *SchedulerxServiceV2) DescribeSchedulerxNamespace(id string) (object map
 		response, err = client.RpcPost("schedulerx2", "2019-04-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("schedulerx2", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -140,7 +140,7 @@ func (s *SchedulerxServiceV2) SchedulerxNamespaceStateRefreshFunc(id string, fie
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSchedulerxNamespace(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -190,7 +190,7 @@ func (s *SchedulerxServiceV2) DescribeSchedulerxAppGroup(id string) (object map[
 		response, err = client.RpcPost("schedulerx2", "2019-04-30", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("schedulerx2", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -218,7 +218,7 @@ func (s *SchedulerxServiceV2) SchedulerxAppGroupStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSchedulerxAppGroup(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -31,7 +31,7 @@ func (s *ServiceCatalogServiceV2) DescribeServiceCatalogProduct(id string) (obje
 		response, err = client.RpcPost("servicecatalog", "2021-09-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("servicecatalog", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -60,7 +60,7 @@ func (s *ServiceCatalogServiceV2) ServiceCatalogProductStateRefreshFunc(id strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeServiceCatalogProduct(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -101,7 +101,7 @@ func (s *ServiceCatalogServiceV2) DescribeServiceCatalogProductPortfolioAssociat
 		response, err = client.RpcPost("servicecatalog", "2021-09-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("servicecatalog", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -139,7 +139,7 @@ func (s *ServiceCatalogServiceV2) ServiceCatalogProductPortfolioAssociationState
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeServiceCatalogProductPortfolioAssociation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -176,7 +176,7 @@ func (s *ServiceCatalogServiceV2) DescribeServiceCatalogProductVersion(id string
 		response, err = client.RpcPost("servicecatalog", "2021-09-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("servicecatalog", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -205,7 +205,7 @@ func (s *ServiceCatalogServiceV2) ServiceCatalogProductVersionStateRefreshFunc(i
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeServiceCatalogProductVersion(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -246,7 +246,7 @@ func (s *ServiceCatalogServiceV2) DescribeServiceCatalogPrincipalPortfolioAssoci
 		response, err = client.RpcPost("servicecatalog", "2021-09-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("servicecatalog", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -287,7 +287,7 @@ func (s *ServiceCatalogServiceV2) ServiceCatalogPrincipalPortfolioAssociationSta
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeServiceCatalogPrincipalPortfolioAssociation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -324,7 +324,7 @@ func (s *ServiceCatalogServiceV2) DescribeServiceCatalogPortfolio(id string) (ob
 		response, err = client.RpcPost("servicecatalog", "2021-09-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("servicecatalog", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -353,7 +353,7 @@ func (s *ServiceCatalogServiceV2) ServiceCatalogPortfolioStateRefreshFunc(id str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeServiceCatalogPortfolio(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
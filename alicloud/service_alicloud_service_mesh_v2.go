@@ -31,7 +31,7 @@ func (s *ServiceMeshServiceV2) DescribeServiceMeshServiceMesh(id string) (object
 		response, err = client.RpcPost("servicemesh", "2020-01-11", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("servicemesh", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -70,7 +70,7 @@ func (s *ServiceMeshServiceV2) DescribeDescribeKialiConfiguration(id string) (ob
 		response, err = client.RpcPost("servicemesh", "2020-01-11", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("servicemesh", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -106,7 +106,7 @@ func (s *ServiceMeshServiceV2) DescribeListTagResources(id string) (object map[s
 		response, err = client.RpcPost("servicemesh", "2020-01-11", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("servicemesh", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -138,7 +138,7 @@ func (s *ServiceMeshServiceV2) DescribeDescribeServiceMeshKubeconfig(id string)
 		response, err = client.RpcPost("servicemesh", "2020-01-11", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("servicemesh", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -162,7 +162,7 @@ func (s *ServiceMeshServiceV2) ServiceMeshServiceMeshStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeServiceMeshServiceMesh(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -184,7 +184,7 @@ func (s *ServiceMeshServiceV2) ServiceMeshServiceMeshStateRefreshFunc(id string,
 
 // SetResourceTags <<< Encapsulated tag function for ServiceMesh.
 func (s *ServiceMeshServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -214,7 +214,7 @@ func (s *ServiceMeshServiceV2) SetResourceTags(d *schema.ResourceData, resourceT
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("servicemesh", "2020-01-11", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("servicemesh", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -247,7 +247,7 @@ func (s *ServiceMeshServiceV2) SetResourceTags(d *schema.ResourceData, resourceT
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("servicemesh", "2020-01-11", action, query, request, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("servicemesh", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
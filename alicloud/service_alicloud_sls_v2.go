@@ -33,7 +33,7 @@ func (s *SlsServiceV2) DescribeSlsProject(id string) (object map[string]interfac
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Sls", roaParam("GET", "2020-12-30", "GetProject", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sls", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -69,7 +69,7 @@ func (s *SlsServiceV2) DescribeListTagResources(id string) (object map[string]in
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Sls", roaParam("GET", "2020-12-30", "ListTagResources", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sls", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -93,7 +93,7 @@ func (s *SlsServiceV2) SlsProjectStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSlsProject(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -114,7 +114,7 @@ func (s *SlsServiceV2) SlsProjectStateRefreshFunc(id string, field string, failS
 
 // SetResourceTags <<< Encapsulated tag function for Sls.
 func (s *SlsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		client := s.client
@@ -147,7 +147,7 @@ func (s *SlsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.Do("Sls", roaParam("POST", "2020-12-30", "UntagResources", action), query, body, nil, hostMap, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Sls", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -187,7 +187,7 @@ func (s *SlsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.Do("Sls", roaParam("POST", "2020-12-30", "TagResources", action), query, body, nil, hostMap, false)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Sls", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -231,7 +231,7 @@ func (s *SlsServiceV2) DescribeSlsLogStore(id string) (object map[string]interfa
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Sls", roaParam("GET", "2020-12-30", "GetLogStore", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sls", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -270,7 +270,7 @@ func (s *SlsServiceV2) DescribeGetLogStoreMeteringMode(id string) (object map[st
 	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Sls", roaParam("GET", "2020-12-30", "GetLogStoreMeteringMode", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sls", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -294,7 +294,7 @@ func (s *SlsServiceV2) SlsLogStoreStateRefreshFunc(id string, field string, fail
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSlsLogStore(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -338,7 +338,7 @@ func (s *SlsServiceV2) DescribeSlsAlert(id string) (object map[string]interface{
 		response, err = client.Do("Sls", roaParam("GET", "2020-12-30", "GetAlert", action), query, nil, nil, hostMap, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sls", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -361,7 +361,7 @@ func (s *SlsServiceV2) SlsAlertStateRefreshFunc(id string, field string, failSta
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSlsAlert(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -410,7 +410,7 @@ func (s *SlsServiceV2) DescribeSlsScheduledSQL(id string) (object map[string]int
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Sls", roaParam("GET", "2020-12-30", "GetScheduledSQL", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sls", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -434,7 +434,7 @@ func (s *SlsServiceV2) SlsScheduledSQLStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSlsScheduledSQL(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -472,7 +472,7 @@ func (s *SlsServiceV2) DescribeSlsCollectionPolicy(id string) (object map[string
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Sls", roaParam("GET", "2020-12-30", "GetCollectionPolicy", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sls", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -501,7 +501,7 @@ func (s *SlsServiceV2) SlsCollectionPolicyStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSlsCollectionPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -549,7 +549,7 @@ func (s *SlsServiceV2) DescribeSlsOssExportSink(id string) (object map[string]in
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.Do("Sls", roaParam("GET", "2020-12-30", "GetOSSExport", action), query, nil, nil, hostMap, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sls", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -572,7 +572,7 @@ func (s *SlsServiceV2) SlsOssExportSinkStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSlsOssExportSink(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -623,7 +623,7 @@ func (s *SlsServiceV2) DescribeSlsEtl(id string) (object map[string]interface{},
 		response, err = client.Do("Sls", roaParam("GET", "2020-12-30", "GetETL", action), query, nil, nil, hostMap, false)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sls", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -646,7 +646,7 @@ func (s *SlsServiceV2) SlsEtlStateRefreshFunc(id string, field string, failState
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeSlsEtl(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
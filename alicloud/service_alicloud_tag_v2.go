@@ -32,7 +32,7 @@ func (s *TagServiceV2) DescribeTagPolicy(id string) (object map[string]interface
 		response, err = client.RpcPost("Tag", "2018-08-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Tag", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -60,7 +60,7 @@ func (s *TagServiceV2) TagPolicyStateRefreshFunc(id string, field string, failSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeTagPolicy(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -105,7 +105,7 @@ func (s *TagServiceV2) DescribeTagAssociatedRule(id string) (object map[string]i
 		response, err = client.RpcPost("Tag", "2018-08-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Tag", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -134,7 +134,7 @@ func (s *TagServiceV2) TagAssociatedRuleStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeTagAssociatedRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -34,7 +34,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionInstance(id string) (o
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPostWithEndpoint("Sas", "2018-12-03", action, query, request, true, endpoint)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -92,7 +92,7 @@ func (s *ThreatDetectionServiceV2) DescribeInstanceQueryAvailableInstances(d *sc
 		response, err = client.RpcPostWithEndpoint("BssOpenApi", "2017-12-14", action, query, request, true, endpoint)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("BssOpenApi", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -136,7 +136,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionInstanceStateRefreshFunc(id st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionInstance(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -182,7 +182,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionClientUserDefineRule(i
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -213,7 +213,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionClientUserDefineRuleStateRefre
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionClientUserDefineRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -250,7 +250,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionLogMeta(id string) (ob
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -280,7 +280,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionLogMetaStateRefreshFunc(id str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionLogMeta(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -326,7 +326,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionClientFileProtect(id s
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -352,7 +352,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionClientFileProtectStateRefreshF
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionClientFileProtect(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -393,7 +393,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionFileUploadLimit(id str
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -424,7 +424,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionFileUploadLimitStateRefreshFun
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionFileUploadLimit(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -462,7 +462,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionMaliciousFileWhitelist
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -488,7 +488,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionMaliciousFileWhitelistConfigSt
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionMaliciousFileWhitelistConfig(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -526,7 +526,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionImageEventOperation(id
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -555,7 +555,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionImageEventOperationStateRefres
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionImageEventOperation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -597,7 +597,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionSasTrail(id string) (o
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -628,7 +628,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionSasTrailStateRefreshFunc(id st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionSasTrail(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -666,7 +666,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionOssScanConfig(id strin
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -697,7 +697,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionOssScanConfigStateRefreshFunc(
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionOssScanConfig(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -735,7 +735,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionAntiBruteForceRule(id
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -769,7 +769,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionAntiBruteForceRuleStateRefresh
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionAntiBruteForceRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -814,7 +814,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionAssetSelectionConfig(i
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -844,7 +844,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionAssetSelectionConfigStateRefre
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionAssetSelectionConfig(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -888,7 +888,7 @@ func (s *ThreatDetectionServiceV2) DescribeThreatDetectionAssetBind(id string) (
 		response, err = client.RpcPost("Sas", "2018-12-03", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Sas", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -913,7 +913,7 @@ func (s *ThreatDetectionServiceV2) ThreatDetectionAssetBindStateRefreshFunc(id s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeThreatDetectionAssetBind(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -71,7 +71,7 @@ func (s *VodService) VodStateRefreshFunc(id string, failStates []string) resourc
 
 func (s *VodService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -135,7 +135,7 @@ func (s *VpcService) DescribeVSwitch(id string) (v vpc.DescribeVSwitchAttributes
 	request.RegionId = s.client.RegionId
 	request.VSwitchId = id
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeVSwitchAttributes(request)
@@ -321,7 +321,7 @@ func (s *VpcService) DescribeRouterInterface(id, regionId string) (ri vpc.Router
 		},
 	}
 	request.Filter = &filter
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeRouterInterfaces(request)
@@ -371,7 +371,7 @@ func (s *VpcService) DescribeCenInstanceGrant(id string) (rule vpc.CbnGrantRule,
 	request.InstanceId = instanceId
 	request.InstanceType = instanceType
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeGrantRulesToCen(request)
@@ -462,7 +462,7 @@ func (s *VpcService) DescribeRouteTableAttachment(id string) (v map[string]inter
 	if err != nil {
 		return v, WrapError(err)
 	}
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	routeTableId := parts[0]
 	vSwitchId := parts[1]
 
@@ -647,7 +647,7 @@ func (s *VpcService) DescribeNetworkAcl(id string) (object map[string]interface{
 
 func (s *VpcService) DescribeNetworkAclAttachment(id string, resource []vpc.Resource) (err error) {
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	return invoker.Run(func() error {
 		object, err := s.DescribeNetworkAcl(id)
 		if err != nil {
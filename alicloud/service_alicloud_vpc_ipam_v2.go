@@ -33,7 +33,7 @@ func (s *VpcIpamServiceV2) DescribeVpcIpamIpam(id string) (object map[string]int
 		response, err = client.RpcPost("VpcIpam", "2023-02-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("VpcIpam", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -67,7 +67,7 @@ func (s *VpcIpamServiceV2) VpcIpamIpamStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpamIpam(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -96,7 +96,7 @@ func (s *VpcIpamServiceV2) VpcIpamIpamStateRefreshFunc(id string, field string,
 
 // SetResourceTags <<< Encapsulated tag function for VpcIpam.
 func (s *VpcIpamServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -126,7 +126,7 @@ func (s *VpcIpamServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("VpcIpam", "2023-02-28", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("VpcIpam", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -159,7 +159,7 @@ func (s *VpcIpamServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("VpcIpam", "2023-02-28", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("VpcIpam", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -198,7 +198,7 @@ func (s *VpcIpamServiceV2) DescribeVpcIpamIpamScope(id string) (object map[strin
 		response, err = client.RpcPost("VpcIpam", "2023-02-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("VpcIpam", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -232,7 +232,7 @@ func (s *VpcIpamServiceV2) VpcIpamIpamScopeStateRefreshFunc(id string, field str
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpamIpamScope(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -277,7 +277,7 @@ func (s *VpcIpamServiceV2) DescribeVpcIpamIpamPool(id string) (object map[string
 		response, err = client.RpcPost("VpcIpam", "2023-02-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("VpcIpam", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -311,7 +311,7 @@ func (s *VpcIpamServiceV2) VpcIpamIpamPoolStateRefreshFunc(id string, field stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpamIpamPool(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -361,7 +361,7 @@ func (s *VpcIpamServiceV2) DescribeVpcIpamIpamPoolCidr(id string) (object map[st
 		response, err = client.RpcPost("VpcIpam", "2023-02-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("VpcIpam", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -390,7 +390,7 @@ func (s *VpcIpamServiceV2) VpcIpamIpamPoolCidrStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpamIpamPoolCidr(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -435,7 +435,7 @@ func (s *VpcIpamServiceV2) DescribeVpcIpamIpamPoolAllocation(id string) (object
 		response, err = client.RpcGet("VpcIpam", "2023-02-28", action, query, nil)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("VpcIpam", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -458,7 +458,7 @@ func (s *VpcIpamServiceV2) VpcIpamIpamPoolAllocationStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpamIpamPoolAllocation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -504,7 +504,7 @@ func (s *VpcIpamServiceV2) DescribeVpcIpamService(id string) (object map[string]
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("VpcIpam", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -524,7 +524,7 @@ func (s *VpcIpamServiceV2) VpcIpamServiceStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpamService(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -569,7 +569,7 @@ func (s *VpcIpamServiceV2) DescribeVpcIpamIpamResourceDiscovery(id string) (obje
 		response, err = client.RpcPost("VpcIpam", "2023-02-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("VpcIpam", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -603,7 +603,7 @@ func (s *VpcIpamServiceV2) VpcIpamIpamResourceDiscoveryStateRefreshFunc(id strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpamIpamResourceDiscovery(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -32,7 +32,7 @@ func (s *VpcPeerServiceV2) DescribeVpcPeerPeerConnection(id string) (object map[
 		response, err = client.RpcPost("VpcPeer", "2022-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("VpcPeer", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -55,7 +55,7 @@ func (s *VpcPeerServiceV2) VpcPeerPeerConnectionStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcPeerPeerConnection(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -84,7 +84,7 @@ func (s *VpcPeerServiceV2) VpcPeerPeerConnectionStateRefreshFunc(id string, fiel
 
 // SetResourceTags <<< Encapsulated tag function for VpcPeer.
 func (s *VpcPeerServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var err error
 		var action string
 		var request map[string]interface{}
@@ -115,7 +115,7 @@ func (s *VpcPeerServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("VpcPeer", "2022-01-01", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("VpcPeer", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -150,7 +150,7 @@ func (s *VpcPeerServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("VpcPeer", "2022-01-01", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("VpcPeer", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -188,7 +188,7 @@ func (s *VpcPeerServiceV2) DescribeVpcPeerPeerConnectionAccepter(id string) (obj
 		response, err = client.RpcPost("VpcPeer", "2022-01-01", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("VpcPeer", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -211,7 +211,7 @@ func (s *VpcPeerServiceV2) VpcPeerPeerConnectionAccepterStateRefreshFunc(id stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcPeerPeerConnectionAccepter(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -35,7 +35,7 @@ func (s *VpcServiceV2) DescribeVpcPublicIpAddressPool(id string) (object map[str
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -65,7 +65,7 @@ func (s *VpcServiceV2) VpcPublicIpAddressPoolStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcPublicIpAddressPool(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -87,7 +87,7 @@ func (s *VpcServiceV2) VpcPublicIpAddressPoolStateRefreshFunc(id string, field s
 
 // SetResourceTags <<< Encapsulated tag function for Vpc.
 func (s *VpcServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -117,7 +117,7 @@ func (s *VpcServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Vpc", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -150,7 +150,7 @@ func (s *VpcServiceV2) SetResourceTags(d *schema.ResourceData, resourceType stri
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Vpc", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -191,7 +191,7 @@ func (s *VpcServiceV2) DescribeVpcPrefixList(id string) (object map[string]inter
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -235,7 +235,7 @@ func (s *VpcServiceV2) DescribeGetVpcPrefixListEntries(id string) (object map[st
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -276,7 +276,7 @@ func (s *VpcServiceV2) DescribeGetVpcPrefixListAssociations(id string) (object m
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -304,7 +304,7 @@ func (s *VpcServiceV2) VpcPrefixListStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcPrefixList(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -347,7 +347,7 @@ func (s *VpcServiceV2) DescribeVpcHaVip(id string) (object map[string]interface{
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -378,7 +378,7 @@ func (s *VpcServiceV2) VpcHaVipStateRefreshFunc(id string, field string, failSta
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcHaVip(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -421,7 +421,7 @@ func (s *VpcServiceV2) DescribeVpcVswitchCidrReservation(id string) (object map[
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -452,7 +452,7 @@ func (s *VpcServiceV2) VpcVswitchCidrReservationStateRefreshFunc(id string, fiel
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcVswitchCidrReservation(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -488,7 +488,7 @@ func (s *VpcServiceV2) DescribeVpcFlowLog(id string) (object map[string]interfac
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -519,7 +519,7 @@ func (s *VpcServiceV2) VpcFlowLogStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcFlowLog(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -564,7 +564,7 @@ func (s *VpcServiceV2) DescribeVpcIpv4Gateway(id string) (object map[string]inte
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -587,7 +587,7 @@ func (s *VpcServiceV2) VpcIpv4GatewayStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpv4Gateway(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -633,7 +633,7 @@ func (s *VpcServiceV2) DescribeVpcIpv6Gateway(id string) (object map[string]inte
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -661,7 +661,7 @@ func (s *VpcServiceV2) VpcIpv6GatewayStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpv6Gateway(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -702,7 +702,7 @@ func (s *VpcServiceV2) DescribeVpcPublicIpAddressPoolCidrBlock(id string) (objec
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -732,7 +732,7 @@ func (s *VpcServiceV2) VpcPublicIpAddressPoolCidrBlockStateRefreshFunc(id string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcPublicIpAddressPoolCidrBlock(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -772,7 +772,7 @@ func (s *VpcServiceV2) DescribeVpcVswitch(id string) (object map[string]interfac
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -800,7 +800,7 @@ func (s *VpcServiceV2) VpcVswitchStateRefreshFunc(id string, field string, failS
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcVswitch(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -836,7 +836,7 @@ func (s *VpcServiceV2) DescribeVpcVpc(id string) (object map[string]interface{},
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -872,7 +872,7 @@ func (s *VpcServiceV2) DescribeVpcDescribeRouteTableList(id string) (object map[
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -912,7 +912,7 @@ func (s *VpcServiceV2) VpcVpcStateRefreshFunc(id string, field string, failState
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcVpc(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -957,7 +957,7 @@ func (s *VpcServiceV2) DescribeVpcRouteTable(id string) (object map[string]inter
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -991,7 +991,7 @@ func (s *VpcServiceV2) VpcRouteTableStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcRouteTable(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1043,7 +1043,7 @@ func (s *VpcServiceV2) DescribeVpcGatewayRouteTableAttachment(id string) (object
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1066,7 +1066,7 @@ func (s *VpcServiceV2) VpcGatewayRouteTableAttachmentStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcGatewayRouteTableAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1104,7 +1104,7 @@ func (s *VpcServiceV2) DescribeVpcNetworkAcl(id string) (object map[string]inter
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1133,7 +1133,7 @@ func (s *VpcServiceV2) VpcNetworkAclStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcNetworkAcl(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1176,7 +1176,7 @@ func (s *VpcServiceV2) DescribeVpcIpv6EgressRule(id string) (object map[string]i
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1206,7 +1206,7 @@ func (s *VpcServiceV2) VpcIpv6EgressRuleStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpv6EgressRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1246,7 +1246,7 @@ func (s *VpcServiceV2) DescribeVpcTrafficMirrorFilter(id string) (object map[str
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1278,7 +1278,7 @@ func (s *VpcServiceV2) VpcTrafficMirrorFilterStateRefreshFunc(id string, field s
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcTrafficMirrorFilter(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1316,7 +1316,7 @@ func (s *VpcServiceV2) DescribeVpcTrafficMirrorSession(id string) (object map[st
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1348,7 +1348,7 @@ func (s *VpcServiceV2) VpcTrafficMirrorSessionStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcTrafficMirrorSession(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1386,7 +1386,7 @@ func (s *VpcServiceV2) DescribeVpcIpv6InternetBandwidth(id string) (object map[s
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1418,7 +1418,7 @@ func (s *VpcServiceV2) VpcIpv6InternetBandwidthStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpv6InternetBandwidth(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1454,7 +1454,7 @@ func (s *VpcServiceV2) DescribeVpcDhcpOptionsSet(id string) (object map[string]i
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1480,7 +1480,7 @@ func (s *VpcServiceV2) VpcDhcpOptionsSetStateRefreshFunc(id string, field string
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcDhcpOptionsSet(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1514,7 +1514,7 @@ func (s *VpcServiceV2) DescribeVpcPeerConnection(id string) (object map[string]i
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		response, err = client.RpcPost("VpcPeer", "2022-01-01", action, nil, request, true)
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("VpcPeer", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1559,7 +1559,7 @@ func (s *VpcServiceV2) DescribeVpcGatewayEndpoint(id string) (object map[string]
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1582,7 +1582,7 @@ func (s *VpcServiceV2) VpcGatewayEndpointStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcGatewayEndpoint(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1632,7 +1632,7 @@ func (s *VpcServiceV2) DescribeVpcGatewayEndpointRouteTableAttachment(id string)
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1667,7 +1667,7 @@ func (s *VpcServiceV2) VpcGatewayEndpointRouteTableAttachmentStateRefreshFunc(id
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcGatewayEndpointRouteTableAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1711,7 +1711,7 @@ func (s *VpcServiceV2) DescribeVpcNetworkAclAttachment(id string) (object map[st
 		request["ClientToken"] = buildClientToken(action)
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{"OperationFailure.OperationFailed"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"OperationFailure.OperationFailed"}) || IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1749,7 +1749,7 @@ func (s *VpcServiceV2) VpcNetworkAclAttachmentStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcNetworkAclAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1790,7 +1790,7 @@ func (s *VpcServiceV2) DescribeVpcTrafficMirrorFilterEgressRule(id string) (obje
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1834,7 +1834,7 @@ func (s *VpcServiceV2) VpcTrafficMirrorFilterEgressRuleStateRefreshFunc(id strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcTrafficMirrorFilterEgressRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1876,7 +1876,7 @@ func (s *VpcServiceV2) DescribeVpcTrafficMirrorFilterIngressRule(id string) (obj
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1920,7 +1920,7 @@ func (s *VpcServiceV2) VpcTrafficMirrorFilterIngressRuleStateRefreshFunc(id stri
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcTrafficMirrorFilterIngressRule(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -1968,7 +1968,7 @@ func (s *VpcServiceV2) DescribeVpcHaVipAttachment(id string) (object map[string]
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2022,7 +2022,7 @@ func (s *VpcServiceV2) VpcHaVipAttachmentStateRefreshFunc(id string, field strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcHaVipAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2063,7 +2063,7 @@ func (s *VpcServiceV2) DescribeVpcRouteTableAttachment(id string) (object map[st
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2117,7 +2117,7 @@ func (s *VpcServiceV2) VpcRouteTableAttachmentStateRefreshFunc(id string, field
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcRouteTableAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2157,7 +2157,7 @@ func (s *VpcServiceV2) DescribeVpcIpv4CidrBlock(id string) (object map[string]in
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2207,7 +2207,7 @@ func (s *VpcServiceV2) VpcIpv4CidrBlockStateRefreshFunc(id string, field string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpv4CidrBlock(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2252,7 +2252,7 @@ func (s *VpcServiceV2) DescribeVpcIpv6Address(id string) (object map[string]inte
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2286,7 +2286,7 @@ func (s *VpcServiceV2) VpcIpv6AddressStateRefreshFunc(id string, field string, f
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcIpv6Address(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -2336,7 +2336,7 @@ func (s *VpcServiceV2) DescribeVpcRouteEntry(id string) (object map[string]inter
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2385,7 +2385,7 @@ func (s *VpcServiceV2) DescribeRouteEntryListVpcPublishedRouteEntries(id string)
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -2414,7 +2414,7 @@ func (s *VpcServiceV2) VpcRouteEntryStateRefreshFunc(id string, field string, fa
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpcRouteEntry(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
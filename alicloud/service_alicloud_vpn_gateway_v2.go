@@ -34,7 +34,7 @@ func (s *VPNGatewayServiceV2) DescribeVPNGatewayVPNGateway(id string) (object ma
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -58,7 +58,7 @@ func (s *VPNGatewayServiceV2) VPNGatewayVPNGatewayStateRefreshFunc(id string, fi
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVPNGatewayVPNGateway(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -102,7 +102,7 @@ func (s *VPNGatewayServiceV2) DescribeVPNGatewayCustomerGateway(id string) (obje
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -126,7 +126,7 @@ func (s *VPNGatewayServiceV2) VPNGatewayCustomerGatewayStateRefreshFunc(id strin
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVPNGatewayCustomerGateway(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -162,7 +162,7 @@ func (s *VPNGatewayServiceV2) DescribeVPNGatewayVpnConnection(id string) (object
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -186,7 +186,7 @@ func (s *VPNGatewayServiceV2) VPNGatewayVpnConnectionStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVPNGatewayVpnConnection(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return nil, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -232,7 +232,7 @@ func (s *VPNGatewayServiceV2) DescribeVPNGatewayZone(id string) (object map[stri
 		response, err = client.RpcGet("Vpc", "2016-04-28", action, query, request)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -254,7 +254,7 @@ func (s *VPNGatewayServiceV2) VPNGatewayZoneStateRefreshFunc(id string, field st
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVPNGatewayZone(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -292,7 +292,7 @@ func (s *VPNGatewayServiceV2) DescribeVpnGatewayVpnAttachment(id string) (object
 		response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 
 		if err != nil {
-			if NeedRetry(err) {
+			if IsRetryableError("Vpc", err) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -315,7 +315,7 @@ func (s *VPNGatewayServiceV2) VpnGatewayVpnAttachmentStateRefreshFunc(id string,
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeVpnGatewayVpnAttachment(id)
 		if err != nil {
-			if NotFoundError(err) {
+			if IsNotFoundError(err) {
 				return object, "", nil
 			}
 			return nil, "", WrapError(err)
@@ -344,7 +344,7 @@ func (s *VPNGatewayServiceV2) VpnGatewayVpnAttachmentStateRefreshFunc(id string,
 
 // SetResourceTags <<< Encapsulated tag function for VpnGateway.
 func (s *VPNGatewayServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		var action string
 		var err error
 		client := s.client
@@ -374,7 +374,7 @@ func (s *VPNGatewayServiceV2) SetResourceTags(d *schema.ResourceData, resourceTy
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Vpc", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -407,7 +407,7 @@ func (s *VPNGatewayServiceV2) SetResourceTags(d *schema.ResourceData, resourceTy
 			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 				response, err = client.RpcPost("Vpc", "2016-04-28", action, query, request, true)
 				if err != nil {
-					if NeedRetry(err) {
+					if IsRetryableError("Vpc", err) {
 						wait()
 						return resource.RetryableError(err)
 					}
@@ -399,7 +399,7 @@ func (s *YundunBastionhostService) ListTagResources(id string, resourceType stri
 
 func (s *YundunBastionhostService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) (err error) {
 	client := s.client
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		added, removed := parsingTags(d)
 		if len(removed) > 0 {
 			var response map[string]interface{}
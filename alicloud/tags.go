@@ -64,7 +64,7 @@ func tagsSchemaWithIgnore() *schema.Schema {
 }
 
 func parsingTags(d *schema.ResourceData) (map[string]interface{}, []string) {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	removedTags := oraw.(map[string]interface{})
 	addedTags := nraw.(map[string]interface{})
 	// Build the list of what to remove
@@ -80,6 +80,26 @@ func parsingTags(d *schema.ResourceData) (map[string]interface{}, []string) {
 	return addedTags, removed
 }
 
+// getTagsChange returns the tags which have been applied to the resource and the tags which are expected.
+// For the resources supporting the provider default_tags, the applied tags come from the attribute tags_all
+// and the expected tags have been merged with the default tags before invoking the resource's Create and Update.
+func getTagsChange(d *schema.ResourceData) (interface{}, interface{}) {
+	oraw, _ := d.GetChange("tags")
+	if v, _ := d.GetChange("tags_all"); v != nil {
+		if tagsAll, ok := v.(map[string]interface{}); ok && len(tagsAll) > 0 {
+			oraw = tagsAll
+		}
+	}
+	nraw := d.Get("tags")
+	if oraw == nil {
+		oraw = map[string]interface{}{}
+	}
+	if nraw == nil {
+		nraw = map[string]interface{}{}
+	}
+	return oraw, nraw
+}
+
 func tagsToMap(tags interface{}) map[string]interface{} {
 	result := make(map[string]interface{})
 	if tags == nil {
@@ -138,6 +158,16 @@ func tagsToMap(tags interface{}) map[string]interface{} {
 	return result
 }
 
+// providerTagIgnored checks whether the tag key is ignored by the ignore_tags block of the provider configuration
+// the client belongs to.
+func providerTagIgnored(client *connectivity.AliyunClient, tagKey string) bool {
+	if client.TagIgnored(tagKey) {
+		log.Printf("[DEBUG] Found tag %s in the provider ignore_tags, ignoring.\n", tagKey)
+		return true
+	}
+	return false
+}
+
 func tagIgnored(tagKey string, tagValue interface{}) bool {
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://", "^sae.do.not.delete"}
 	for _, v := range filter {
@@ -154,8 +184,8 @@ func tagIgnored(tagKey string, tagValue interface{}) bool {
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTags(client *connectivity.AliyunClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oraw, nraw := getTagsChange(d)
 		return updateTags(client, []string{d.Id()}, resourceType, oraw, nraw)
 	}
 
@@ -163,8 +193,8 @@ func setTags(client *connectivity.AliyunClient, resourceType TagResourceType, d
 }
 
 func setCdnTags(client *connectivity.AliyunClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChanges("tags", "tags_all") {
+		oraw, nraw := getTagsChange(d)
 		return updateCdnTags(client, []string{d.Id()}, resourceType, oraw, nraw)
 	}
 
@@ -229,6 +259,7 @@ func updateTags(client *connectivity.AliyunClient, ids []string, resourceType Ta
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+	remove = withoutProviderIgnoredTags(client, remove)
 
 	// Set tags
 	if len(remove) > 0 {
@@ -378,6 +409,7 @@ func updateCdnTags(client *connectivity.AliyunClient, ids []string, resourceType
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+	remove = withoutProviderIgnoredTags(client, remove)
 
 	// Set tags
 	if len(remove) > 0 {
@@ -740,3 +772,224 @@ func ignoredTags(tagKey string, tagValue interface{}) bool {
 	}
 	return false
 }
+
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
+
+// supportDefaultTags checks whether the resource tags can be merged with the provider default_tags.
+// The tags must be an updatable map, and the resource does not define the attribute tags_all by itself.
+func supportDefaultTags(r *schema.Resource) bool {
+	if r == nil || r.Create == nil || r.Read == nil || r.Update == nil {
+		return false
+	}
+	v, ok := r.Schema["tags"]
+	if !ok || v.Type != schema.TypeMap || !v.Optional || v.ForceNew {
+		return false
+	}
+	_, ok = r.Schema["tags_all"]
+	return !ok
+}
+
+// resourceWithDefaultTags adds a computed attribute tags_all to the resource which records all of the applied tags,
+// and merges the provider default_tags into the resource tags before invoking the resource's Create and Update.
+// The default tags are removed from the attribute tags after reading, so the plan only shows the configured tags.
+func resourceWithDefaultTags(r *schema.Resource) {
+	if !supportDefaultTags(r) {
+		return
+	}
+	r.Schema["tags_all"] = tagsAllSchema()
+
+	create, read, update, customizeDiff := r.Create, r.Read, r.Update, r.CustomizeDiff
+	r.CustomizeDiff = func(diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(diff, meta); err != nil {
+				return err
+			}
+		}
+		return setTagsAllDiff(diff, meta)
+	}
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		configured := expandTagsMap(d.Get("tags"))
+		if err := setMergedTags(d, meta, configured); err != nil {
+			return err
+		}
+		err := create(d, meta)
+		if d.Id() == "" {
+			return err
+		}
+		if e := splitDefaultTags(d, meta, configured); e != nil && err == nil {
+			return e
+		}
+		return err
+	}
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		configured := expandTagsMap(d.Get("tags"))
+		if err := read(d, meta); err != nil || d.Id() == "" {
+			return err
+		}
+		return splitDefaultTags(d, meta, configured)
+	}
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		configured := expandTagsMap(d.Get("tags"))
+		if err := setMergedTags(d, meta, configured); err != nil {
+			return err
+		}
+		err := update(d, meta)
+		if d.Id() == "" {
+			return err
+		}
+		if e := splitDefaultTags(d, meta, configured); e != nil && err == nil {
+			return e
+		}
+		return err
+	}
+}
+
+// resourceWithIgnoreTags drops the tags ignored by the provider ignore_tags block from the resource attribute tags
+// after creating, reading and updating the resource. The ignored tags which have been managed by the resource are kept.
+func resourceWithIgnoreTags(r *schema.Resource) {
+	if v, ok := r.Schema["tags"]; !ok || v.Type != schema.TypeMap || !v.Optional {
+		return
+	}
+	wrap := func(operation func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if operation == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			managed := expandTagsMap(d.Get("tags"))
+			err := operation(d, meta)
+			if d.Id() == "" {
+				return err
+			}
+			if e := removeProviderIgnoredTags(d, meta, managed); e != nil && err == nil {
+				return e
+			}
+			return err
+		}
+	}
+	r.Create, r.Read, r.Update = wrap(r.Create), wrap(r.Read), wrap(r.Update)
+}
+
+// removeProviderIgnoredTags removes the tags ignored by the provider ignore_tags block and not in the managed tags.
+func removeProviderIgnoredTags(d *schema.ResourceData, meta interface{}, managed map[string]interface{}) error {
+	client, ok := meta.(*connectivity.AliyunClient)
+	if !ok || client == nil {
+		return nil
+	}
+	tags := expandTagsMap(d.Get("tags"))
+	changed := false
+	for key := range tags {
+		if _, ok := managed[key]; !ok && providerTagIgnored(client, key) {
+			delete(tags, key)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return d.Set("tags", tags)
+}
+
+// withoutProviderIgnoredTags filters out the tags ignored by the provider ignore_tags block.
+func withoutProviderIgnoredTags(client *connectivity.AliyunClient, tags []Tag) []Tag {
+	var result []Tag
+	for _, t := range tags {
+		if !providerTagIgnored(client, t.Key) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// providerDefaultTags returns the tags configured in the provider default_tags block.
+func providerDefaultTags(meta interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if client, ok := meta.(*connectivity.AliyunClient); ok && client != nil {
+		for key, value := range client.DefaultTags() {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func expandTagsMap(tags interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if v, ok := tags.(map[string]interface{}); ok {
+		for key, value := range v {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// mergeDefaultTags returns the default tags overridden by the configured tags.
+func mergeDefaultTags(defaultTags, configured map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(configured))
+	for key, value := range defaultTags {
+		result[key] = value
+	}
+	for key, value := range configured {
+		result[key] = value
+	}
+	return result
+}
+
+// removeDefaultTags drops the tags which are the same as the default tags and are not configured explicitly.
+func removeDefaultTags(tags, defaultTags, configured map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+	for key, value := range tags {
+		if v, ok := defaultTags[key]; ok && fmt.Sprint(v) == fmt.Sprint(value) {
+			if _, ok := configured[key]; !ok {
+				continue
+			}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+func setMergedTags(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+	defaultTags := providerDefaultTags(meta)
+	if len(defaultTags) == 0 {
+		return nil
+	}
+	if err := d.Set("tags", mergeDefaultTags(defaultTags, configured)); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func splitDefaultTags(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+	tagsAll := expandTagsMap(d.Get("tags"))
+	if err := d.Set("tags_all", tagsAll); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("tags", removeDefaultTags(tagsAll, providerDefaultTags(meta), configured)); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func setTagsAllDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+	tagsAll := mergeDefaultTags(providerDefaultTags(meta), expandTagsMap(diff.Get("tags")))
+	oraw, _ := diff.GetChange("tags_all")
+	if old := expandTagsMap(oraw); len(old) == len(tagsAll) && tagsMapEqual(tagsAll, stringTagsMap(old)) {
+		return nil
+	}
+	return diff.SetNew("tags_all", tagsAll)
+}
+
+func stringTagsMap(tags map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(tags))
+	for key, value := range tags {
+		result[key] = fmt.Sprint(value)
+	}
+	return result
+}
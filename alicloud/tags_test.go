@@ -2,6 +2,9 @@ package alicloud
 
 import (
 	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 func TestTagsMapEqual(t *testing.T) {
@@ -48,3 +51,118 @@ func TestTagsMapEqual(t *testing.T) {
 		t.Fatal("Tag maps is equal.")
 	}
 }
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]interface{}{
+		"env":   "dev",
+		"owner": "team",
+	}
+	configured := map[string]interface{}{
+		"env":  "prod",
+		"name": "tf",
+	}
+	merged := mergeDefaultTags(defaultTags, configured)
+	expected := map[string]string{
+		"env":   "prod",
+		"owner": "team",
+		"name":  "tf",
+	}
+	if !tagsMapEqual(merged, expected) {
+		t.Fatalf("Merged tags %v is not equal to %v.", merged, expected)
+	}
+
+	tags := removeDefaultTags(merged, defaultTags, configured)
+	if !tagsMapEqual(tags, map[string]string{"env": "prod", "name": "tf"}) {
+		t.Fatalf("Default tags are not removed: %v.", tags)
+	}
+
+	configured["owner"] = "team"
+	tags = removeDefaultTags(mergeDefaultTags(defaultTags, configured), defaultTags, configured)
+	if !tagsMapEqual(tags, map[string]string{"env": "prod", "name": "tf", "owner": "team"}) {
+		t.Fatalf("The configured tag which is the same as default tag should be kept: %v.", tags)
+	}
+}
+
+func TestResourceWithDefaultTags(t *testing.T) {
+	p := Provider().(*schema.Provider).ResourcesMap
+	if v, ok := p["alicloud_vpc"].Schema["tags_all"]; !ok || !v.Computed {
+		t.Fatal("The computed attribute tags_all should be added to alicloud_vpc.")
+	}
+	for name, r := range p {
+		if v, ok := r.Schema["tags"]; ok && v.ForceNew {
+			if _, ok := r.Schema["tags_all"]; ok {
+				t.Fatalf("The attribute tags_all should not be added to %s whose tags is ForceNew.", name)
+			}
+		}
+	}
+}
+
+func TestGetTagsChangeWithDefaultTags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"tags":     tagsSchema(),
+		"tags_all": tagsAllSchema(),
+	}, map[string]interface{}{
+		"tags": map[string]interface{}{"name": "vpc"},
+	})
+	// The default tags are merged into the tags by d.Set before the Create and Update
+	if err := d.Set("tags", mergeDefaultTags(map[string]interface{}{"env": "prod"}, expandTagsMap(d.Get("tags")))); err != nil {
+		t.Fatal(err)
+	}
+	_, nraw := getTagsChange(d)
+	if n := nraw.(map[string]interface{}); len(n) != 2 || n["env"] != "prod" || n["name"] != "vpc" {
+		t.Fatalf("The expected tags should contain the default tags: %v.", n)
+	}
+}
+
+func TestProviderTagIgnored(t *testing.T) {
+	server := connectivity.NewMockServer()
+	defer server.Close()
+	client, err := server.ClientWithConfig("cn-hangzhou", func(config *connectivity.Config) {
+		config.IgnoreTagKeys = []string{"owner", ""}
+		config.IgnoreTagKeyPrefixes = []string{"finops:", ""}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := server.Client("cn-hangzhou")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"owner", "finops:cost"} {
+		if !providerTagIgnored(client, key) {
+			t.Fatalf("The tag %s should be ignored.", key)
+		}
+		if providerTagIgnored(other, key) {
+			t.Fatalf("The tag %s should not be ignored by another provider configuration.", key)
+		}
+	}
+	for _, key := range []string{"owner2", "name", ""} {
+		if providerTagIgnored(client, key) {
+			t.Fatalf("The tag %s should not be ignored.", key)
+		}
+	}
+
+	remove := withoutProviderIgnoredTags(client, []Tag{{Key: "owner", Value: "a"}, {Key: "name", Value: "b"}, {Key: "finops:cost", Value: "c"}})
+	if len(remove) != 1 || remove[0].Key != "name" {
+		t.Fatalf("The ignored tags should not be removed: %v.", remove)
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", map[string]interface{}{"name": "b", "owner": "a", "finops:cost": "c"})
+		},
+	}
+	resourceWithIgnoreTags(r)
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"tags": map[string]interface{}{"owner": "a"}})
+	d.SetId("id")
+	if err := r.Read(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if tags := expandTagsMap(d.Get("tags")); len(tags) != 2 || tags["owner"] != "a" || tags["name"] != "b" {
+		t.Fatalf("Only the ignored tags which are not managed should be dropped: %v.", tags)
+	}
+}
@@ -2,10 +2,13 @@ package main
 
 import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/plugin"
 )
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: alicloud.Provider})
+	// The spans are exported in batches, so the remaining ones are flushed before exiting.
+	connectivity.CloseTracers()
 }
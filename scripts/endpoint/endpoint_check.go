@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+var (
+	endpointsFile = flag.String("file", os.Getenv("ALIBABA_CLOUD_ENDPOINTS_FILE"), "the JSON or YAML endpoint file to validate")
+	regionIds     = flag.String("regions", os.Getenv("ALIBABA_CLOUD_REGION"), "the comma separated regions to resolve the endpoints in")
+)
+
+// The command validates an endpoint file and prints which endpoint each product resolves to in the regions and where it comes from, like:
+//
+//	go run scripts/endpoint/endpoint_check.go -file endpoints.yaml -regions cn-qingdao-env17-d01,cn-beijing-env17-d01
+func main() {
+	flag.Parse()
+	if *endpointsFile == "" {
+		log.Println("the endpoint file is required, set it by -file or the environment variable ALIBABA_CLOUD_ENDPOINTS_FILE")
+		os.Exit(1)
+	}
+	endpointFile, err := connectivity.LoadEndpointFile(*endpointsFile)
+	if err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+	log.Printf("the endpoint file %s is valid.", *endpointsFile)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "REGION\tPRODUCT\tENDPOINT\tPROTOCOL\tSIGN VERSION\tSOURCE")
+	for _, regionId := range strings.Split(*regionIds, ",") {
+		regionId = strings.TrimSpace(regionId)
+		if regionId == "" {
+			continue
+		}
+		resolved := endpointFile.Resolve(regionId)
+		if len(resolved) == 0 {
+			log.Printf("[WARN] there is no endpoint configured for the region %s.", regionId)
+		}
+		for _, endpoint := range resolved {
+			source := endpoint.Source
+			if name, value := connectivity.EndpointFromEnv(endpoint.ProductCode); value != "" {
+				endpoint.Endpoint, source = value, "environment variable "+name
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", regionId, endpoint.ProductCode, endpoint.Endpoint, valueOrDefault(endpoint.Protocol), valueOrDefault(endpoint.SignVersion), source)
+		}
+	}
+	writer.Flush()
+}
+
+func valueOrDefault(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}